@@ -0,0 +1,207 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Command crd-compat reports the changes between two sets of Hub CRDs that may prevent
+// existing objects from validating after an upgrade.
+//
+// Usage:
+//
+//	crd-compat -old ./previous/crd [-new ./pkg/apis/hub/v1alpha1/crd] [-json]
+//	crd-compat -objects ./manifests [-new ./pkg/apis/hub/v1alpha1/crd]
+//	crd-compat -kubeconfig ~/.kube/config [-namespace default] [-new ./pkg/apis/hub/v1alpha1/crd]
+//
+// When -new is omitted, the CRDs embedded in this module are used.
+// With -objects or -kubeconfig, the command runs in preflight mode: the existing objects, read from
+// files or fetched from the cluster, are re-validated against the candidate CRDs.
+// The command exits with status 1 when at least one breaking change or invalid object is found.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+
+	hubcrd "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1/crd"
+	"github.com/traefik/hub-crds/pkg/client/clientset/versioned"
+	"github.com/traefik/hub-crds/pkg/compatibility"
+	"github.com/traefik/hub-crds/pkg/crd"
+	"github.com/traefik/hub-crds/pkg/validation"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func main() {
+	var cfg config
+
+	flag.StringVar(&cfg.oldDir, "old", "", "Directory containing the currently deployed CRDs")
+	flag.StringVar(&cfg.newDir, "new", "", "Directory containing the candidate CRDs (defaults to the embedded CRDs)")
+	flag.StringVar(&cfg.objectsDir, "objects", "", "Directory containing existing objects to re-validate against the candidate CRDs")
+	flag.StringVar(&cfg.kubeconfig, "kubeconfig", "", "Kubeconfig of the cluster holding existing objects to re-validate against the candidate CRDs")
+	flag.StringVar(&cfg.namespace, "namespace", "", "Namespace of the objects to fetch from the cluster (defaults to all namespaces)")
+	flag.BoolVar(&cfg.json, "json", false, "Print results as JSON")
+	flag.Parse()
+
+	failed, err := run(context.Background(), cfg)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
+type config struct {
+	oldDir     string
+	newDir     string
+	objectsDir string
+	kubeconfig string
+	namespace  string
+	json       bool
+}
+
+func run(ctx context.Context, cfg config) (bool, error) {
+	if cfg.oldDir == "" && cfg.objectsDir == "" && cfg.kubeconfig == "" {
+		return false, errors.New("one of -old, -objects or -kubeconfig is required")
+	}
+
+	var newFS fs.FS = hubcrd.CRDs
+	if cfg.newDir != "" {
+		newFS = os.DirFS(cfg.newDir)
+	}
+
+	newCRDs, err := crd.GetCRDs(newFS)
+	if err != nil {
+		return false, fmt.Errorf("loading new CRDs: %w", err)
+	}
+
+	var failed bool
+
+	if cfg.oldDir != "" {
+		breaking, err := compare(cfg, newCRDs)
+		if err != nil {
+			return false, err
+		}
+
+		failed = failed || breaking
+	}
+
+	if cfg.objectsDir != "" || cfg.kubeconfig != "" {
+		invalid, err := preflight(ctx, cfg, newCRDs)
+		if err != nil {
+			return false, err
+		}
+
+		failed = failed || invalid
+	}
+
+	return failed, nil
+}
+
+func compare(cfg config, newCRDs []*apiextensions.CustomResourceDefinition) (bool, error) {
+	oldCRDs, err := crd.GetCRDs(os.DirFS(cfg.oldDir))
+	if err != nil {
+		return false, fmt.Errorf("loading old CRDs: %w", err)
+	}
+
+	findings, err := compatibility.Compare(oldCRDs, newCRDs)
+	if err != nil {
+		return false, fmt.Errorf("comparing CRDs: %w", err)
+	}
+
+	if cfg.json {
+		if err = printJSON(findings); err != nil {
+			return false, err
+		}
+	} else {
+		for _, finding := range findings {
+			_, _ = fmt.Fprintln(os.Stdout, finding.String())
+		}
+	}
+
+	return compatibility.HasBreaking(findings), nil
+}
+
+func preflight(ctx context.Context, cfg config, newCRDs []*apiextensions.CustomResourceDefinition) (bool, error) {
+	var objects []*unstructured.Unstructured
+
+	if cfg.objectsDir != "" {
+		fileObjects, err := crd.GetObjects(os.DirFS(cfg.objectsDir))
+		if err != nil {
+			return false, fmt.Errorf("loading objects: %w", err)
+		}
+
+		objects = append(objects, fileObjects...)
+	}
+
+	if cfg.kubeconfig != "" {
+		restConfig, err := clientcmd.BuildConfigFromFlags("", cfg.kubeconfig)
+		if err != nil {
+			return false, fmt.Errorf("loading kubeconfig: %w", err)
+		}
+
+		clientSet, err := versioned.NewForConfig(restConfig)
+		if err != nil {
+			return false, fmt.Errorf("creating clientset: %w", err)
+		}
+
+		clusterObjects, err := validation.ListObjects(ctx, clientSet, cfg.namespace)
+		if err != nil {
+			return false, fmt.Errorf("listing objects: %w", err)
+		}
+
+		objects = append(objects, clusterObjects...)
+	}
+
+	checker, err := validation.NewPreflight(newCRDs)
+	if err != nil {
+		return false, fmt.Errorf("creating preflight: %w", err)
+	}
+
+	results := checker.Check(objects)
+
+	if cfg.json {
+		if err = printJSON(results); err != nil {
+			return false, err
+		}
+	} else {
+		for _, result := range results {
+			for _, fieldErr := range append(result.SchemaErrs, result.CELErrs...) {
+				_, _ = fmt.Fprintf(os.Stdout, "[invalid] %s: %s\n", result.String(), fieldErr.Error())
+			}
+		}
+	}
+
+	return len(results) > 0, nil
+}
+
+func printJSON(value any) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(value); err != nil {
+		return fmt.Errorf("encoding results: %w", err)
+	}
+
+	return nil
+}
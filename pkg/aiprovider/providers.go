@@ -0,0 +1,48 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package aiprovider
+
+// Keys of the Secrets holding the credentials of the providers, named after the kind of credentials.
+var (
+	tokenKeys  = []string{"token"}
+	apiKeyKeys = []string{"apiKey"}
+)
+
+// builtinProviders are the providers supported by AIServices.
+var builtinProviders = []Provider{
+	{Name: "anthropic", Field: "anthropic", SecretField: "token", SecretKeys: tokenKeys, Params: samplingParams},
+	{Name: "azureOpenai", Field: "azureOpenai", SecretField: "apiKeySecret", SecretKeys: apiKeyKeys, Params: allParams},
+	{Name: "bedrock", Field: "bedrock", Params: samplingParams},
+	{Name: "cohere", Field: "cohere", SecretField: "token", SecretKeys: tokenKeys, Params: allParams},
+	{Name: "gemini", Field: "gemini", SecretField: "apiKey", SecretKeys: apiKeyKeys, Params: allParams},
+	{Name: "mistral", Field: "mistral", SecretField: "apiKey", SecretKeys: apiKeyKeys, Params: allParams},
+	{Name: "ollama", Field: "ollama", Params: allParams},
+	{Name: "openai", Field: "openai", SecretField: "token", SecretKeys: tokenKeys, DefaultBaseURL: "https://api.openai.com/v1", Params: allParams},
+	{Name: "deepSeek", Field: "deepSeek", SecretField: "token", SecretKeys: tokenKeys, DefaultBaseURL: "https://api.deepseek.com", Params: allParams},
+	{Name: "qWen", Field: "qWen", SecretField: "token", SecretKeys: tokenKeys, DefaultBaseURL: "https://dashscope.aliyuncs.com/compatible-mode/v1", Params: allParams},
+
+	// The generic OpenAI-compatible provider, used when no provider name is set.
+	{Name: FieldOpenAICompatible, Field: FieldOpenAICompatible, SecretField: "token", SecretKeys: tokenKeys, Params: allParams},
+
+	// OpenAI-compatible providers.
+	{Name: "groq", Field: FieldOpenAICompatible, SecretField: "token", SecretKeys: tokenKeys, SecretRequired: true, DefaultBaseURL: "https://api.groq.com/openai/v1", Params: allParams},
+	{Name: "together", Field: FieldOpenAICompatible, SecretField: "token", SecretKeys: tokenKeys, SecretRequired: true, DefaultBaseURL: "https://api.together.xyz/v1", Params: allParams},
+	{Name: "xai", Field: FieldOpenAICompatible, SecretField: "token", SecretKeys: tokenKeys, SecretRequired: true, DefaultBaseURL: "https://api.x.ai/v1", Params: allParams},
+	{Name: "vllm", Field: FieldOpenAICompatible, SecretField: "token", SecretKeys: tokenKeys, Params: allParams},
+	{Name: "tgi", Field: FieldOpenAICompatible, SecretField: "token", SecretKeys: tokenKeys, Params: allParams},
+}
@@ -0,0 +1,176 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package aiprovider describes the LLM providers of AIServices: the field configuring them, the Secret holding their
+// credentials, their default base URL and the parameters they support.
+// The validation of AIServices is driven by a Registry of providers, so that supporting a new OpenAI-compatible
+// provider only takes registering it.
+package aiprovider
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+)
+
+// FieldOpenAICompatible is the AIService field configuring the OpenAI-compatible providers.
+const FieldOpenAICompatible = "openaiCompatible"
+
+// Parameters of the LLM providers, as named in the AIService params.
+const (
+	ParamTemperature      = "temperature"
+	ParamTopP             = "topP"
+	ParamMaxTokens        = "maxTokens"
+	ParamFrequencyPenalty = "frequencyPenalty"
+	ParamPresencePenalty  = "presencePenalty"
+)
+
+var (
+	allParams      = []string{ParamTemperature, ParamTopP, ParamMaxTokens, ParamFrequencyPenalty, ParamPresencePenalty}
+	samplingParams = []string{ParamTemperature, ParamTopP, ParamMaxTokens}
+)
+
+// Provider describes an LLM provider.
+type Provider struct {
+	// Name is the name of the provider. The providers configured by their own AIService field are named after it,
+	// while the OpenAI-compatible providers are named by the provider field of the openaiCompatible configuration.
+	Name string
+	// Field is the AIService field configuring the provider, such as "openai" or "openaiCompatible".
+	Field string
+	// SecretField is the field of the provider configuration referencing the Secret holding the credentials, if any.
+	SecretField string
+	// SecretRequired is true when the provider cannot be called without credentials.
+	SecretRequired bool
+	// SecretKeys are the keys the Secret holding the credentials must contain.
+	SecretKeys []string
+	// DefaultBaseURL is the base URL of the provider API, used when the configuration doesn't set one.
+	// The OpenAI-compatible providers without default base URL require the configuration to set one.
+	DefaultBaseURL string
+	// Params are the names of the parameters known to be supported. The validation reports the other parameters
+	// as warnings rather than errors, a provider possibly accepting parameters it doesn't document.
+	Params []string
+}
+
+// IsField reports whether the provider is configured by its own AIService field,
+// rather than being an OpenAI-compatible provider selected by name.
+func (p Provider) IsField() bool {
+	return p.Name == p.Field
+}
+
+// SupportsParam reports whether the given parameter is known to be supported by the provider.
+func (p Provider) SupportsParam(name string) bool {
+	return slices.Contains(p.Params, name)
+}
+
+// Registry holds providers by name. It is not safe for concurrent use while providers are registered.
+type Registry struct {
+	providers map[string]Provider
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{providers: make(map[string]Provider)}
+}
+
+// Default returns a new Registry holding the providers supported by AIServices.
+func Default() *Registry {
+	r := NewRegistry()
+	for _, provider := range builtinProviders {
+		if err := r.Register(provider); err != nil {
+			panic(err)
+		}
+	}
+
+	return r
+}
+
+// Register adds a provider to the registry.
+func (r *Registry) Register(provider Provider) error {
+	if provider.Name == "" || provider.Field == "" {
+		return errors.New("provider name and field are required")
+	}
+
+	if _, ok := r.providers[provider.Name]; ok {
+		return fmt.Errorf("provider %q is already registered", provider.Name)
+	}
+
+	if !provider.IsField() && provider.Field != FieldOpenAICompatible {
+		return fmt.Errorf("provider %q must be configured by its own field or by the %s field", provider.Name, FieldOpenAICompatible)
+	}
+
+	if provider.DefaultBaseURL != "" {
+		u, err := url.Parse(provider.DefaultBaseURL)
+		if err != nil {
+			return fmt.Errorf("parsing default base URL of provider %q: %w", provider.Name, err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("default base URL of provider %q must be an HTTP or HTTPS URL", provider.Name)
+		}
+	}
+
+	for _, param := range provider.Params {
+		if !slices.Contains(allParams, param) {
+			return fmt.Errorf("unknown parameter %q of provider %q", param, provider.Name)
+		}
+	}
+
+	r.providers[provider.Name] = provider
+
+	return nil
+}
+
+// Get returns the provider of the given name.
+func (r *Registry) Get(name string) (Provider, bool) {
+	provider, ok := r.providers[name]
+
+	return provider, ok
+}
+
+// Providers returns the registered providers, sorted by name.
+func (r *Registry) Providers() []Provider {
+	providers := make([]Provider, 0, len(r.providers))
+	for _, provider := range r.providers {
+		providers = append(providers, provider)
+	}
+
+	slices.SortFunc(providers, func(a, b Provider) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return providers
+}
+
+// Fields returns the providers configured by their own AIService field, sorted by name.
+func (r *Registry) Fields() []Provider {
+	return slices.DeleteFunc(r.Providers(), func(p Provider) bool {
+		return !p.IsField()
+	})
+}
+
+// OpenAICompatible returns the names of the OpenAI-compatible providers that can be selected by name, sorted.
+func (r *Registry) OpenAICompatible() []string {
+	var names []string
+	for _, provider := range r.Providers() {
+		if provider.Field == FieldOpenAICompatible && !provider.IsField() {
+			names = append(names, provider.Name)
+		}
+	}
+
+	return names
+}
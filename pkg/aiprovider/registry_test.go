@@ -0,0 +1,147 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package aiprovider_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/hub-crds/pkg/aiprovider"
+	hubcrd "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1/crd"
+	"github.com/traefik/hub-crds/pkg/crd"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+)
+
+func TestDefault_matchesAIServiceCRD(t *testing.T) {
+	t.Parallel()
+
+	crds, err := crd.GetCRDs(hubcrd.CRDs)
+	require.NoError(t, err)
+
+	i := slices.IndexFunc(crds, func(definition *apiextensions.CustomResourceDefinition) bool {
+		return definition.Spec.Names.Kind == "AIService"
+	})
+	require.GreaterOrEqual(t, i, 0)
+
+	validation, err := apiextensions.GetSchemaForVersion(crds[i], "v1alpha1")
+	require.NoError(t, err)
+
+	spec := validation.OpenAPIV3Schema.Properties["spec"]
+	backend := spec.Properties["routing"].Properties["backends"].Items.Schema
+
+	// The providers are the fields shared by the spec and the routing backends.
+	var fields []string
+	for name := range spec.Properties {
+		if _, ok := backend.Properties[name]; ok {
+			fields = append(fields, name)
+		}
+	}
+
+	var registered []string
+	for _, provider := range aiprovider.Default().Fields() {
+		registered = append(registered, provider.Field)
+
+		config := spec.Properties[provider.Field]
+		assert.Containsf(t, config.Properties, "params", "%s has no params field", provider.Name)
+		if provider.SecretField != "" {
+			assert.Containsf(t, config.Properties, provider.SecretField, "%s has no %s field", provider.Name, provider.SecretField)
+		}
+	}
+
+	assert.ElementsMatch(t, fields, registered)
+}
+
+func TestRegistry_Register(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc     string
+		provider aiprovider.Provider
+		wantErr  string
+	}{
+		{
+			desc:     "OpenAI-compatible provider",
+			provider: aiprovider.Provider{Name: "mistral-vllm", Field: aiprovider.FieldOpenAICompatible, DefaultBaseURL: "http://vllm.default.svc:8000/v1", Params: []string{aiprovider.ParamTemperature}},
+		},
+		{
+			desc:     "missing name",
+			provider: aiprovider.Provider{Field: aiprovider.FieldOpenAICompatible},
+			wantErr:  "provider name and field are required",
+		},
+		{
+			desc:     "already registered",
+			provider: aiprovider.Provider{Name: "groq", Field: aiprovider.FieldOpenAICompatible},
+			wantErr:  `provider "groq" is already registered`,
+		},
+		{
+			desc:     "unknown field",
+			provider: aiprovider.Provider{Name: "llama", Field: "openai"},
+			wantErr:  `provider "llama" must be configured by its own field or by the openaiCompatible field`,
+		},
+		{
+			desc:     "invalid default base URL",
+			provider: aiprovider.Provider{Name: "llama", Field: aiprovider.FieldOpenAICompatible, DefaultBaseURL: "ftp://llama.example.com"},
+			wantErr:  `default base URL of provider "llama" must be an HTTP or HTTPS URL`,
+		},
+		{
+			desc:     "unknown parameter",
+			provider: aiprovider.Provider{Name: "llama", Field: aiprovider.FieldOpenAICompatible, Params: []string{"topK"}},
+			wantErr:  `unknown parameter "topK" of provider "llama"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			registry := aiprovider.Default()
+
+			err := registry.Register(test.provider)
+			if test.wantErr != "" {
+				require.EqualError(t, err, test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			got, ok := registry.Get(test.provider.Name)
+			require.True(t, ok)
+			assert.Equal(t, test.provider, got)
+			assert.Contains(t, registry.OpenAICompatible(), test.provider.Name)
+		})
+	}
+}
+
+func TestRegistry_OpenAICompatible(t *testing.T) {
+	t.Parallel()
+
+	registry := aiprovider.Default()
+
+	assert.Equal(t, []string{"groq", "tgi", "together", "vllm", "xai"}, registry.OpenAICompatible())
+
+	groq, ok := registry.Get("groq")
+	require.True(t, ok)
+	assert.False(t, groq.IsField())
+	assert.True(t, groq.SupportsParam(aiprovider.ParamPresencePenalty))
+
+	anthropic, ok := registry.Get("anthropic")
+	require.True(t, ok)
+	assert.True(t, anthropic.IsField())
+	assert.False(t, anthropic.SupportsParam(aiprovider.ParamFrequencyPenalty))
+}
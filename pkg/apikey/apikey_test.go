@@ -0,0 +1,177 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package apikey_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/hub-crds/pkg/apikey"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+)
+
+func TestSource_Extract(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc    string
+		source  apikey.Source
+		url     string
+		headers map[string]string
+		cookie  *http.Cookie
+		want    string
+		wantErr error
+	}{
+		{
+			desc:    "default bearer header",
+			source:  apikey.FromAPIKeySource(nil),
+			headers: map[string]string{"Authorization": "Bearer my-key"},
+			want:    "my-key",
+		},
+		{
+			desc:    "default scheme is case insensitive",
+			source:  apikey.FromAPIKeySource(nil),
+			headers: map[string]string{"Authorization": "bearer my-key"},
+			want:    "my-key",
+		},
+		{
+			desc:   "default query",
+			source: apikey.FromAPIKeySource(nil),
+			url:    "/?api_key=my-key",
+			want:   "my-key",
+		},
+		{
+			desc:    "default header without scheme",
+			source:  apikey.FromAPIKeySource(nil),
+			url:     "/?api_key=my-key",
+			headers: map[string]string{"Authorization": "Basic abc"},
+			wantErr: apikey.ErrMissingAuthScheme,
+		},
+		{
+			desc:    "custom header overrides defaults",
+			source:  apikey.FromAPIKeySource(&hubv1alpha1.APIKeySource{Header: "X-API-Key"}),
+			url:     "/?api_key=ignored",
+			headers: map[string]string{"Authorization": "Bearer ignored"},
+			wantErr: apikey.ErrNotFound,
+		},
+		{
+			desc:    "custom header",
+			source:  apikey.FromAPIKeySource(&hubv1alpha1.APIKeySource{Header: "X-API-Key"}),
+			headers: map[string]string{"X-API-Key": "my-key"},
+			want:    "my-key",
+		},
+		{
+			desc:    "header takes precedence over query and cookie",
+			source:  apikey.FromTokenSource(hubv1alpha1.TokenSource{Header: "X-Key", Query: "key", Cookie: "key"}),
+			url:     "/?key=from-query",
+			headers: map[string]string{"X-Key": "from-header"},
+			cookie:  &http.Cookie{Name: "key", Value: "from-cookie"},
+			want:    "from-header",
+		},
+		{
+			desc:   "query takes precedence over cookie",
+			source: apikey.FromTokenSource(hubv1alpha1.TokenSource{Header: "X-Key", Query: "key", Cookie: "key"}),
+			url:    "/?key=from-query",
+			cookie: &http.Cookie{Name: "key", Value: "from-cookie"},
+			want:   "from-query",
+		},
+		{
+			desc:   "cookie",
+			source: apikey.FromTokenSource(hubv1alpha1.TokenSource{Header: "X-Key", Query: "key", Cookie: "key"}),
+			cookie: &http.Cookie{Name: "key", Value: "from-cookie"},
+			want:   "from-cookie",
+		},
+		{
+			desc:    "auth scheme ignored for other headers",
+			source:  apikey.FromTokenSource(hubv1alpha1.TokenSource{Header: "X-Key", HeaderAuthScheme: "Bearer"}),
+			headers: map[string]string{"X-Key": "my-key"},
+			want:    "my-key",
+		},
+		{
+			desc:    "not found",
+			source:  apikey.FromTokenSource(hubv1alpha1.TokenSource{Header: "X-Key"}),
+			wantErr: apikey.ErrNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			url := test.url
+			if url == "" {
+				url = "/"
+			}
+
+			req := httptest.NewRequest(http.MethodGet, url, http.NoBody)
+			for name, value := range test.headers {
+				req.Header.Set(name, value)
+			}
+			if test.cookie != nil {
+				req.AddCookie(test.cookie)
+			}
+
+			got, err := test.source.Extract(req)
+			if test.wantErr != nil {
+				require.ErrorIs(t, err, test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestHash(t *testing.T) {
+	t.Parallel()
+
+	// SHAKE-256 test vector of the empty message.
+	assert.Equal(t, "46b9dd2b0ba88d13233b3feb743eeb243fcd52ea62b81b82b50c27646ed5762fd75dc4ddd8c0f200cb05019d67b592f6fc821c49479ab48640292eacb3b7c4be", apikey.Hash(""))
+
+	hash := apikey.Hash("my-key")
+	assert.Len(t, hash, 128)
+	assert.True(t, apikey.Verify("my-key", hash))
+	assert.True(t, apikey.Verify("my-key", strings.ToUpper(hash)))
+	assert.False(t, apikey.Verify("other-key", hash))
+	assert.False(t, apikey.Verify("my-key", hash[:64]))
+	assert.False(t, apikey.Verify("my-key", "not-hex"))
+}
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+
+	key, err := apikey.Generate()
+	require.NoError(t, err)
+
+	keys := []hubv1alpha1.AccessControlPolicyAPIKeyKey{
+		apikey.NewKey("other", "other-key", nil),
+		apikey.NewKey("ci", key, map[string]string{"team": "ci"}),
+	}
+
+	entry, ok := apikey.Lookup(keys, key)
+	require.True(t, ok)
+	assert.Equal(t, "ci", entry.ID)
+	assert.Equal(t, map[string]string{"team": "ci"}, entry.Metadata)
+
+	_, ok = apikey.Lookup(keys, "unknown")
+	assert.False(t, ok)
+}
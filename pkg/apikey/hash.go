@@ -0,0 +1,84 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	"golang.org/x/crypto/sha3"
+)
+
+// hashSize is the size of the SHAKE-256 output of the hashed keys.
+const hashSize = 64
+
+// Hash returns the hex encoded SHAKE-256 hash, using 64 bytes, of the key, as expected by the Value of the
+// AccessControlPolicy API keys.
+func Hash(key string) string {
+	sum := make([]byte, hashSize)
+	sha3.ShakeSum256(sum, []byte(key))
+
+	return hex.EncodeToString(sum)
+}
+
+// Verify returns whether the key matches the given hash, in constant time.
+func Verify(key, hash string) bool {
+	want, err := hex.DecodeString(hash)
+	if err != nil || len(want) != hashSize {
+		return false
+	}
+
+	got := make([]byte, hashSize)
+	sha3.ShakeSum256(got, []byte(key))
+
+	return subtle.ConstantTimeCompare(got, want) == 1
+}
+
+// Lookup returns the entry of the keys matching the given key.
+func Lookup(keys []hubv1alpha1.AccessControlPolicyAPIKeyKey, key string) (hubv1alpha1.AccessControlPolicyAPIKeyKey, bool) {
+	for _, entry := range keys {
+		if Verify(key, entry.Value) {
+			return entry, true
+		}
+	}
+
+	return hubv1alpha1.AccessControlPolicyAPIKeyKey{}, false
+}
+
+// NewKey returns the AccessControlPolicy API key entry of the given key.
+func NewKey(id, key string, metadata map[string]string) hubv1alpha1.AccessControlPolicyAPIKeyKey {
+	return hubv1alpha1.AccessControlPolicyAPIKeyKey{
+		ID:       id,
+		Value:    Hash(key),
+		Metadata: metadata,
+	}
+}
+
+// Generate generates a random key of 32 bytes, base64 URL encoded.
+func Generate() (string, error) {
+	data := make([]byte, 32)
+	if _, err := rand.Read(data); err != nil {
+		return "", fmt.Errorf("generating key: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
@@ -0,0 +1,112 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package apikey extracts API keys from HTTP requests as described by the APIKeySource of APIAuths and the TokenSource
+// of AccessControlPolicies, and hashes them in the format of the AccessControlPolicy API keys.
+package apikey
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+)
+
+// Errors returned by Source.Extract.
+var (
+	// ErrNotFound is returned when the request has no key in any of the configured locations.
+	ErrNotFound = errors.New("API key not found")
+	// ErrMissingAuthScheme is returned when the header holds a value without the configured auth scheme.
+	ErrMissingAuthScheme = errors.New("API key header is missing the auth scheme")
+)
+
+// Default location of the API keys of an APIKeyAuthSpec without KeySource.
+const (
+	DefaultHeader           = "Authorization"
+	DefaultHeaderAuthScheme = "Bearer"
+	DefaultQuery            = "api_key"
+)
+
+// Source is where API keys are read from requests. Locations are checked in the order header > query > cookie,
+// empty ones being disabled.
+type Source struct {
+	Header string
+	// HeaderAuthScheme is the scheme prefixing the key in the header, "<scheme> <key>".
+	// It only applies to the Authorization header.
+	HeaderAuthScheme string
+	Query            string
+	Cookie           string
+}
+
+// FromAPIKeySource returns the Source of an APIKeyAuthSpec KeySource.
+// A nil KeySource defaults to the Authorization header with the Bearer scheme and the api_key query parameter.
+func FromAPIKeySource(src *hubv1alpha1.APIKeySource) Source {
+	if src == nil {
+		return Source{Header: DefaultHeader, HeaderAuthScheme: DefaultHeaderAuthScheme, Query: DefaultQuery}
+	}
+
+	return Source{Header: src.Header, HeaderAuthScheme: src.HeaderAuthScheme, Query: src.Query}
+}
+
+// FromTokenSource returns the Source of an AccessControlPolicy TokenSource.
+func FromTokenSource(src hubv1alpha1.TokenSource) Source {
+	return Source{Header: src.Header, HeaderAuthScheme: src.HeaderAuthScheme, Query: src.Query, Cookie: src.Cookie}
+}
+
+// Extract returns the API key of the request, read from the first configured location present in the request.
+// A header missing the configured auth scheme is an error rather than a fallback to the next locations,
+// as such requests are dropped.
+func (s Source) Extract(req *http.Request) (string, error) {
+	if s.Header != "" {
+		if value := req.Header.Get(s.Header); value != "" {
+			return s.fromHeader(value)
+		}
+	}
+
+	if s.Query != "" {
+		if value := req.URL.Query().Get(s.Query); value != "" {
+			return value, nil
+		}
+	}
+
+	if s.Cookie != "" {
+		if cookie, err := req.Cookie(s.Cookie); err == nil && cookie.Value != "" {
+			return cookie.Value, nil
+		}
+	}
+
+	return "", ErrNotFound
+}
+
+func (s Source) fromHeader(value string) (string, error) {
+	if s.HeaderAuthScheme == "" || !strings.EqualFold(s.Header, "Authorization") {
+		return value, nil
+	}
+
+	scheme, key, ok := strings.Cut(value, " ")
+	if !ok || !strings.EqualFold(scheme, s.HeaderAuthScheme) {
+		return "", ErrMissingAuthScheme
+	}
+
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return "", ErrNotFound
+	}
+
+	return key, nil
+}
@@ -60,7 +60,8 @@ type AccessControlPolicyJWT struct {
 	StripAuthorizationHeader   *bool             `json:"stripAuthorizationHeader,omitempty"`
 	ForwardHeaders             map[string]string `json:"forwardHeaders,omitempty"`
 	TokenQueryKey              string            `json:"tokenQueryKey,omitempty"`
-	Claims                     string            `json:"claims,omitempty"`
+	// +kubebuilder:validation:MaxLength=4096
+	Claims string `json:"claims,omitempty"`
 }
 
 // AccessControlPolicyBasicAuth holds the HTTP basic authentication configuration.
@@ -111,7 +112,8 @@ type AccessControlPolicyOIDC struct {
 
 	Scopes         []string          `json:"scopes,omitempty"`
 	ForwardHeaders map[string]string `json:"forwardHeaders,omitempty"`
-	Claims         string            `json:"claims,omitempty"`
+	// +kubebuilder:validation:MaxLength=4096
+	Claims string `json:"claims,omitempty"`
 }
 
 // AccessControlPolicyOIDCGoogle holds the Google OIDC authentication configuration.
@@ -155,7 +157,8 @@ type AccessControlOAuthIntro struct {
 	// +kubebuilder:validation:Required
 	ClientConfig AccessControlOAuthIntroClientConfig `json:"clientConfig"`
 	// +kubebuilder:validation:Required
-	TokenSource    TokenSource       `json:"tokenSource"`
+	TokenSource TokenSource `json:"tokenSource"`
+	// +kubebuilder:validation:MaxLength=4096
 	Claims         string            `json:"claims,omitempty"`
 	ForwardHeaders map[string]string `json:"forwardHeaders,omitempty"`
 }
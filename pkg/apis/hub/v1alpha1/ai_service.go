@@ -23,6 +23,8 @@ import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // AIService is a Kubernetes-like Service to interact with a text-based LLM provider. It defines the parameters and credentials required to interact with various LLM providers.
+// +kubebuilder:subresource:status
+// +kubebuilder:validation:XValidation:message="an AIService cannot reference itself",rule="!has(self.spec) || !has(self.spec.routing) || self.spec.routing.backends.all(b, !has(b.aiService) || b.aiService.name != self.metadata.name)"
 type AIService struct {
 	metav1.TypeMeta `json:",inline"`
 	// +optional
@@ -30,12 +32,41 @@ type AIService struct {
 
 	// The desired behavior of this AIService.
 	Spec AIServiceSpec `json:"spec,omitempty"`
+
+	// The current status of this AIService.
+	// +optional
+	Status AIServiceStatus `json:"status,omitempty"`
 }
 
 // +k8s:deepcopy-gen=true
 
 // AIServiceSpec describes the LLM service provider.
+// +kubebuilder:validation:XValidation:message="exactly one provider or routing must be specified",rule="[has(self.anthropic), has(self.azureOpenai), has(self.bedrock), has(self.cohere), has(self.gemini), has(self.mistral), has(self.ollama), has(self.openai), has(self.deepSeek), has(self.qWen), has(self.openaiCompatible), has(self.routing)].filter(x, x).size() == 1"
 type AIServiceSpec struct {
+	AIServiceProvider `json:",inline"`
+
+	// Routing spreads the requests over several backends instead of a single provider.
+	// +optional
+	Routing *AIServiceRouting `json:"routing,omitempty"`
+
+	// TokenBudgets limit the number of tokens consumed by each consumer of the AIService.
+	// +optional
+	// +kubebuilder:validation:MaxItems=8
+	TokenBudgets []TokenQuota `json:"tokenBudgets,omitempty"`
+
+	// Models restricts the models that can be requested to the AIService.
+	// +optional
+	Models *ModelFilter `json:"models,omitempty"`
+
+	// ContentGuard checks the prompts and the responses with a content guard service.
+	// +optional
+	ContentGuard *ContentGuard `json:"contentGuard,omitempty"`
+}
+
+// +k8s:deepcopy-gen=true
+
+// AIServiceProvider configures the LLM provider of an AIService or of a routing backend.
+type AIServiceProvider struct {
 	Anthropic   *Anthropic   `json:"anthropic,omitempty"`
 	AzureOpenAI *AzureOpenAI `json:"azureOpenai,omitempty"`
 	Bedrock     *Bedrock     `json:"bedrock,omitempty"`
@@ -46,11 +77,148 @@ type AIServiceSpec struct {
 	OpenAI      *OpenAI      `json:"openai,omitempty"`
 	DeepSeek    *DeepSeek    `json:"deepSeek,omitempty"`
 	QWen        *QWen        `json:"qWen,omitempty"`
+
+	OpenAICompatible *OpenAICompatible `json:"openaiCompatible,omitempty"`
+}
+
+// AIServiceRoutingStrategy is how the requests are spread over the backends of an AIService.
+type AIServiceRoutingStrategy string
+
+// Routing strategies of AIServices.
+const (
+	// AIServiceRoutingFailover sends the requests to the first backend, and to the next ones in order on failover.
+	AIServiceRoutingFailover AIServiceRoutingStrategy = "failover"
+	// AIServiceRoutingWeighted spreads the requests over the backends according to their weight,
+	// and fails over to the other backends.
+	AIServiceRoutingWeighted AIServiceRoutingStrategy = "weighted"
+)
+
+// +k8s:deepcopy-gen=true
+
+// AIServiceRouting defines the backends of an AIService and how the requests are spread over them.
+// +kubebuilder:validation:XValidation:message="weight can only be set with the weighted strategy",rule="(has(self.strategy) && self.strategy == 'weighted') || self.backends.all(b, !has(b.weight) || b.weight == 1)"
+// +kubebuilder:validation:XValidation:message="at least one backend must have a non-zero weight",rule="!has(self.strategy) || self.strategy != 'weighted' || self.backends.exists(b, !has(b.weight) || b.weight > 0)"
+// +kubebuilder:validation:XValidation:message="duplicated AIService reference",rule="self.backends.all(b, !has(b.aiService) || self.backends.exists_one(o, has(o.aiService) && o.aiService.name == b.aiService.name))"
+type AIServiceRouting struct {
+	// Strategy is how the requests are spread over the backends.
+	// With failover, the backends are tried in order. With weighted, they are picked according to their weight.
+	// +optional
+	// +kubebuilder:validation:Enum=failover;weighted
+	// +kubebuilder:default=failover
+	Strategy AIServiceRoutingStrategy `json:"strategy,omitempty"`
+
+	// Backends are the backends of the AIService.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Backends []AIServiceBackend `json:"backends"`
+
+	// Failover defines when a request is sent to the next backend.
+	// +optional
+	Failover *AIServiceFailover `json:"failover,omitempty"`
+}
+
+// +k8s:deepcopy-gen=true
+
+// AIServiceBackend is a backend of an AIService, either another AIService or an embedded provider.
+// +kubebuilder:validation:XValidation:message="exactly one of aiService or a provider must be specified",rule="[has(self.aiService), has(self.anthropic), has(self.azureOpenai), has(self.bedrock), has(self.cohere), has(self.gemini), has(self.mistral), has(self.ollama), has(self.openai), has(self.deepSeek), has(self.qWen), has(self.openaiCompatible)].filter(x, x).size() == 1"
+type AIServiceBackend struct {
+	// AIService references another AIService of the same namespace.
+	// +optional
+	AIService *AIServiceReference `json:"aiService,omitempty"`
+
+	AIServiceProvider `json:",inline"`
+
+	// Weight is the share of the requests sent to the backend with the weighted strategy. Defaults to 1.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:XValidation:message="must be a positive number",rule="self >= 0"
+	Weight *int `json:"weight,omitempty"`
+
+	// Params overrides the parameters of the backend.
+	// +optional
+	Params *Params `json:"params,omitempty"`
+}
+
+// AIServiceReference references an AIService.
+type AIServiceReference struct {
+	// Name of the AIService.
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+}
+
+// +k8s:deepcopy-gen=true
+
+// AIServiceFailover defines when a request is sent to the next backend.
+type AIServiceFailover struct {
+	// StatusCodes are the response status codes, or ranges of status codes such as 500-599, triggering a failover.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MaxLength=7
+	// +kubebuilder:validation:XValidation:message="must be status codes or ranges of status codes such as 500-599",rule="self.all(x, x.matches(r\"\"\"^[1-5][0-9]{2}(-[1-5][0-9]{2})?$\"\"\"))"
+	StatusCodes []string `json:"statusCodes,omitempty"`
+
+	// Timeout is the maximum duration of a request to a backend before failing over.
+	// +optional
+	// +kubebuilder:validation:XValidation:message="must be between 1s and 1h",rule="[self.lowerAscii().replace('p', '').replace('t', '').replace(',', '.').findAll(r\"\"\"[0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h|d|w)\"\"\").map(c, double(c.find(r\"\"\"[0-9]+(\\.[0-9]+)?\"\"\")) * {'ns': 1e-9, 'us': 1e-6, 'µs': 1e-6, 'ms': 1e-3, 's': 1.0, 'm': 60.0, 'h': 3600.0, 'd': 86400.0, 'w': 604800.0}[c.find(r\"\"\"[a-zµ]+\"\"\")]).sum()].all(seconds, seconds >= 1.0 && seconds <= 3600.0)"
+	Timeout *Period `json:"timeout,omitempty"`
+}
+
+// +k8s:deepcopy-gen=true
+
+// ModelFilter restricts the models that can be requested.
+type ModelFilter struct {
+	// Allow lists the models that can be requested. When empty, all the models that are not denied can be requested.
+	// +optional
+	// +kubebuilder:validation:MaxItems=100
+	// +kubebuilder:validation:items:MaxLength=253
+	// +kubebuilder:validation:XValidation:message="duplicated models",rule="self.all(x, self.exists_one(y, x == y))"
+	Allow []string `json:"allow,omitempty"`
+
+	// Deny lists the models that cannot be requested. It takes precedence over Allow.
+	// +optional
+	// +kubebuilder:validation:MaxItems=100
+	// +kubebuilder:validation:items:MaxLength=253
+	// +kubebuilder:validation:XValidation:message="duplicated models",rule="self.all(x, self.exists_one(y, x == y))"
+	Deny []string `json:"deny,omitempty"`
+}
+
+// +k8s:deepcopy-gen=true
+
+// ContentGuard checks the content exchanged with the LLM provider.
+// +kubebuilder:validation:XValidation:message="at least one of prompt or response must be specified",rule="has(self.prompt) || has(self.response)"
+type ContentGuard struct {
+	// Prompt checks the prompts before they are sent to the LLM provider.
+	// +optional
+	Prompt *ContentGuardHook `json:"prompt,omitempty"`
+
+	// Response checks the responses before they are returned to the consumer.
+	// +optional
+	Response *ContentGuardHook `json:"response,omitempty"`
+}
+
+// +k8s:deepcopy-gen=true
+
+// ContentGuardHook sends content to a content guard service, which accepts or rejects it.
+type ContentGuardHook struct {
+	// URL is the endpoint of the content guard service.
+	// +kubebuilder:validation:XValidation:message="must be a valid HTTP or HTTPS URL",rule="isURL(self) && url(self).getScheme() in ['http', 'https']"
+	URL string `json:"url"`
+
+	// Timeout is the maximum duration of a call to the content guard service.
+	// +optional
+	// +kubebuilder:validation:XValidation:message="must be between 1s and 1h",rule="[self.lowerAscii().replace('p', '').replace('t', '').replace(',', '.').findAll(r\"\"\"[0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h|d|w)\"\"\").map(c, double(c.find(r\"\"\"[0-9]+(\\.[0-9]+)?\"\"\")) * {'ns': 1e-9, 'us': 1e-6, 'µs': 1e-6, 'ms': 1e-3, 's': 1.0, 'm': 60.0, 'h': 3600.0, 'd': 86400.0, 'w': 604800.0}[c.find(r\"\"\"[a-zµ]+\"\"\")]).sum()].all(seconds, seconds >= 1.0 && seconds <= 3600.0)"
+	Timeout *Period `json:"timeout,omitempty"`
+
+	// FailOpen defines whether the content is let through when the content guard service fails to answer.
+	// By default, the content is rejected.
+	// +optional
+	FailOpen bool `json:"failOpen,omitempty"`
 }
 
 // +k8s:deepcopy-gen=true
 
 // SecretReference references a kubernetes secret.
+// The Secret holds the credentials under the "token" key for the token fields, and the "apiKey" key for the API key fields.
 type SecretReference struct {
 	// +kubebuilder:validation:MaxLength=253
 	SecretName string `json:"secretName"`
@@ -69,11 +237,13 @@ type Anthropic struct {
 
 // AzureOpenAI configures AzureOpenAI.
 type AzureOpenAI struct {
-	APIKey         *SecretReference `json:"apiKeySecret,omitempty"`
-	Model          string           `json:"model,omitempty"`
-	DeploymentName string           `json:"deploymentName"`
-	BaseURL        string           `json:"baseUrl"`
-	Params         *Params          `json:"params,omitempty"`
+	APIKey *SecretReference `json:"apiKeySecret,omitempty"`
+	Model  string           `json:"model,omitempty"`
+	// +kubebuilder:validation:MinLength=1
+	DeploymentName string `json:"deploymentName"`
+	// +kubebuilder:validation:XValidation:message="must be a valid HTTP or HTTPS URL",rule="isURL(self) && url(self).getScheme() in ['http', 'https']"
+	BaseURL string  `json:"baseUrl"`
+	Params  *Params `json:"params,omitempty"`
 }
 
 // +k8s:deepcopy-gen=true
@@ -117,7 +287,8 @@ type Mistral struct {
 
 // Ollama configures Ollama backend.
 type Ollama struct {
-	Model   string  `json:"model,omitempty"`
+	Model string `json:"model,omitempty"`
+	// +kubebuilder:validation:XValidation:message="must be a valid HTTP or HTTPS URL",rule="isURL(self) && url(self).getScheme() in ['http', 'https']"
 	BaseURL string  `json:"baseUrl"`
 	Params  *Params `json:"params,omitempty"`
 }
@@ -126,41 +297,116 @@ type Ollama struct {
 
 // OpenAI configures OpenAI.
 type OpenAI struct {
-	Token   *SecretReference `json:"token,omitempty"`
-	Model   string           `json:"model,omitempty"`
-	BaseURL string           `json:"baseUrl,omitempty"`
-	Params  *Params          `json:"params,omitempty"`
+	Token *SecretReference `json:"token,omitempty"`
+	Model string           `json:"model,omitempty"`
+	// +optional
+	// +kubebuilder:validation:XValidation:message="must be a valid HTTP or HTTPS URL",rule="isURL(self) && url(self).getScheme() in ['http', 'https']"
+	BaseURL string  `json:"baseUrl,omitempty"`
+	Params  *Params `json:"params,omitempty"`
 }
 
 // +k8s:deepcopy-gen=true
 
 // DeepSeek configures DeepSeek.
 type DeepSeek struct {
-	Token   *SecretReference `json:"token,omitempty"`
-	Model   string           `json:"model,omitempty"`
-	BaseURL string           `json:"baseUrl,omitempty"`
-	Params  *Params          `json:"params,omitempty"`
+	Token *SecretReference `json:"token,omitempty"`
+	Model string           `json:"model,omitempty"`
+	// +optional
+	// +kubebuilder:validation:XValidation:message="must be a valid HTTP or HTTPS URL",rule="isURL(self) && url(self).getScheme() in ['http', 'https']"
+	BaseURL string  `json:"baseUrl,omitempty"`
+	Params  *Params `json:"params,omitempty"`
 }
 
 // +k8s:deepcopy-gen=true
 
 // QWen configures QWen.
 type QWen struct {
-	Token   *SecretReference `json:"token,omitempty"`
-	Model   string           `json:"model,omitempty"`
-	BaseURL string           `json:"baseUrl,omitempty"`
-	Params  *Params          `json:"params,omitempty"`
+	Token *SecretReference `json:"token,omitempty"`
+	Model string           `json:"model,omitempty"`
+	// +optional
+	// +kubebuilder:validation:XValidation:message="must be a valid HTTP or HTTPS URL",rule="isURL(self) && url(self).getScheme() in ['http', 'https']"
+	BaseURL string  `json:"baseUrl,omitempty"`
+	Params  *Params `json:"params,omitempty"`
+}
+
+// +k8s:deepcopy-gen=true
+
+// OpenAICompatible configures a provider exposing an OpenAI-compatible API, such as Groq, Together, vLLM, xAI
+// or Hugging Face TGI.
+// +kubebuilder:validation:XValidation:message="authScheme can only be used when authHeader is 'Authorization'",rule="!has(self.authScheme) || !has(self.authHeader) || self.authHeader == 'Authorization'"
+type OpenAICompatible struct {
+	// Provider is the name of a known OpenAI-compatible provider, such as groq, together or xai.
+	// It provides the default BaseURL, and the parameters supported by the provider.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	Provider string `json:"provider,omitempty"`
+
+	// Token references the Secret holding the token sent to the provider.
+	// +optional
+	Token *SecretReference `json:"token,omitempty"`
+
+	// Model is the model to use.
+	// +optional
+	Model string `json:"model,omitempty"`
+
+	// BaseURL is the URL of the OpenAI-compatible API. It is required unless the Provider has a default one.
+	// +optional
+	// +kubebuilder:validation:XValidation:message="must be a valid HTTP or HTTPS URL",rule="isURL(self) && url(self).getScheme() in ['http', 'https']"
+	BaseURL string `json:"baseUrl,omitempty"`
+
+	// AuthHeader is the name of the header carrying the token. Defaults to Authorization.
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	AuthHeader string `json:"authHeader,omitempty"`
+
+	// AuthScheme is the authentication scheme prefixing the token, in the format "<scheme> <token>".
+	// Only applies when authHeader is "Authorization". Defaults to Bearer.
+	// +optional
+	// +kubebuilder:validation:MaxLength=64
+	AuthScheme string `json:"authScheme,omitempty"`
+
+	// Headers are additional headers sent to the provider.
+	// +optional
+	// +kubebuilder:validation:MaxProperties=32
+	Headers map[string]string `json:"headers,omitempty"`
+
+	Params *Params `json:"params,omitempty"`
 }
 
 // +k8s:deepcopy-gen=true
 
 // Params holds the LLM hyperparameters.
 type Params struct {
-	Temperature      float32 `json:"temperature,omitempty"`
-	TopP             float32 `json:"topP,omitempty"`
-	MaxTokens        int     `json:"maxTokens,omitempty"`
+	// Temperature controls the randomness of the responses.
+	// +optional
+	// +kubebuilder:validation:XValidation:message="must be between 0 and 2",rule="self >= 0.0 && self <= 2.0"
+	Temperature float32 `json:"temperature,omitempty"`
+	// TopP restricts the sampling to the tokens whose cumulated probability reaches TopP.
+	// +optional
+	// +kubebuilder:validation:XValidation:message="must be between 0 and 1",rule="self >= 0.0 && self <= 1.0"
+	TopP float32 `json:"topP,omitempty"`
+	// MaxTokens is the maximum number of tokens of a response.
+	// +optional
+	// +kubebuilder:validation:XValidation:message="must be a positive number",rule="self >= 0"
+	MaxTokens int `json:"maxTokens,omitempty"`
+	// FrequencyPenalty penalizes the tokens according to their frequency in the text so far.
+	// +optional
+	// +kubebuilder:validation:XValidation:message="must be between -2 and 2",rule="self >= -2.0 && self <= 2.0"
 	FrequencyPenalty float32 `json:"frequencyPenalty,omitempty"`
-	PresencePenalty  float32 `json:"presencePenalty,omitempty"`
+	// PresencePenalty penalizes the tokens already present in the text so far.
+	// +optional
+	// +kubebuilder:validation:XValidation:message="must be between -2 and 2",rule="self >= -2.0 && self <= 2.0"
+	PresencePenalty float32 `json:"presencePenalty,omitempty"`
+}
+
+// AIServiceStatus is the status of the AIService.
+type AIServiceStatus struct {
+	Version  string       `json:"version,omitempty"`
+	SyncedAt *metav1.Time `json:"syncedAt,omitempty"`
+	// Hash is a hash representing the AIService.
+	Hash string `json:"hash,omitempty"`
+
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
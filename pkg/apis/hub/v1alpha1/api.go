@@ -28,6 +28,7 @@ import (
 // and provides instructions for accessing its documentation. Once instantiated, an API object is associated
 // with an Ingress, IngressRoute, or HTTPRoute resource, enabling the exposure of the described API to the outside world.
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 type API struct {
 	metav1.TypeMeta `json:",inline"`
 	// +optional
@@ -139,7 +140,7 @@ type OpenAPISpec struct {
 
 	// RefreshInterval defines the rate at which the OpenAPI specification is refreshed.
 	// +optional
-	// +kubebuilder:validation:XValidation:message="must be at least 1m",rule="self >= duration('1m')"
+	// +kubebuilder:validation:XValidation:message="must be at least 1m",rule="[self.lowerAscii().replace('p', '').replace('t', '').replace(',', '.').findAll(r\"\"\"[0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h|d|w)\"\"\").map(c, double(c.find(r\"\"\"[0-9]+(\\.[0-9]+)?\"\"\")) * {'ns': 1e-9, 'us': 1e-6, 'µs': 1e-6, 'ms': 1e-3, 's': 1.0, 'm': 60.0, 'h': 3600.0, 'd': 86400.0, 'w': 604800.0}[c.find(r\"\"\"[a-zµ]+\"\"\")]).sum()].all(seconds, seconds >= 60.0)"
 	RefreshInterval *Period `json:"refreshInterval,omitempty"`
 }
 
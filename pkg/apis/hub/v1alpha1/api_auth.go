@@ -26,6 +26,7 @@ import (
 
 // APIAuth defines the authentication configuration for APIs.
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 type APIAuth struct {
 	metav1.TypeMeta `json:",inline"`
 	// +optional
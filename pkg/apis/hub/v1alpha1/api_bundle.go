@@ -26,6 +26,7 @@ import (
 
 // APIBundle defines a set of APIs.
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 type APIBundle struct {
 	metav1.TypeMeta `json:",inline"`
 	// +optional
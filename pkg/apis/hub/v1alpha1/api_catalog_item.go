@@ -26,6 +26,7 @@ import (
 
 // APICatalogItem defines APIs that will be part of the API catalog on the portal.
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 type APICatalogItem struct {
 	metav1.TypeMeta `json:",inline"`
 	// +optional
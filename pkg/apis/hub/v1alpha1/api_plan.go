@@ -26,6 +26,7 @@ import (
 
 // APIPlan defines API Plan policy.
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 type APIPlan struct {
 	metav1.TypeMeta `json:",inline"`
 	// +optional
@@ -55,6 +56,10 @@ type APIPlanSpec struct {
 	// Quota defines the quota policy.
 	// +optional
 	Quota *Quota `json:"quota,omitempty"`
+
+	// TokenQuota defines the quota of LLM tokens, enforced on the AI APIs of the plan.
+	// +optional
+	TokenQuota *TokenQuota `json:"tokenQuota,omitempty"`
 }
 
 // APIPlanStatus is the status of an APIPlan.
@@ -77,7 +82,7 @@ type RateLimit struct {
 	// Period is the time unit used to express the rate.
 	// Combined with Limit, it defines the rate at which request capacity regenerates (Limit ÷ Period).
 	// +optional
-	// +kubebuilder:validation:XValidation:message="must be between 1s and 1h",rule="self >= duration('1s') && self <= duration('1h')"
+	// +kubebuilder:validation:XValidation:message="must be between 1s and 1h",rule="[self.lowerAscii().replace('p', '').replace('t', '').replace(',', '.').findAll(r\"\"\"[0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h|d|w)\"\"\").map(c, double(c.find(r\"\"\"[0-9]+(\\.[0-9]+)?\"\"\")) * {'ns': 1e-9, 'us': 1e-6, 'µs': 1e-6, 'ms': 1e-3, 's': 1.0, 'm': 60.0, 'h': 3600.0, 'd': 86400.0, 'w': 604800.0}[c.find(r\"\"\"[a-zµ]+\"\"\")]).sum()].all(seconds, seconds >= 1.0 && seconds <= 3600.0)"
 	Period *Period `json:"period,omitempty"`
 
 	// Bucket defines the bucket strategy for the rate limit.
@@ -87,6 +92,7 @@ type RateLimit struct {
 	Bucket Bucket `json:"bucket,omitempty"`
 }
 
+// +kubebuilder:validation:XValidation:message="period cannot be set with a calendar-month window",rule="!has(self.period) || !has(self.window) || self.window != 'calendar-month'"
 type Quota struct {
 	// Limit is the maximum number of requests per sliding Period.
 	// +kubebuilder:validation:XValidation:message="must be a positive number",rule="self >= 0"
@@ -94,7 +100,33 @@ type Quota struct {
 
 	// Period is the unit of time for the Limit.
 	// +optional
-	// +kubebuilder:validation:XValidation:message="must be between 1s and 9999h",rule="self >= duration('1s') && self <= duration('9999h')"
+	// +kubebuilder:validation:XValidation:message="must be between 1s and 9999h",rule="[self.lowerAscii().replace('p', '').replace('t', '').replace(',', '.').findAll(r\"\"\"[0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h|d|w)\"\"\").map(c, double(c.find(r\"\"\"[0-9]+(\\.[0-9]+)?\"\"\")) * {'ns': 1e-9, 'us': 1e-6, 'µs': 1e-6, 'ms': 1e-3, 's': 1.0, 'm': 60.0, 'h': 3600.0, 'd': 86400.0, 'w': 604800.0}[c.find(r\"\"\"[a-zµ]+\"\"\")]).sum()].all(seconds, seconds >= 1.0 && seconds <= 35996400.0)"
+	Period *Period `json:"period,omitempty"`
+
+	// Bucket defines the bucket strategy for the quota.
+	// +optional
+	// +kubebuilder:default="subscription"
+	// +kubebuilder:validation:Enum=subscription;application-api;application
+	Bucket Bucket `json:"bucket,omitempty"`
+
+	// Window defines the time window on which the quota applies.
+	// It can be, either "sliding", the default, which counts the requests of the last Period,
+	// or "calendar-month", which counts the requests of the current calendar month in UTC and cannot be combined with a Period.
+	// +optional
+	// +kubebuilder:validation:Enum=sliding;calendar-month
+	Window QuotaWindow `json:"window,omitempty"`
+}
+
+// TokenQuota limits the number of LLM tokens consumed per Period.
+// +kubebuilder:validation:XValidation:message="period cannot be set with a calendar-month window",rule="!has(self.period) || !has(self.window) || self.window != 'calendar-month'"
+type TokenQuota struct {
+	// Limit is the maximum number of tokens per sliding Period.
+	// +kubebuilder:validation:XValidation:message="must be a positive number",rule="self >= 0"
+	Limit int `json:"limit"`
+
+	// Period is the unit of time for the Limit.
+	// +optional
+	// +kubebuilder:validation:XValidation:message="must be between 1s and 9999h",rule="[self.lowerAscii().replace('p', '').replace('t', '').replace(',', '.').findAll(r\"\"\"[0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h|d|w)\"\"\").map(c, double(c.find(r\"\"\"[0-9]+(\\.[0-9]+)?\"\"\")) * {'ns': 1e-9, 'us': 1e-6, 'µs': 1e-6, 'ms': 1e-3, 's': 1.0, 'm': 60.0, 'h': 3600.0, 'd': 86400.0, 'w': 604800.0}[c.find(r\"\"\"[a-zµ]+\"\"\")]).sum()].all(seconds, seconds >= 1.0 && seconds <= 35996400.0)"
 	Period *Period `json:"period,omitempty"`
 
 	// Bucket defines the bucket strategy for the quota.
@@ -102,8 +134,43 @@ type Quota struct {
 	// +kubebuilder:default="subscription"
 	// +kubebuilder:validation:Enum=subscription;application-api;application
 	Bucket Bucket `json:"bucket,omitempty"`
+
+	// Window defines the time window on which the quota applies, as for Quota.
+	// +optional
+	// +kubebuilder:validation:Enum=sliding;calendar-month
+	Window QuotaWindow `json:"window,omitempty"`
+
+	// Tokens defines the tokens counted in the quota.
+	// It can be, either "total", the default, which counts the prompt and the completion tokens,
+	// "input", which only counts the prompt tokens, or "output", which only counts the completion tokens.
+	// +optional
+	// +kubebuilder:default="total"
+	// +kubebuilder:validation:Enum=total;input;output
+	Tokens TokenType `json:"tokens,omitempty"`
 }
 
+// TokenType is a kind of LLM tokens.
+type TokenType string
+
+const (
+	// TokenTypeTotal counts both the prompt and the completion tokens.
+	TokenTypeTotal TokenType = "total"
+	// TokenTypeInput counts the prompt tokens.
+	TokenTypeInput TokenType = "input"
+	// TokenTypeOutput counts the completion tokens.
+	TokenTypeOutput TokenType = "output"
+)
+
+// QuotaWindow is a quota window strategy.
+type QuotaWindow string
+
+const (
+	// QuotaWindowSliding counts the requests of the last Period.
+	QuotaWindowSliding QuotaWindow = "sliding"
+	// QuotaWindowCalendarMonth counts the requests since the beginning of the current calendar month, in UTC.
+	QuotaWindowCalendarMonth QuotaWindow = "calendar-month"
+)
+
 // Bucket is a bucket strategy.
 type Bucket string
 
@@ -26,6 +26,7 @@ import (
 
 // APIPortal defines a developer portal for accessing the documentation of APIs.
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 type APIPortal struct {
 	metav1.TypeMeta `json:",inline"`
 	// +optional
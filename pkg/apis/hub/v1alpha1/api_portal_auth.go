@@ -26,6 +26,7 @@ import (
 
 // APIPortalAuth defines the authentication configuration for an APIPortal.
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 type APIPortalAuth struct {
 	metav1.TypeMeta `json:",inline"`
 	// +optional
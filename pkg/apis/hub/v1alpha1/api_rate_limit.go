@@ -18,12 +18,6 @@ along with this program. If not, see <https://www.gnu.org/licenses/>.
 package v1alpha1
 
 import (
-	"encoding/json"
-	"errors"
-	"fmt"
-	"strings"
-	"time"
-
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -55,7 +49,7 @@ type APIRateLimitSpec struct {
 
 	// Period is the unit of time for the Limit.
 	// +optional
-	// +kubebuilder:validation:XValidation:message="must be between 1s and 1h",rule="self >= duration('1s') && self <= duration('1h')"
+	// +kubebuilder:validation:XValidation:message="must be between 1s and 1h",rule="[self.lowerAscii().replace('p', '').replace('t', '').replace(',', '.').findAll(r\"\"\"[0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h|d|w)\"\"\").map(c, double(c.find(r\"\"\"[0-9]+(\\.[0-9]+)?\"\"\")) * {'ns': 1e-9, 'us': 1e-6, 'µs': 1e-6, 'ms': 1e-3, 's': 1.0, 'm': 60.0, 'h': 3600.0, 'd': 86400.0, 'w': 604800.0}[c.find(r\"\"\"[a-zµ]+\"\"\")]).sum()].all(seconds, seconds >= 1.0 && seconds <= 3600.0)"
 	Period *Period `json:"period,omitempty"`
 
 	// Strategy defines how the bucket state will be synchronized between the different Traefik Hub instances.
@@ -108,81 +102,6 @@ type APIRateLimitStatus struct {
 	Hash string `json:"hash,omitempty"`
 }
 
-// Period describes the time window on which a limit applies.
-// +kubebuilder:validation:Type=string
-// +kubebuilder:validation:Format=duration
-type Period time.Duration
-
-// NewPeriod creates a new Period.
-func NewPeriod(d time.Duration) *Period {
-	p := Period(d)
-
-	return &p
-}
-
-// Seconds returns the period in seconds.
-func (p *Period) Seconds() float64 {
-	if p == nil {
-		return 0
-	}
-
-	return time.Duration(*p).Seconds()
-}
-
-// IsZero checks whether the period is a zero-value Period.
-func (p *Period) IsZero() bool {
-	return p == nil || time.Duration(*p) == 0
-}
-
-// MarshalJSON marshals the Period.
-func (p *Period) MarshalJSON() ([]byte, error) {
-	if p == nil {
-		return []byte("null"), nil
-	}
-
-	return json.Marshal(toStringShortDuration(time.Duration(*p)))
-}
-
-// UnmarshalJSON unmarshals the buffer into a Period.
-func (p *Period) UnmarshalJSON(b []byte) error {
-	var rawValue any
-	if err := json.Unmarshal(b, &rawValue); err != nil {
-		return err
-	}
-
-	if value, ok := rawValue.(string); ok {
-		duration, err := time.ParseDuration(value)
-		if err != nil {
-			return fmt.Errorf("parse: %w", err)
-		}
-
-		*p = Period(duration)
-
-		return nil
-	}
-
-	return errors.New("invalid period")
-}
-
-func (p *Period) String() string {
-	return toStringShortDuration(time.Duration(*p))
-}
-
-// toStringShortDuration stringifies the given duration in it's shorted form.
-// Contrary to Go standard stringifier, here time.Minute will become "1m" instead of "1m0s".
-func toStringShortDuration(duration time.Duration) string {
-	short := duration.String()
-	if strings.HasSuffix(short, "m0s") {
-		short = short[:len(short)-2]
-	}
-
-	if strings.HasSuffix(short, "h0m") {
-		short = short[:len(short)-2]
-	}
-
-	return short
-}
-
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // APIRateLimitList defines a list of APIRateLimits.
@@ -28,6 +28,7 @@ import (
 // +kubebuilder:printcolumn:name="Title",type=string,JSONPath=`.spec.title`
 // +kubebuilder:printcolumn:name="Release",type=string,JSONPath=`.spec.release`
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 type APIVersion struct {
 	metav1.TypeMeta `json:",inline"`
 	// +optional
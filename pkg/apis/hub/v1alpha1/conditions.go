@@ -0,0 +1,195 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// Condition types reported in the status of Hub objects.
+const (
+	// ConditionReady indicates whether the object is fully processed and in use.
+	// It aggregates all the other conditions of the object.
+	ConditionReady = "Ready"
+	// ConditionSynced indicates whether the latest spec of the object has been synced with the platform.
+	ConditionSynced = "Synced"
+	// ConditionReferencesResolved indicates whether all the objects referenced by the object exist.
+	ConditionReferencesResolved = "ReferencesResolved"
+	// ConditionCredentialsResolved indicates whether the credentials of an AIService provider are available.
+	ConditionCredentialsResolved = "CredentialsResolved"
+	// ConditionModelAvailable indicates whether the model of an AIService is served by its provider.
+	ConditionModelAvailable = "ModelAvailable"
+)
+
+// Condition reasons reported in the status of Hub objects.
+const (
+	// ReasonReady is used when all the conditions of the object are true.
+	ReasonReady = "Ready"
+	// ReasonPending is used when the object has not been processed yet, or not in its latest generation.
+	ReasonPending = "Pending"
+	// ReasonSynced is used when the latest spec of the object has been synced.
+	ReasonSynced = "Synced"
+	// ReasonSyncFailed is used when the object could not be synced with the platform.
+	ReasonSyncFailed = "SyncFailed"
+	// ReasonResolved is used when all the referenced objects exist.
+	ReasonResolved = "Resolved"
+	// ReasonReferenceNotFound is used when at least one referenced object doesn't exist.
+	ReasonReferenceNotFound = "ReferenceNotFound"
+	// ReasonInvalidSpec is used when the spec of the object is rejected by the platform.
+	ReasonInvalidSpec = "InvalidSpec"
+	// ReasonSecretNotFound is used when a Secret holding the credentials of an AIService doesn't exist.
+	ReasonSecretNotFound = "SecretNotFound"
+	// ReasonSecretKeyNotFound is used when a Secret holding the credentials of an AIService lacks the expected key.
+	ReasonSecretKeyNotFound = "SecretKeyNotFound"
+	// ReasonCredentialsRejected is used when the provider of an AIService rejects its credentials.
+	ReasonCredentialsRejected = "CredentialsRejected"
+	// ReasonModelAvailable is used when the model of an AIService is listed by its provider.
+	ReasonModelAvailable = "ModelAvailable"
+	// ReasonModelNotFound is used when the model of an AIService is not listed by its provider.
+	ReasonModelNotFound = "ModelNotFound"
+	// ReasonProviderUnreachable is used when the provider of an AIService cannot be reached.
+	ReasonProviderUnreachable = "ProviderUnreachable"
+)
+
+// GetConditions returns the status conditions of the AIService.
+func (in *AIService) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the AIService.
+func (in *AIService) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the API.
+func (in *API) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the API.
+func (in *API) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the APIAuth.
+func (in *APIAuth) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the APIAuth.
+func (in *APIAuth) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the APIBundle.
+func (in *APIBundle) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the APIBundle.
+func (in *APIBundle) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the APICatalogItem.
+func (in *APICatalogItem) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the APICatalogItem.
+func (in *APICatalogItem) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the APIPlan.
+func (in *APIPlan) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the APIPlan.
+func (in *APIPlan) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the APIPortal.
+func (in *APIPortal) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the APIPortal.
+func (in *APIPortal) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the APIPortalAuth.
+func (in *APIPortalAuth) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the APIPortalAuth.
+func (in *APIPortalAuth) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the APIVersion.
+func (in *APIVersion) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the APIVersion.
+func (in *APIVersion) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the ContentItem.
+func (in *ContentItem) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the ContentItem.
+func (in *ContentItem) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the ManagedApplication.
+func (in *ManagedApplication) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the ManagedApplication.
+func (in *ManagedApplication) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the ManagedSubscription.
+func (in *ManagedSubscription) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the ManagedSubscription.
+func (in *ManagedSubscription) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the Uplink.
+func (in *Uplink) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the Uplink.
+func (in *Uplink) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
@@ -26,6 +26,7 @@ import (
 
 // ContentItem defines additional documentation for given resource.
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 type ContentItem struct {
 	metav1.TypeMeta `json:",inline"`
 	// +optional
@@ -0,0 +1,57 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package v1alpha1
+
+// v1alpha1 is the storage version of the kinds served in several versions, and thereby the hub
+// every other version converts to and from.
+
+// Hub marks this type as a conversion hub.
+func (*API) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*APIAuth) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*APIBundle) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*APICatalogItem) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*APIPlan) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*APIPortal) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*APIPortalAuth) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*APIVersion) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ContentItem) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ManagedApplication) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ManagedSubscription) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*Uplink) Hub() {}
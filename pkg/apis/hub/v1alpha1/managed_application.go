@@ -26,6 +26,7 @@ import (
 
 // ManagedApplication represents a managed application.
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 type ManagedApplication struct {
 	metav1.TypeMeta `json:",inline"`
 	// +optional
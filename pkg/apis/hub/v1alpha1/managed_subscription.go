@@ -27,6 +27,7 @@ import (
 // ManagedSubscription defines a Subscription managed by the API manager as the result of a pre-negotiation with its
 // API consumers. This subscription grant consuming access to a set of APIs to a set of Applications.
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 type ManagedSubscription struct {
 	metav1.TypeMeta `json:",inline"`
 	// +optional
@@ -105,6 +106,7 @@ type ManagedSubscriptionSpec struct {
 
 	// Claims specifies an expression that validate claims in order to authorize the request.
 	// +optional
+	// +kubebuilder:validation:MaxLength=4096
 	Claims string `json:"claims,omitempty"`
 }
 
@@ -0,0 +1,192 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Period describes the time window on which a limit applies.
+// It is written as a Go duration ("90s", "1h30m"), optionally using the "d" (24h) and "w" (7d) units ("30d", "1w2d"),
+// or as an ISO-8601 duration without years and months ("P30D", "PT1H30M", "P1DT12H").
+// +kubebuilder:validation:Type=string
+// +kubebuilder:validation:MaxLength=32
+// +kubebuilder:validation:XValidation:message="must be a duration such as 90s, 1h30m, 30d, 2w or P1DT12H",rule="self.matches(r\"\"\"^(0|([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h|d|w))+)$\"\"\") || (self.matches(r\"\"\"^P([0-9]+([.,][0-9]+)?W)?([0-9]+([.,][0-9]+)?D)?(T([0-9]+([.,][0-9]+)?H)?([0-9]+([.,][0-9]+)?M)?([0-9]+([.,][0-9]+)?S)?)?$\"\"\") && self != 'P' && !self.endsWith('T'))"
+type Period time.Duration
+
+// Units of a Period on top of the Go duration units.
+const (
+	day  = 24 * time.Hour
+	week = 7 * day
+)
+
+var isoPeriod = regexp.MustCompile(`^P(?:([0-9]+(?:[.,][0-9]+)?)W)?(?:([0-9]+(?:[.,][0-9]+)?)D)?(?:T(?:([0-9]+(?:[.,][0-9]+)?)H)?(?:([0-9]+(?:[.,][0-9]+)?)M)?(?:([0-9]+(?:[.,][0-9]+)?)S)?)?$`)
+
+// NewPeriod creates a new Period.
+func NewPeriod(d time.Duration) *Period {
+	p := Period(d)
+
+	return &p
+}
+
+// ParsePeriod parses a Period written as a Go duration, optionally using the "d" and "w" units,
+// or as an ISO-8601 duration without years and months.
+func ParsePeriod(value string) (Period, error) {
+	if strings.HasPrefix(value, "P") {
+		return parseISOPeriod(value)
+	}
+
+	// Replace the day and week units by their value in hours, time.ParseDuration summing repeated units.
+	var (
+		goDuration strings.Builder
+		number     strings.Builder
+	)
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+
+		switch {
+		case c >= '0' && c <= '9' || c == '.':
+			number.WriteByte(c)
+		case c == 'd' || c == 'w':
+			hours, err := strconv.ParseFloat(number.String(), 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid period %q", value)
+			}
+			if c == 'w' {
+				hours *= 7
+			}
+
+			goDuration.WriteString(strconv.FormatFloat(hours*24, 'f', -1, 64) + "h")
+			number.Reset()
+		default:
+			goDuration.WriteString(number.String())
+			goDuration.WriteByte(c)
+			number.Reset()
+		}
+	}
+	goDuration.WriteString(number.String())
+
+	duration, err := time.ParseDuration(goDuration.String())
+	if err != nil {
+		return 0, fmt.Errorf("invalid period %q", value)
+	}
+
+	return Period(duration), nil
+}
+
+func parseISOPeriod(value string) (Period, error) {
+	matches := isoPeriod.FindStringSubmatch(value)
+	if matches == nil || value == "P" || strings.HasSuffix(value, "T") {
+		return 0, fmt.Errorf("invalid ISO-8601 period %q, years and months are not supported", value)
+	}
+
+	units := []time.Duration{week, day, time.Hour, time.Minute, time.Second}
+
+	var duration time.Duration
+	for i, unit := range units {
+		if matches[i+1] == "" {
+			continue
+		}
+
+		amount, err := strconv.ParseFloat(strings.Replace(matches[i+1], ",", ".", 1), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid ISO-8601 period %q", value)
+		}
+
+		// Durations are limited to about 292 years.
+		if amount*float64(unit) >= float64(math.MaxInt64-duration) {
+			return 0, fmt.Errorf("ISO-8601 period %q is out of range", value)
+		}
+
+		duration += time.Duration(amount * float64(unit))
+	}
+
+	return Period(duration), nil
+}
+
+// Seconds returns the period in seconds.
+func (p *Period) Seconds() float64 {
+	if p == nil {
+		return 0
+	}
+
+	return time.Duration(*p).Seconds()
+}
+
+// IsZero checks whether the period is a zero-value Period.
+func (p *Period) IsZero() bool {
+	return p == nil || time.Duration(*p) == 0
+}
+
+// MarshalJSON marshals the Period.
+func (p *Period) MarshalJSON() ([]byte, error) {
+	if p == nil {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(p.String())
+}
+
+// UnmarshalJSON unmarshals the buffer into a Period.
+func (p *Period) UnmarshalJSON(b []byte) error {
+	var rawValue any
+	if err := json.Unmarshal(b, &rawValue); err != nil {
+		return err
+	}
+
+	if value, ok := rawValue.(string); ok {
+		period, err := ParsePeriod(value)
+		if err != nil {
+			return fmt.Errorf("parse: %w", err)
+		}
+
+		*p = period
+
+		return nil
+	}
+
+	return errors.New("invalid period")
+}
+
+// String returns the period as a Go duration in its shortest form, such as "720h" for a period written "30d",
+// so that it can be parsed with time.ParseDuration.
+func (p *Period) String() string {
+	return toStringShortDuration(time.Duration(*p))
+}
+
+// toStringShortDuration stringifies the given duration in it's shorted form.
+// Contrary to Go standard stringifier, here time.Minute will become "1m" instead of "1m0s".
+func toStringShortDuration(duration time.Duration) string {
+	short := duration.String()
+	if strings.HasSuffix(short, "m0s") {
+		short = short[:len(short)-2]
+	}
+
+	if strings.HasSuffix(short, "h0m") {
+		short = short[:len(short)-2]
+	}
+
+	return short
+}
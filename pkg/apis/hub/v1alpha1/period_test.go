@@ -0,0 +1,131 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package v1alpha1_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+)
+
+func TestParsePeriod(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc    string
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{desc: "Go duration", value: "1h30m", want: 90 * time.Minute},
+		{desc: "fractional Go duration", value: "1.5h", want: 90 * time.Minute},
+		{desc: "zero", value: "0", want: 0},
+		{desc: "days", value: "30d", want: 720 * time.Hour},
+		{desc: "weeks", value: "2w", want: 336 * time.Hour},
+		{desc: "fractional days", value: "1.5d", want: 36 * time.Hour},
+		{desc: "combined units", value: "1w2d3h4m5s", want: 219*time.Hour + 4*time.Minute + 5*time.Second},
+		{desc: "ISO-8601 days", value: "P30D", want: 720 * time.Hour},
+		{desc: "ISO-8601 weeks", value: "P1W", want: 168 * time.Hour},
+		{desc: "ISO-8601 time", value: "PT1H30M", want: 90 * time.Minute},
+		{desc: "ISO-8601 date and time", value: "P1DT12H", want: 36 * time.Hour},
+		{desc: "ISO-8601 fractional seconds", value: "PT1,5S", want: 1500 * time.Millisecond},
+		{desc: "invalid unit", value: "1y", wantErr: true},
+		{desc: "missing unit", value: "30", wantErr: true},
+		{desc: "missing number", value: "d", wantErr: true},
+		{desc: "ISO-8601 months", value: "P1M", wantErr: true},
+		{desc: "ISO-8601 years", value: "P1Y", wantErr: true},
+		{desc: "empty ISO-8601", value: "P", wantErr: true},
+		{desc: "empty ISO-8601 time", value: "P1DT", wantErr: true},
+		{desc: "out of range weeks", value: "99999999999w", wantErr: true},
+		{desc: "out of range ISO-8601 weeks", value: "P99999999999W", wantErr: true},
+		{desc: "out of range ISO-8601 sum", value: "P15000WT100000000H", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := hubv1alpha1.ParsePeriod(test.value)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, hubv1alpha1.Period(test.want), got)
+		})
+	}
+}
+
+func TestPeriod_String(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		period time.Duration
+		want   string
+	}{
+		{period: 500 * time.Millisecond, want: "500ms"},
+		{period: time.Minute, want: "1m"},
+		{period: 90 * time.Minute, want: "1h30m"},
+		{period: 23 * time.Hour, want: "23h"},
+		{period: 24 * time.Hour, want: "24h"},
+		{period: 36 * time.Hour, want: "36h"},
+		{period: 168 * time.Hour, want: "168h"},
+		{period: 720 * time.Hour, want: "720h"},
+		{period: 9999 * time.Hour, want: "9999h"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.want, func(t *testing.T) {
+			t.Parallel()
+
+			period := hubv1alpha1.Period(test.period)
+			assert.Equal(t, test.want, period.String())
+
+			parsed, err := time.ParseDuration(period.String())
+			require.NoError(t, err)
+			assert.Equal(t, test.period, parsed)
+		})
+	}
+}
+
+func TestPeriod_JSON(t *testing.T) {
+	t.Parallel()
+
+	var quota hubv1alpha1.Quota
+	require.NoError(t, json.Unmarshal([]byte(`{"limit":1000,"period":"P30D"}`), &quota))
+	assert.Equal(t, hubv1alpha1.NewPeriod(720*time.Hour), quota.Period)
+
+	got, err := json.Marshal(quota)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"limit":1000,"period":"720h"}`, string(got))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"limit":1000,"period":30}`), &quota))
+}
+
+func TestPeriod_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	got, err := json.Marshal(hubv1alpha1.NewPeriod(720 * time.Hour))
+	require.NoError(t, err)
+	assert.JSONEq(t, `"720h"`, string(got))
+}
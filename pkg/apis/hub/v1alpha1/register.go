@@ -31,6 +31,8 @@ var SchemeGroupVersion = kschema.GroupVersion{
 
 var (
 	schemeBuilder = runtime.NewSchemeBuilder(addKnownTypes)
+	// SchemeBuilder collects the functions registering the v1alpha1 types to a scheme.
+	SchemeBuilder = &schemeBuilder
 	// AddToScheme applies the SchemeBuilder functions to a specified scheme.
 	AddToScheme = schemeBuilder.AddToScheme
 )
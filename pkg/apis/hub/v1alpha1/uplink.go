@@ -28,6 +28,7 @@ import (
 // Uplink is an inter-cluster service advertisement: a child cluster declares an Uplink to advertise
 // to a parent cluster that it can handle a particular workload.
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 type Uplink struct {
 	metav1.TypeMeta `json:",inline"`
 	// +optional
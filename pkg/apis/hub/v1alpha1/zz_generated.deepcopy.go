@@ -37,6 +37,7 @@ func (in *AIService) DeepCopyInto(out *AIService) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -58,6 +59,64 @@ func (in *AIService) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIServiceBackend) DeepCopyInto(out *AIServiceBackend) {
+	*out = *in
+	if in.AIService != nil {
+		in, out := &in.AIService, &out.AIService
+		*out = new(AIServiceReference)
+		**out = **in
+	}
+	in.AIServiceProvider.DeepCopyInto(&out.AIServiceProvider)
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int)
+		**out = **in
+	}
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = new(Params)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIServiceBackend.
+func (in *AIServiceBackend) DeepCopy() *AIServiceBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(AIServiceBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIServiceFailover) DeepCopyInto(out *AIServiceFailover) {
+	*out = *in
+	if in.StatusCodes != nil {
+		in, out := &in.StatusCodes, &out.StatusCodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(Period)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIServiceFailover.
+func (in *AIServiceFailover) DeepCopy() *AIServiceFailover {
+	if in == nil {
+		return nil
+	}
+	out := new(AIServiceFailover)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AIServiceList) DeepCopyInto(out *AIServiceList) {
 	*out = *in
@@ -92,7 +151,7 @@ func (in *AIServiceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIServiceSpec) DeepCopyInto(out *AIServiceSpec) {
+func (in *AIServiceProvider) DeepCopyInto(out *AIServiceProvider) {
 	*out = *in
 	if in.Anthropic != nil {
 		in, out := &in.Anthropic, &out.Anthropic
@@ -144,6 +203,94 @@ func (in *AIServiceSpec) DeepCopyInto(out *AIServiceSpec) {
 		*out = new(QWen)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OpenAICompatible != nil {
+		in, out := &in.OpenAICompatible, &out.OpenAICompatible
+		*out = new(OpenAICompatible)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIServiceProvider.
+func (in *AIServiceProvider) DeepCopy() *AIServiceProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(AIServiceProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIServiceReference) DeepCopyInto(out *AIServiceReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIServiceReference.
+func (in *AIServiceReference) DeepCopy() *AIServiceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(AIServiceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIServiceRouting) DeepCopyInto(out *AIServiceRouting) {
+	*out = *in
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make([]AIServiceBackend, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = new(AIServiceFailover)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIServiceRouting.
+func (in *AIServiceRouting) DeepCopy() *AIServiceRouting {
+	if in == nil {
+		return nil
+	}
+	out := new(AIServiceRouting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIServiceSpec) DeepCopyInto(out *AIServiceSpec) {
+	*out = *in
+	in.AIServiceProvider.DeepCopyInto(&out.AIServiceProvider)
+	if in.Routing != nil {
+		in, out := &in.Routing, &out.Routing
+		*out = new(AIServiceRouting)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TokenBudgets != nil {
+		in, out := &in.TokenBudgets, &out.TokenBudgets
+		*out = make([]TokenQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Models != nil {
+		in, out := &in.Models, &out.Models
+		*out = new(ModelFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContentGuard != nil {
+		in, out := &in.ContentGuard, &out.ContentGuard
+		*out = new(ContentGuard)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -157,6 +304,33 @@ func (in *AIServiceSpec) DeepCopy() *AIServiceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIServiceStatus) DeepCopyInto(out *AIServiceStatus) {
+	*out = *in
+	if in.SyncedAt != nil {
+		in, out := &in.SyncedAt, &out.SyncedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIServiceStatus.
+func (in *AIServiceStatus) DeepCopy() *AIServiceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AIServiceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *API) DeepCopyInto(out *API) {
 	*out = *in
@@ -764,6 +938,11 @@ func (in *APIPlanSpec) DeepCopyInto(out *APIPlanSpec) {
 		*out = new(Quota)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TokenQuota != nil {
+		in, out := &in.TokenQuota, &out.TokenQuota
+		*out = new(TokenQuota)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1907,6 +2086,53 @@ func (in *Cohere) DeepCopy() *Cohere {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentGuard) DeepCopyInto(out *ContentGuard) {
+	*out = *in
+	if in.Prompt != nil {
+		in, out := &in.Prompt, &out.Prompt
+		*out = new(ContentGuardHook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Response != nil {
+		in, out := &in.Response, &out.Response
+		*out = new(ContentGuardHook)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentGuard.
+func (in *ContentGuard) DeepCopy() *ContentGuard {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentGuard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentGuardHook) DeepCopyInto(out *ContentGuardHook) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(Period)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentGuardHook.
+func (in *ContentGuardHook) DeepCopy() *ContentGuardHook {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentGuardHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContentItem) DeepCopyInto(out *ContentItem) {
 	*out = *in
@@ -2562,6 +2788,32 @@ func (in *Mistral) DeepCopy() *Mistral {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelFilter) DeepCopyInto(out *ModelFilter) {
+	*out = *in
+	if in.Allow != nil {
+		in, out := &in.Allow, &out.Allow
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Deny != nil {
+		in, out := &in.Deny, &out.Deny
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelFilter.
+func (in *ModelFilter) DeepCopy() *ModelFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OIDCConfig) DeepCopyInto(out *OIDCConfig) {
 	*out = *in
@@ -2666,6 +2918,39 @@ func (in *OpenAI) DeepCopy() *OpenAI {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenAICompatible) DeepCopyInto(out *OpenAICompatible) {
+	*out = *in
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = new(Params)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenAICompatible.
+func (in *OpenAICompatible) DeepCopy() *OpenAICompatible {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenAICompatible)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenAPISpec) DeepCopyInto(out *OpenAPISpec) {
 	*out = *in
@@ -3016,6 +3301,27 @@ func (in *StateCookie) DeepCopy() *StateCookie {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenQuota) DeepCopyInto(out *TokenQuota) {
+	*out = *in
+	if in.Period != nil {
+		in, out := &in.Period, &out.Period
+		*out = new(Period)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenQuota.
+func (in *TokenQuota) DeepCopy() *TokenQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TokenSource) DeepCopyInto(out *TokenSource) {
 	*out = *in
@@ -0,0 +1,96 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// APIBundle defines a set of APIs.
+// +kubebuilder:subresource:status
+// +kubebuilder:unservedversion
+type APIBundle struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The desired behavior of this APIBundle.
+	Spec APIBundleSpec `json:"spec,omitempty"`
+
+	// The current status of this APIBundle.
+	// +optional
+	Status APIBundleStatus `json:"status,omitempty"`
+}
+
+// APIBundleSpec configures an APIBundle.
+type APIBundleSpec struct {
+	// Title is the human-readable name of the APIBundle that will be used on the portal.
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	Title string `json:"title,omitempty"`
+
+	// APISelector selects the APIs that will be accessible to the configured audience.
+	// Multiple APIBundles can select the same set of APIs.
+	// This field is optional and follows standard label selector semantics.
+	// An empty APISelector matches any API.
+	// +optional
+	APISelector *metav1.LabelSelector `json:"apiSelector,omitempty"`
+
+	// APIs defines a set of APIs that will be accessible to the configured audience.
+	// Multiple APIBundles can select the same APIs.
+	// When combined with APISelector, this set of APIs is appended to the matching APIs.
+	// +optional
+	// +kubebuilder:validation:MaxItems=100
+	// +kubebuilder:validation:XValidation:message="duplicated apis",rule="self.all(x, self.exists_one(y, x.name == y.name))"
+	APIs []APIReference `json:"apis,omitempty"`
+}
+
+// APIBundleStatus is the status of an APIBundle.
+type APIBundleStatus struct {
+	Version  string       `json:"version,omitempty"`
+	SyncedAt *metav1.Time `json:"syncedAt,omitempty"`
+
+	// Hash is a hash representing the APIBundle.
+	Hash string `json:"hash,omitempty"`
+
+	// Conditions is the list of status conditions.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ResolvedAPIs is the list of APIs that were successfully resolved.
+	// +optional
+	ResolvedAPIs []ResolvedAPIReference `json:"resolvedApis,omitempty"`
+
+	// UnresolvedAPIs is the list of APIs that could not be resolved.
+	// +optional
+	UnresolvedAPIs []ResolvedAPIReference `json:"unresolvedApis,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// APIBundleList defines a list of APIBundles.
+type APIBundleList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []APIBundle `json:"items"`
+}
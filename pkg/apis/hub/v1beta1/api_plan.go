@@ -0,0 +1,198 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// APIPlan defines API Plan policy.
+// +kubebuilder:subresource:status
+// +kubebuilder:unservedversion
+type APIPlan struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The desired behavior of this APIPlan.
+	Spec APIPlanSpec `json:"spec,omitempty"`
+
+	// The current status of this APIPlan.
+	// +optional
+	Status APIPlanStatus `json:"status,omitempty"`
+}
+
+// APIPlanSpec configures an APIPlan.
+type APIPlanSpec struct {
+	// Title is the human-readable name of the plan.
+	Title string `json:"title"`
+
+	// Description describes the plan.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// RateLimit defines the rate limit policy.
+	// +optional
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+
+	// Quota defines the quota policy.
+	// +optional
+	Quota *Quota `json:"quota,omitempty"`
+
+	// TokenQuota defines the quota of LLM tokens, enforced on the AI APIs of the plan.
+	// +optional
+	TokenQuota *TokenQuota `json:"tokenQuota,omitempty"`
+}
+
+// APIPlanStatus is the status of an APIPlan.
+type APIPlanStatus struct {
+	Version  string       `json:"version,omitempty"`
+	SyncedAt *metav1.Time `json:"syncedAt,omitempty"`
+
+	// Hash is a hash representing the APIPlan.
+	Hash string `json:"hash,omitempty"`
+
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+type RateLimit struct {
+	// Limit is the number of requests per Period used to calculate the regeneration rate.
+	// Traffic will converge to this rate over time by delaying requests when possible, and dropping them when throttling alone is not enough.
+	// +kubebuilder:validation:XValidation:message="must be a positive number",rule="self >= 0"
+	Limit int `json:"limit"`
+
+	// Period is the time unit used to express the rate.
+	// Combined with Limit, it defines the rate at which request capacity regenerates (Limit ÷ Period).
+	// +optional
+	// +kubebuilder:validation:XValidation:message="must be between 1s and 1h",rule="[self.lowerAscii().replace('p', '').replace('t', '').replace(',', '.').findAll(r\"\"\"[0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h|d|w)\"\"\").map(c, double(c.find(r\"\"\"[0-9]+(\\.[0-9]+)?\"\"\")) * {'ns': 1e-9, 'us': 1e-6, 'µs': 1e-6, 'ms': 1e-3, 's': 1.0, 'm': 60.0, 'h': 3600.0, 'd': 86400.0, 'w': 604800.0}[c.find(r\"\"\"[a-zµ]+\"\"\")]).sum()].all(seconds, seconds >= 1.0 && seconds <= 3600.0)"
+	Period *Period `json:"period,omitempty"`
+
+	// Bucket defines the bucket strategy for the rate limit.
+	// +optional
+	// +kubebuilder:default="subscription"
+	// +kubebuilder:validation:Enum=subscription;application-api;application
+	Bucket Bucket `json:"bucket,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:message="period cannot be set with a calendar-month window",rule="!has(self.period) || !has(self.window) || self.window != 'calendar-month'"
+type Quota struct {
+	// Limit is the maximum number of requests per sliding Period.
+	// +kubebuilder:validation:XValidation:message="must be a positive number",rule="self >= 0"
+	Limit int `json:"limit"`
+
+	// Period is the unit of time for the Limit.
+	// +optional
+	// +kubebuilder:validation:XValidation:message="must be between 1s and 9999h",rule="[self.lowerAscii().replace('p', '').replace('t', '').replace(',', '.').findAll(r\"\"\"[0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h|d|w)\"\"\").map(c, double(c.find(r\"\"\"[0-9]+(\\.[0-9]+)?\"\"\")) * {'ns': 1e-9, 'us': 1e-6, 'µs': 1e-6, 'ms': 1e-3, 's': 1.0, 'm': 60.0, 'h': 3600.0, 'd': 86400.0, 'w': 604800.0}[c.find(r\"\"\"[a-zµ]+\"\"\")]).sum()].all(seconds, seconds >= 1.0 && seconds <= 35996400.0)"
+	Period *Period `json:"period,omitempty"`
+
+	// Bucket defines the bucket strategy for the quota.
+	// +optional
+	// +kubebuilder:default="subscription"
+	// +kubebuilder:validation:Enum=subscription;application-api;application
+	Bucket Bucket `json:"bucket,omitempty"`
+
+	// Window defines the time window on which the quota applies.
+	// It can be, either "sliding", the default, which counts the requests of the last Period,
+	// or "calendar-month", which counts the requests of the current calendar month in UTC and cannot be combined with a Period.
+	// +optional
+	// +kubebuilder:validation:Enum=sliding;calendar-month
+	Window QuotaWindow `json:"window,omitempty"`
+}
+
+// TokenQuota limits the number of LLM tokens consumed per Period.
+// +kubebuilder:validation:XValidation:message="period cannot be set with a calendar-month window",rule="!has(self.period) || !has(self.window) || self.window != 'calendar-month'"
+type TokenQuota struct {
+	// Limit is the maximum number of tokens per sliding Period.
+	// +kubebuilder:validation:XValidation:message="must be a positive number",rule="self >= 0"
+	Limit int `json:"limit"`
+
+	// Period is the unit of time for the Limit.
+	// +optional
+	// +kubebuilder:validation:XValidation:message="must be between 1s and 9999h",rule="[self.lowerAscii().replace('p', '').replace('t', '').replace(',', '.').findAll(r\"\"\"[0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h|d|w)\"\"\").map(c, double(c.find(r\"\"\"[0-9]+(\\.[0-9]+)?\"\"\")) * {'ns': 1e-9, 'us': 1e-6, 'µs': 1e-6, 'ms': 1e-3, 's': 1.0, 'm': 60.0, 'h': 3600.0, 'd': 86400.0, 'w': 604800.0}[c.find(r\"\"\"[a-zµ]+\"\"\")]).sum()].all(seconds, seconds >= 1.0 && seconds <= 35996400.0)"
+	Period *Period `json:"period,omitempty"`
+
+	// Bucket defines the bucket strategy for the quota.
+	// +optional
+	// +kubebuilder:default="subscription"
+	// +kubebuilder:validation:Enum=subscription;application-api;application
+	Bucket Bucket `json:"bucket,omitempty"`
+
+	// Window defines the time window on which the quota applies, as for Quota.
+	// +optional
+	// +kubebuilder:validation:Enum=sliding;calendar-month
+	Window QuotaWindow `json:"window,omitempty"`
+
+	// Tokens defines the tokens counted in the quota.
+	// It can be, either "total", the default, which counts the prompt and the completion tokens,
+	// "input", which only counts the prompt tokens, or "output", which only counts the completion tokens.
+	// +optional
+	// +kubebuilder:default="total"
+	// +kubebuilder:validation:Enum=total;input;output
+	Tokens TokenType `json:"tokens,omitempty"`
+}
+
+// TokenType is a kind of LLM tokens.
+type TokenType string
+
+const (
+	// TokenTypeTotal counts both the prompt and the completion tokens.
+	TokenTypeTotal TokenType = "total"
+	// TokenTypeInput counts the prompt tokens.
+	TokenTypeInput TokenType = "input"
+	// TokenTypeOutput counts the completion tokens.
+	TokenTypeOutput TokenType = "output"
+)
+
+// QuotaWindow is a quota window strategy.
+type QuotaWindow string
+
+const (
+	// QuotaWindowSliding counts the requests of the last Period.
+	QuotaWindowSliding QuotaWindow = "sliding"
+	// QuotaWindowCalendarMonth counts the requests since the beginning of the current calendar month, in UTC.
+	QuotaWindowCalendarMonth QuotaWindow = "calendar-month"
+)
+
+// Bucket is a bucket strategy.
+type Bucket string
+
+const (
+	// BucketSubscription shares the rate limit or quota across all APIs and applications
+	// within the same subscription, providing a global limit for the entire subscription.
+	BucketSubscription Bucket = "subscription"
+	// BucketApplicationAPI creates separate rate limit or quota buckets for each unique
+	// combination of application and API, allowing fine-grained control per app-API pair.
+	BucketApplicationAPI Bucket = "application-api"
+	// BucketApplication creates a single rate limit or quota bucket per application,
+	// shared across all APIs that the application calls.
+	BucketApplication Bucket = "application"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// APIPlanList defines a list of APIPlans.
+type APIPlanList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []APIPlan `json:"items"`
+}
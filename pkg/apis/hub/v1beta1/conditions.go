@@ -0,0 +1,158 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package v1beta1
+
+import (
+	"github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Condition types and reasons are shared by all the versions, see v1alpha1.
+const (
+	ConditionReady              = v1alpha1.ConditionReady
+	ConditionSynced             = v1alpha1.ConditionSynced
+	ConditionReferencesResolved = v1alpha1.ConditionReferencesResolved
+
+	ReasonReady             = v1alpha1.ReasonReady
+	ReasonPending           = v1alpha1.ReasonPending
+	ReasonSynced            = v1alpha1.ReasonSynced
+	ReasonSyncFailed        = v1alpha1.ReasonSyncFailed
+	ReasonResolved          = v1alpha1.ReasonResolved
+	ReasonReferenceNotFound = v1alpha1.ReasonReferenceNotFound
+	ReasonInvalidSpec       = v1alpha1.ReasonInvalidSpec
+)
+
+// GetConditions returns the status conditions of the API.
+func (in *API) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the API.
+func (in *API) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the APIAuth.
+func (in *APIAuth) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the APIAuth.
+func (in *APIAuth) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the APIBundle.
+func (in *APIBundle) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the APIBundle.
+func (in *APIBundle) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the APICatalogItem.
+func (in *APICatalogItem) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the APICatalogItem.
+func (in *APICatalogItem) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the APIPlan.
+func (in *APIPlan) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the APIPlan.
+func (in *APIPlan) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the APIPortal.
+func (in *APIPortal) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the APIPortal.
+func (in *APIPortal) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the APIPortalAuth.
+func (in *APIPortalAuth) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the APIPortalAuth.
+func (in *APIPortalAuth) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the APIVersion.
+func (in *APIVersion) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the APIVersion.
+func (in *APIVersion) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the ContentItem.
+func (in *ContentItem) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the ContentItem.
+func (in *ContentItem) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the ManagedApplication.
+func (in *ManagedApplication) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the ManagedApplication.
+func (in *ManagedApplication) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the ManagedSubscription.
+func (in *ManagedSubscription) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the ManagedSubscription.
+func (in *ManagedSubscription) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the Uplink.
+func (in *Uplink) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the Uplink.
+func (in *Uplink) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
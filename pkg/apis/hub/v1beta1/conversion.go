@@ -0,0 +1,608 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package v1beta1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	hubconversion "github.com/traefik/hub-crds/pkg/conversion"
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// AnnotationV1alpha1Applications holds the v1alpha1 ManagedSubscription applications, which have no
+// v1beta1 equivalent, so that they survive a round trip through v1beta1.
+const AnnotationV1alpha1Applications = "hub.traefik.io/v1alpha1-applications"
+
+// AnnotationV1alpha1Spec holds the v1alpha1 spec of an object whose conversion to v1beta1 cannot be reverted,
+// such as an APIAuth JWKSURL becoming a trusted issuer, or an Uplink duration given as a number of seconds
+// becoming a Period. The spec is restored when converting back, unless the v1beta1 spec has been modified.
+const AnnotationV1alpha1Spec = "hub.traefik.io/v1alpha1-spec"
+
+// addConversionFuncs registers the conversions which need more than the generated functions,
+// so that converting with the scheme gives the same result as ConvertTo and ConvertFrom.
+func addConversionFuncs(scheme *runtime.Scheme) error {
+	for _, kind := range []struct {
+		spoke hubconversion.Convertible
+		hub   hubconversion.Hub
+	}{
+		{spoke: (*APIAuth)(nil), hub: (*v1alpha1.APIAuth)(nil)},
+		{spoke: (*ManagedSubscription)(nil), hub: (*v1alpha1.ManagedSubscription)(nil)},
+		{spoke: (*Uplink)(nil), hub: (*v1alpha1.Uplink)(nil)},
+	} {
+		err := scheme.AddConversionFunc(kind.spoke, kind.hub, func(a, b any, _ conversion.Scope) error {
+			return a.(hubconversion.Convertible).ConvertTo(b.(hubconversion.Hub))
+		})
+		if err != nil {
+			return err
+		}
+
+		err = scheme.AddConversionFunc(kind.hub, kind.spoke, func(a, b any, _ conversion.Scope) error {
+			return b.(hubconversion.Convertible).ConvertFrom(a.(hubconversion.Hub))
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ConvertTo converts this API to the hub version.
+func (src *API) ConvertTo(dstRaw hubconversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.API)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", dstRaw)
+	}
+
+	return Convert_v1beta1_API_To_v1alpha1_API(src, dst, nil)
+}
+
+// ConvertFrom converts the hub version to this API.
+func (dst *API) ConvertFrom(srcRaw hubconversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.API)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", srcRaw)
+	}
+
+	return Convert_v1alpha1_API_To_v1beta1_API(src, dst, nil)
+}
+
+// ConvertTo converts this APIAuth to the hub version.
+// The v1alpha1 spec stored by ConvertFrom is restored.
+func (src *APIAuth) ConvertTo(dstRaw hubconversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.APIAuth)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", dstRaw)
+	}
+
+	if err := Convert_v1beta1_APIAuth_To_v1alpha1_APIAuth(src, dst, nil); err != nil {
+		return err
+	}
+
+	annotations, err := restoreHubSpec(src.Annotations, &src.Spec, &dst.Spec, Convert_v1alpha1_APIAuthSpec_To_v1beta1_APIAuthSpec)
+	if err != nil {
+		return err
+	}
+	dst.Annotations = annotations
+
+	return nil
+}
+
+// ConvertFrom converts the hub version to this APIAuth.
+// The v1alpha1 spec is stored in the AnnotationV1alpha1Spec annotation when it uses a JWKSURL.
+func (dst *APIAuth) ConvertFrom(srcRaw hubconversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.APIAuth)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", srcRaw)
+	}
+
+	if err := Convert_v1alpha1_APIAuth_To_v1beta1_APIAuth(src, dst, nil); err != nil {
+		return err
+	}
+
+	annotations, err := storeHubSpec(src.Annotations, &src.Spec, &dst.Spec, Convert_v1beta1_APIAuthSpec_To_v1alpha1_APIAuthSpec)
+	if err != nil {
+		return err
+	}
+	dst.Annotations = annotations
+
+	return nil
+}
+
+// ConvertTo converts this APIBundle to the hub version.
+func (src *APIBundle) ConvertTo(dstRaw hubconversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.APIBundle)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", dstRaw)
+	}
+
+	return Convert_v1beta1_APIBundle_To_v1alpha1_APIBundle(src, dst, nil)
+}
+
+// ConvertFrom converts the hub version to this APIBundle.
+func (dst *APIBundle) ConvertFrom(srcRaw hubconversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.APIBundle)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", srcRaw)
+	}
+
+	return Convert_v1alpha1_APIBundle_To_v1beta1_APIBundle(src, dst, nil)
+}
+
+// ConvertTo converts this APICatalogItem to the hub version.
+func (src *APICatalogItem) ConvertTo(dstRaw hubconversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.APICatalogItem)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", dstRaw)
+	}
+
+	return Convert_v1beta1_APICatalogItem_To_v1alpha1_APICatalogItem(src, dst, nil)
+}
+
+// ConvertFrom converts the hub version to this APICatalogItem.
+func (dst *APICatalogItem) ConvertFrom(srcRaw hubconversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.APICatalogItem)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", srcRaw)
+	}
+
+	return Convert_v1alpha1_APICatalogItem_To_v1beta1_APICatalogItem(src, dst, nil)
+}
+
+// ConvertTo converts this APIPlan to the hub version.
+func (src *APIPlan) ConvertTo(dstRaw hubconversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.APIPlan)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", dstRaw)
+	}
+
+	return Convert_v1beta1_APIPlan_To_v1alpha1_APIPlan(src, dst, nil)
+}
+
+// ConvertFrom converts the hub version to this APIPlan.
+func (dst *APIPlan) ConvertFrom(srcRaw hubconversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.APIPlan)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", srcRaw)
+	}
+
+	return Convert_v1alpha1_APIPlan_To_v1beta1_APIPlan(src, dst, nil)
+}
+
+// ConvertTo converts this APIPortal to the hub version.
+func (src *APIPortal) ConvertTo(dstRaw hubconversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.APIPortal)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", dstRaw)
+	}
+
+	return Convert_v1beta1_APIPortal_To_v1alpha1_APIPortal(src, dst, nil)
+}
+
+// ConvertFrom converts the hub version to this APIPortal.
+func (dst *APIPortal) ConvertFrom(srcRaw hubconversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.APIPortal)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", srcRaw)
+	}
+
+	return Convert_v1alpha1_APIPortal_To_v1beta1_APIPortal(src, dst, nil)
+}
+
+// ConvertTo converts this APIPortalAuth to the hub version.
+func (src *APIPortalAuth) ConvertTo(dstRaw hubconversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.APIPortalAuth)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", dstRaw)
+	}
+
+	return Convert_v1beta1_APIPortalAuth_To_v1alpha1_APIPortalAuth(src, dst, nil)
+}
+
+// ConvertFrom converts the hub version to this APIPortalAuth.
+func (dst *APIPortalAuth) ConvertFrom(srcRaw hubconversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.APIPortalAuth)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", srcRaw)
+	}
+
+	return Convert_v1alpha1_APIPortalAuth_To_v1beta1_APIPortalAuth(src, dst, nil)
+}
+
+// ConvertTo converts this APIVersion to the hub version.
+func (src *APIVersion) ConvertTo(dstRaw hubconversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.APIVersion)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", dstRaw)
+	}
+
+	return Convert_v1beta1_APIVersion_To_v1alpha1_APIVersion(src, dst, nil)
+}
+
+// ConvertFrom converts the hub version to this APIVersion.
+func (dst *APIVersion) ConvertFrom(srcRaw hubconversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.APIVersion)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", srcRaw)
+	}
+
+	return Convert_v1alpha1_APIVersion_To_v1beta1_APIVersion(src, dst, nil)
+}
+
+// ConvertTo converts this ContentItem to the hub version.
+func (src *ContentItem) ConvertTo(dstRaw hubconversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.ContentItem)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", dstRaw)
+	}
+
+	return Convert_v1beta1_ContentItem_To_v1alpha1_ContentItem(src, dst, nil)
+}
+
+// ConvertFrom converts the hub version to this ContentItem.
+func (dst *ContentItem) ConvertFrom(srcRaw hubconversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.ContentItem)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", srcRaw)
+	}
+
+	return Convert_v1alpha1_ContentItem_To_v1beta1_ContentItem(src, dst, nil)
+}
+
+// ConvertTo converts this ManagedApplication to the hub version.
+func (src *ManagedApplication) ConvertTo(dstRaw hubconversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.ManagedApplication)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", dstRaw)
+	}
+
+	return Convert_v1beta1_ManagedApplication_To_v1alpha1_ManagedApplication(src, dst, nil)
+}
+
+// ConvertFrom converts the hub version to this ManagedApplication.
+func (dst *ManagedApplication) ConvertFrom(srcRaw hubconversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.ManagedApplication)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", srcRaw)
+	}
+
+	return Convert_v1alpha1_ManagedApplication_To_v1beta1_ManagedApplication(src, dst, nil)
+}
+
+// ConvertTo converts this Uplink to the hub version.
+// The v1alpha1 spec stored by ConvertFrom is restored.
+func (src *Uplink) ConvertTo(dstRaw hubconversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.Uplink)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", dstRaw)
+	}
+
+	if err := Convert_v1beta1_Uplink_To_v1alpha1_Uplink(src, dst, nil); err != nil {
+		return err
+	}
+
+	annotations, err := restoreHubSpec(src.Annotations, &src.Spec, &dst.Spec, Convert_v1alpha1_UplinkSpec_To_v1beta1_UplinkSpec)
+	if err != nil {
+		return err
+	}
+	dst.Annotations = annotations
+
+	return nil
+}
+
+// ConvertFrom converts the hub version to this Uplink.
+// The v1alpha1 spec is stored in the AnnotationV1alpha1Spec annotation when its durations are not Go duration strings.
+func (dst *Uplink) ConvertFrom(srcRaw hubconversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.Uplink)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", srcRaw)
+	}
+
+	if err := Convert_v1alpha1_Uplink_To_v1beta1_Uplink(src, dst, nil); err != nil {
+		return err
+	}
+
+	annotations, err := storeHubSpec(src.Annotations, &src.Spec, &dst.Spec, Convert_v1beta1_UplinkSpec_To_v1alpha1_UplinkSpec)
+	if err != nil {
+		return err
+	}
+	dst.Annotations = annotations
+
+	return nil
+}
+
+// ConvertTo converts this ManagedSubscription to the hub version.
+// The v1alpha1 applications stored by ConvertFrom are restored.
+func (src *ManagedSubscription) ConvertTo(dstRaw hubconversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.ManagedSubscription)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", dstRaw)
+	}
+
+	if err := Convert_v1beta1_ManagedSubscription_To_v1alpha1_ManagedSubscription(src, dst, nil); err != nil {
+		return err
+	}
+
+	raw, ok := src.Annotations[AnnotationV1alpha1Applications]
+	if !ok {
+		return nil
+	}
+
+	if err := json.Unmarshal([]byte(raw), &dst.Spec.Applications); err != nil {
+		return fmt.Errorf("unmarshal %q annotation: %w", AnnotationV1alpha1Applications, err)
+	}
+
+	dst.Annotations = withoutAnnotation(src.Annotations, AnnotationV1alpha1Applications)
+
+	return nil
+}
+
+// ConvertFrom converts the hub version to this ManagedSubscription.
+// The v1alpha1 applications are stored in the AnnotationV1alpha1Applications annotation.
+func (dst *ManagedSubscription) ConvertFrom(srcRaw hubconversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.ManagedSubscription)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", srcRaw)
+	}
+
+	if err := Convert_v1alpha1_ManagedSubscription_To_v1beta1_ManagedSubscription(src, dst, nil); err != nil {
+		return err
+	}
+
+	if len(src.Spec.Applications) == 0 {
+		return nil
+	}
+
+	raw, err := json.Marshal(src.Spec.Applications)
+	if err != nil {
+		return fmt.Errorf("marshal applications: %w", err)
+	}
+
+	dst.Annotations = withAnnotation(src.Annotations, AnnotationV1alpha1Applications, string(raw))
+
+	return nil
+}
+
+// storeHubSpec returns the annotations of a spoke object converted from a hub object, holding the hub spec in the
+// AnnotationV1alpha1Spec annotation when converting the spoke spec back wouldn't give the hub spec.
+func storeHubSpec[H, S any](annotations map[string]string, hubSpec *H, spokeSpec *S, toHub func(*S, *H, conversion.Scope) error) (map[string]string, error) {
+	var converted H
+	if err := toHub(spokeSpec, &converted, nil); err != nil {
+		return nil, err
+	}
+
+	raw, err := json.Marshal(hubSpec)
+	if err != nil {
+		return nil, fmt.Errorf("marshal spec: %w", err)
+	}
+
+	rawConverted, err := json.Marshal(converted)
+	if err != nil {
+		return nil, fmt.Errorf("marshal converted spec: %w", err)
+	}
+
+	if bytes.Equal(raw, rawConverted) {
+		return annotations, nil
+	}
+
+	return withAnnotation(annotations, AnnotationV1alpha1Spec, string(raw)), nil
+}
+
+// restoreHubSpec restores the hub spec held in the AnnotationV1alpha1Spec annotation of a spoke object when the
+// spoke spec is still its conversion, and returns the annotations of the hub object.
+func restoreHubSpec[H, S any](annotations map[string]string, spokeSpec *S, hubSpec *H, toSpoke func(*H, *S, conversion.Scope) error) (map[string]string, error) {
+	raw, ok := annotations[AnnotationV1alpha1Spec]
+	if !ok {
+		return annotations, nil
+	}
+
+	var stored H
+	if err := json.Unmarshal([]byte(raw), &stored); err != nil {
+		return nil, fmt.Errorf("unmarshal %q annotation: %w", AnnotationV1alpha1Spec, err)
+	}
+
+	var storedSpoke S
+	if err := toSpoke(&stored, &storedSpoke, nil); err != nil {
+		return nil, err
+	}
+
+	rawStoredSpoke, err := json.Marshal(storedSpoke)
+	if err != nil {
+		return nil, fmt.Errorf("marshal stored spec: %w", err)
+	}
+
+	rawSpoke, err := json.Marshal(spokeSpec)
+	if err != nil {
+		return nil, fmt.Errorf("marshal spec: %w", err)
+	}
+
+	// The stored spec is outdated when the spoke spec has been modified since it was converted.
+	if bytes.Equal(rawStoredSpoke, rawSpoke) {
+		*hubSpec = stored
+	}
+
+	return withoutAnnotation(annotations, AnnotationV1alpha1Spec), nil
+}
+
+// withAnnotation returns a copy of the annotations with the given annotation set.
+// Annotations are shared with the source object of a conversion, so they are never modified in place.
+func withAnnotation(annotations map[string]string, key, value string) map[string]string {
+	annotations = maps.Clone(annotations)
+	if annotations == nil {
+		annotations = make(map[string]string, 1)
+	}
+	annotations[key] = value
+
+	return annotations
+}
+
+// withoutAnnotation returns a copy of the annotations without the given annotation, or nil if none is left.
+func withoutAnnotation(annotations map[string]string, key string) map[string]string {
+	annotations = maps.Clone(annotations)
+	delete(annotations, key)
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	return annotations
+}
+
+// Convert_v1alpha1_JWTAuthSpec_To_v1beta1_JWTAuthSpec converts a v1alpha1 JWTAuthSpec to v1beta1.
+// The deprecated JWKSURL becomes a trusted issuer accepting any issuer.
+func Convert_v1alpha1_JWTAuthSpec_To_v1beta1_JWTAuthSpec(in *v1alpha1.JWTAuthSpec, out *JWTAuthSpec, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_JWTAuthSpec_To_v1beta1_JWTAuthSpec(in, out, s); err != nil {
+		return err
+	}
+
+	if in.JWKSURL != "" {
+		out.TrustedIssuers = append([]TrustedIssuer{{JWKSURL: in.JWKSURL}}, out.TrustedIssuers...)
+	}
+
+	return nil
+}
+
+// Convert_v1alpha1_ManagedSubscriptionSpec_To_v1beta1_ManagedSubscriptionSpec converts a v1alpha1
+// ManagedSubscriptionSpec to v1beta1. The deprecated Applications are dropped, see ManagedSubscription.ConvertFrom.
+func Convert_v1alpha1_ManagedSubscriptionSpec_To_v1beta1_ManagedSubscriptionSpec(in *v1alpha1.ManagedSubscriptionSpec, out *ManagedSubscriptionSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ManagedSubscriptionSpec_To_v1beta1_ManagedSubscriptionSpec(in, out, s)
+}
+
+// Convert_v1alpha1_ManagedApplicationStatus_To_v1beta1_ManagedApplicationStatus converts a v1alpha1
+// ManagedApplicationStatus to v1beta1. API key versions are sorted by key.
+func Convert_v1alpha1_ManagedApplicationStatus_To_v1beta1_ManagedApplicationStatus(in *v1alpha1.ManagedApplicationStatus, out *ManagedApplicationStatus, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_ManagedApplicationStatus_To_v1beta1_ManagedApplicationStatus(in, out, s); err != nil {
+		return err
+	}
+
+	out.APIKeyVersions = nil
+	if in.APIKeyVersions == nil {
+		return nil
+	}
+
+	out.APIKeyVersions = make([]APIKeyVersion, 0, len(in.APIKeyVersions))
+	for _, key := range slices.Sorted(maps.Keys(in.APIKeyVersions)) {
+		out.APIKeyVersions = append(out.APIKeyVersions, APIKeyVersion{Key: key, Version: in.APIKeyVersions[key]})
+	}
+
+	return nil
+}
+
+// Convert_v1beta1_ManagedApplicationStatus_To_v1alpha1_ManagedApplicationStatus converts a v1beta1
+// ManagedApplicationStatus to v1alpha1.
+func Convert_v1beta1_ManagedApplicationStatus_To_v1alpha1_ManagedApplicationStatus(in *ManagedApplicationStatus, out *v1alpha1.ManagedApplicationStatus, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_ManagedApplicationStatus_To_v1alpha1_ManagedApplicationStatus(in, out, s); err != nil {
+		return err
+	}
+
+	out.APIKeyVersions = nil
+	if in.APIKeyVersions == nil {
+		return nil
+	}
+
+	out.APIKeyVersions = make(map[string]string, len(in.APIKeyVersions))
+	for _, keyVersion := range in.APIKeyVersions {
+		out.APIKeyVersions[keyVersion.Key] = keyVersion.Version
+	}
+
+	return nil
+}
+
+// Convert_intstr_IntOrString_To_Pointer_v1beta1_Period converts a duration expressed either as a number
+// of seconds or as a Period string to a Period. The zero value is converted to nil.
+func Convert_intstr_IntOrString_To_Pointer_v1beta1_Period(in *intstr.IntOrString, out **Period, _ conversion.Scope) error {
+	period, err := intOrStringToPeriod(*in)
+	if err != nil {
+		return err
+	}
+
+	*out = period
+
+	return nil
+}
+
+// Convert_Pointer_v1beta1_Period_To_intstr_IntOrString converts a Period to a Go duration string.
+// A nil Period is converted to the zero value.
+func Convert_Pointer_v1beta1_Period_To_intstr_IntOrString(in **Period, out *intstr.IntOrString, _ conversion.Scope) error {
+	*out = periodToIntOrString(*in)
+
+	return nil
+}
+
+// Convert_Pointer_intstr_IntOrString_To_Pointer_v1beta1_Period converts an optional duration expressed either
+// as a number of seconds or as a Period string to a Period.
+func Convert_Pointer_intstr_IntOrString_To_Pointer_v1beta1_Period(in **intstr.IntOrString, out **Period, s conversion.Scope) error {
+	if *in == nil {
+		*out = nil
+
+		return nil
+	}
+
+	return Convert_intstr_IntOrString_To_Pointer_v1beta1_Period(*in, out, s)
+}
+
+// Convert_Pointer_v1beta1_Period_To_Pointer_intstr_IntOrString converts an optional Period to a Go duration string.
+func Convert_Pointer_v1beta1_Period_To_Pointer_intstr_IntOrString(in **Period, out **intstr.IntOrString, _ conversion.Scope) error {
+	if *in == nil {
+		*out = nil
+
+		return nil
+	}
+
+	value := periodToIntOrString(*in)
+	*out = &value
+
+	return nil
+}
+
+// intOrStringToPeriod converts a duration expressed either as a number of seconds or as a Period string
+// to a Period. The zero value is converted to nil.
+func intOrStringToPeriod(value intstr.IntOrString) (*Period, error) {
+	switch {
+	case value.Type == intstr.Int && value.IntVal == 0:
+		return nil, nil
+	case value.Type == intstr.Int:
+		return NewPeriod(time.Duration(value.IntVal) * time.Second), nil
+	case strings.TrimSpace(value.StrVal) == "":
+		return nil, nil
+	}
+
+	period, err := ParsePeriod(value.StrVal)
+	if err != nil {
+		return nil, fmt.Errorf("parse: %w", err)
+	}
+
+	return &period, nil
+}
+
+// periodToIntOrString converts a Period to a Go duration string. A nil Period is converted to the zero value.
+func periodToIntOrString(period *Period) intstr.IntOrString {
+	if period == nil {
+		return intstr.IntOrString{}
+	}
+
+	return intstr.FromString(period.String())
+}
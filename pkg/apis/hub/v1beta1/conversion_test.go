@@ -0,0 +1,302 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package v1beta1_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	fuzz "github.com/google/gofuzz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	hubv1beta1 "github.com/traefik/hub-crds/pkg/apis/hub/v1beta1"
+	"github.com/traefik/hub-crds/pkg/conversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// FuzzRoundTrip checks that converting a v1beta1 object to the hub version and back is lossless,
+// and that converting a hub object to v1beta1 and back is lossless too.
+func FuzzRoundTrip(f *testing.F) {
+	for seed := range 50 {
+		f.Add([]byte{byte(seed), byte(seed * 7), byte(seed * 13), byte(seed * 31)})
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		spokes := []struct {
+			spoke func() conversion.Convertible
+			hub   func() conversion.Hub
+		}{
+			{spoke: func() conversion.Convertible { return &hubv1beta1.API{} }, hub: func() conversion.Hub { return &hubv1alpha1.API{} }},
+			{spoke: func() conversion.Convertible { return &hubv1beta1.APIAuth{} }, hub: func() conversion.Hub { return &hubv1alpha1.APIAuth{} }},
+			{spoke: func() conversion.Convertible { return &hubv1beta1.APIBundle{} }, hub: func() conversion.Hub { return &hubv1alpha1.APIBundle{} }},
+			{spoke: func() conversion.Convertible { return &hubv1beta1.APICatalogItem{} }, hub: func() conversion.Hub { return &hubv1alpha1.APICatalogItem{} }},
+			{spoke: func() conversion.Convertible { return &hubv1beta1.APIPlan{} }, hub: func() conversion.Hub { return &hubv1alpha1.APIPlan{} }},
+			{spoke: func() conversion.Convertible { return &hubv1beta1.APIPortal{} }, hub: func() conversion.Hub { return &hubv1alpha1.APIPortal{} }},
+			{spoke: func() conversion.Convertible { return &hubv1beta1.APIPortalAuth{} }, hub: func() conversion.Hub { return &hubv1alpha1.APIPortalAuth{} }},
+			{spoke: func() conversion.Convertible { return &hubv1beta1.APIVersion{} }, hub: func() conversion.Hub { return &hubv1alpha1.APIVersion{} }},
+			{spoke: func() conversion.Convertible { return &hubv1beta1.ContentItem{} }, hub: func() conversion.Hub { return &hubv1alpha1.ContentItem{} }},
+			{spoke: func() conversion.Convertible { return &hubv1beta1.ManagedApplication{} }, hub: func() conversion.Hub { return &hubv1alpha1.ManagedApplication{} }},
+			{spoke: func() conversion.Convertible { return &hubv1beta1.ManagedSubscription{} }, hub: func() conversion.Hub { return &hubv1alpha1.ManagedSubscription{} }},
+			{spoke: func() conversion.Convertible { return &hubv1beta1.Uplink{} }, hub: func() conversion.Hub { return &hubv1alpha1.Uplink{} }},
+		}
+
+		fuzzer := fuzz.NewFromGoFuzz(data).NilChance(0.2).Funcs(
+			func(m *metav1.TypeMeta, _ fuzz.Continue) {
+				// TypeMeta is set by the serializer, not by conversion functions.
+				*m = metav1.TypeMeta{}
+			},
+			func(versions *[]hubv1beta1.APIKeyVersion, c fuzz.Continue) {
+				// APIKeyVersions is a map list keyed and sorted by key.
+				var byKey map[string]string
+				c.Fuzz(&byKey)
+				if byKey == nil {
+					*versions = nil
+					return
+				}
+
+				*versions = []hubv1beta1.APIKeyVersion{}
+				for key, version := range byKey {
+					*versions = append(*versions, hubv1beta1.APIKeyVersion{Key: key, Version: version})
+				}
+				slices.SortFunc(*versions, func(a, b hubv1beta1.APIKeyVersion) int {
+					return strings.Compare(a.Key, b.Key)
+				})
+			},
+			func(value *intstr.IntOrString, c fuzz.Continue) {
+				// The v1alpha1 Uplink durations are either a number of seconds or a duration string.
+				if c.RandBool() {
+					*value = intstr.FromInt32(c.Int31n(3600))
+					return
+				}
+
+				durations := []string{"", "0", "30s", "90s", "1m30s", "1h", "36h", "2d", "P1DT12H"}
+				*value = intstr.FromString(durations[c.Intn(len(durations))])
+			},
+		)
+
+		for _, spoke := range spokes {
+			original := spoke.spoke()
+			fuzzer.Fuzz(original)
+
+			hub := spoke.hub()
+			require.NoError(t, original.ConvertTo(hub))
+
+			got := spoke.spoke()
+			require.NoError(t, got.ConvertFrom(hub))
+
+			assert.Equal(t, original, got)
+		}
+
+		for _, spoke := range spokes {
+			original := spoke.hub()
+			fuzzer.Fuzz(original)
+
+			converted := spoke.spoke()
+			require.NoError(t, converted.ConvertFrom(original))
+
+			got := spoke.hub()
+			require.NoError(t, converted.ConvertTo(got))
+
+			assert.Equal(t, original, got)
+		}
+	})
+}
+
+func TestJWTAuthSpec_ConvertFrom_jwksURL(t *testing.T) {
+	t.Parallel()
+
+	hub := &hubv1alpha1.APIAuth{
+		Spec: hubv1alpha1.APIAuthSpec{
+			JWT: &hubv1alpha1.JWTAuthSpec{
+				AppIDClaim: "client_id",
+				JWKSURL:    "https://example.com/jwks.json",
+			},
+		},
+	}
+
+	var got hubv1beta1.APIAuth
+	require.NoError(t, got.ConvertFrom(hub))
+
+	want := &hubv1beta1.JWTAuthSpec{
+		AppIDClaim:     "client_id",
+		TrustedIssuers: []hubv1beta1.TrustedIssuer{{JWKSURL: "https://example.com/jwks.json"}},
+	}
+	assert.Equal(t, want, got.Spec.JWT)
+}
+
+func TestAPIAuth_jwksURL(t *testing.T) {
+	t.Parallel()
+
+	hub := &hubv1alpha1.APIAuth{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-auth"},
+		Spec: hubv1alpha1.APIAuthSpec{
+			JWT: &hubv1alpha1.JWTAuthSpec{
+				AppIDClaim: "client_id",
+				JWKSURL:    "https://example.com/jwks.json",
+			},
+		},
+	}
+
+	var spoke hubv1beta1.APIAuth
+	require.NoError(t, spoke.ConvertFrom(hub))
+
+	assert.Equal(t, map[string]string{
+		hubv1beta1.AnnotationV1alpha1Spec: `{"isDefault":false,"jwt":{"appIdClaim":"client_id","jwksUrl":"https://example.com/jwks.json"}}`,
+	}, spoke.Annotations)
+
+	var got hubv1alpha1.APIAuth
+	require.NoError(t, spoke.ConvertTo(&got))
+	assert.Equal(t, hub, &got)
+
+	// The stored spec is dropped once the v1beta1 spec is modified.
+	spoke.Spec.JWT.TrustedIssuers[0].Issuer = "https://example.com"
+
+	got = hubv1alpha1.APIAuth{}
+	require.NoError(t, spoke.ConvertTo(&got))
+
+	assert.Empty(t, got.Annotations)
+	assert.Empty(t, got.Spec.JWT.JWKSURL)
+	assert.Equal(t, []hubv1alpha1.TrustedIssuer{{Issuer: "https://example.com", JWKSURL: "https://example.com/jwks.json"}}, got.Spec.JWT.TrustedIssuers)
+}
+
+func TestManagedSubscription_applications(t *testing.T) {
+	t.Parallel()
+
+	hub := &hubv1alpha1.ManagedSubscription{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "my-subscription",
+			Annotations: map[string]string{"foo": "bar"},
+		},
+		Spec: hubv1alpha1.ManagedSubscriptionSpec{
+			Applications: []hubv1alpha1.ApplicationReference{{AppID: "my-app"}},
+			APIPlan:      hubv1alpha1.APIPlanReference{Name: "my-plan"},
+		},
+	}
+
+	var spoke hubv1beta1.ManagedSubscription
+	require.NoError(t, spoke.ConvertFrom(hub))
+
+	assert.Equal(t, map[string]string{"foo": "bar"}, hub.Annotations)
+	assert.Equal(t, map[string]string{
+		"foo": "bar",
+		hubv1beta1.AnnotationV1alpha1Applications: `[{"appId":"my-app"}]`,
+	}, spoke.Annotations)
+
+	var got hubv1alpha1.ManagedSubscription
+	require.NoError(t, spoke.ConvertTo(&got))
+
+	assert.Equal(t, hub, &got)
+}
+
+func TestUplink_ConvertFrom_healthCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc    string
+		value   intstr.IntOrString
+		want    *hubv1beta1.Period
+		wantErr bool
+	}{
+		{
+			desc: "unset",
+		},
+		{
+			desc:  "seconds",
+			value: intstr.FromInt32(30),
+			want:  hubv1beta1.NewPeriod(30 * time.Second),
+		},
+		{
+			desc:  "duration",
+			value: intstr.FromString("1m30s"),
+			want:  hubv1beta1.NewPeriod(90 * time.Second),
+		},
+		{
+			desc:  "ISO-8601 duration",
+			value: intstr.FromString("PT1M30S"),
+			want:  hubv1beta1.NewPeriod(90 * time.Second),
+		},
+		{
+			desc:    "invalid duration",
+			value:   intstr.FromString("30 seconds"),
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			hub := &hubv1alpha1.Uplink{
+				Spec: hubv1alpha1.UplinkSpec{
+					HealthCheck: &hubv1alpha1.UplinkHealthCheck{
+						Interval:          test.value,
+						UnhealthyInterval: &test.value,
+						Timeout:           test.value,
+					},
+				},
+			}
+
+			var got hubv1beta1.Uplink
+			err := got.ConvertFrom(hub)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.want, got.Spec.HealthCheck.Interval)
+			assert.Equal(t, test.want, got.Spec.HealthCheck.UnhealthyInterval)
+			assert.Equal(t, test.want, got.Spec.HealthCheck.Timeout)
+		})
+	}
+}
+
+func TestUplink_seconds(t *testing.T) {
+	t.Parallel()
+
+	hub := &hubv1alpha1.Uplink{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-uplink"},
+		Spec: hubv1alpha1.UplinkSpec{
+			HealthCheck: &hubv1alpha1.UplinkHealthCheck{
+				Interval: intstr.FromInt32(30),
+				Timeout:  intstr.FromString("5s"),
+			},
+		},
+	}
+
+	var spoke hubv1beta1.Uplink
+	require.NoError(t, spoke.ConvertFrom(hub))
+
+	assert.Equal(t, hubv1beta1.NewPeriod(30*time.Second), spoke.Spec.HealthCheck.Interval)
+	assert.Contains(t, spoke.Annotations, hubv1beta1.AnnotationV1alpha1Spec)
+
+	var got hubv1alpha1.Uplink
+	require.NoError(t, spoke.ConvertTo(&got))
+	assert.Equal(t, hub, &got)
+
+	// Go duration strings are converted back as is, without storing the spec.
+	hub.Spec.HealthCheck.Interval = intstr.FromString("30s")
+
+	spoke = hubv1beta1.Uplink{}
+	require.NoError(t, spoke.ConvertFrom(hub))
+	assert.Empty(t, spoke.Annotations)
+}
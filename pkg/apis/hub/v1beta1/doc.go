@@ -0,0 +1,29 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package v1beta1 is the v1beta1 version of the hub.traefik.io API.
+//
+// Compared to v1alpha1, deprecated fields are removed and a few types are reshaped.
+// The v1alpha1 version remains the storage version and acts as the conversion hub:
+// every v1beta1 kind implements ConvertTo and ConvertFrom towards its v1alpha1 counterpart.
+// The deprecated AccessControlPolicy and APIRateLimit kinds, as well as AIService whose
+// specification is still evolving, are only available in v1alpha1.
+//
+// +k8s:deepcopy-gen=package
+// +k8s:conversion-gen=github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1
+// +groupName=hub.traefik.io
+package v1beta1
@@ -0,0 +1,77 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package v1beta1
+
+import (
+	"time"
+
+	"github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+)
+
+// Period describes the time window on which a limit applies.
+// It is written as a Go duration ("90s", "1h30m"), optionally using the "d" (24h) and "w" (7d) units ("30d", "1w2d"),
+// or as an ISO-8601 duration without years and months ("P30D", "PT1H30M", "P1DT12H").
+// Parsing and formatting are shared with the v1alpha1 Period.
+// +kubebuilder:validation:Type=string
+// +kubebuilder:validation:MaxLength=32
+// +kubebuilder:validation:XValidation:message="must be a duration such as 90s, 1h30m, 30d, 2w or P1DT12H",rule="self.matches(r\"\"\"^(0|([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h|d|w))+)$\"\"\") || (self.matches(r\"\"\"^P([0-9]+([.,][0-9]+)?W)?([0-9]+([.,][0-9]+)?D)?(T([0-9]+([.,][0-9]+)?H)?([0-9]+([.,][0-9]+)?M)?([0-9]+([.,][0-9]+)?S)?)?$\"\"\") && self != 'P' && !self.endsWith('T'))"
+type Period time.Duration
+
+// NewPeriod creates a new Period.
+func NewPeriod(d time.Duration) *Period {
+	p := Period(d)
+
+	return &p
+}
+
+// ParsePeriod parses a Period written as a Go duration, optionally using the "d" and "w" units,
+// or as an ISO-8601 duration without years and months.
+func ParsePeriod(value string) (Period, error) {
+	period, err := v1alpha1.ParsePeriod(value)
+
+	return Period(period), err
+}
+
+// Seconds returns the period in seconds.
+func (p *Period) Seconds() float64 {
+	if p == nil {
+		return 0
+	}
+
+	return time.Duration(*p).Seconds()
+}
+
+// IsZero checks whether the period is a zero-value Period.
+func (p *Period) IsZero() bool {
+	return p == nil || time.Duration(*p) == 0
+}
+
+// MarshalJSON marshals the Period.
+func (p *Period) MarshalJSON() ([]byte, error) {
+	return (*v1alpha1.Period)(p).MarshalJSON()
+}
+
+// UnmarshalJSON unmarshals the buffer into a Period.
+func (p *Period) UnmarshalJSON(b []byte) error {
+	return (*v1alpha1.Period)(p).UnmarshalJSON(b)
+}
+
+// String returns the period as a Go duration in its shortest form.
+func (p *Period) String() string {
+	return (*v1alpha1.Period)(p).String()
+}
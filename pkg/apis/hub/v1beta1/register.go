@@ -0,0 +1,82 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kschema "k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// SchemeGroupVersion is group version used to register these objects.
+var SchemeGroupVersion = kschema.GroupVersion{
+	Group:   "hub.traefik.io",
+	Version: "v1beta1",
+}
+
+var (
+	schemeBuilder      = runtime.NewSchemeBuilder(addKnownTypes, addConversionFuncs)
+	localSchemeBuilder = &schemeBuilder
+	// SchemeBuilder collects the functions registering the v1beta1 types and their conversions to a scheme.
+	SchemeBuilder = localSchemeBuilder
+	// AddToScheme applies the SchemeBuilder functions to a specified scheme.
+	AddToScheme = localSchemeBuilder.AddToScheme
+)
+
+// Resource takes an unqualified resource and returns a Group qualified GroupResource.
+func Resource(resource string) kschema.GroupResource {
+	return SchemeGroupVersion.WithResource(resource).GroupResource()
+}
+
+// Adds the list of known types to the given scheme.
+func addKnownTypes(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(
+		SchemeGroupVersion,
+		&APIPortal{},
+		&APIPortalList{},
+		&APIPortalAuth{},
+		&APIPortalAuthList{},
+		&APIAuth{},
+		&APIAuthList{},
+		&API{},
+		&APIList{},
+		&APIPlan{},
+		&APIPlanList{},
+		&APIVersion{},
+		&APIVersionList{},
+		&APIBundle{},
+		&APIBundleList{},
+		&ContentItem{},
+		&ContentItemList{},
+		&ManagedSubscription{},
+		&ManagedSubscriptionList{},
+		&ManagedApplication{},
+		&ManagedApplicationList{},
+		&APICatalogItem{},
+		&APICatalogItemList{},
+		&Uplink{},
+		&UplinkList{},
+	)
+
+	metav1.AddToGroupVersion(
+		scheme,
+		SchemeGroupVersion,
+	)
+
+	return nil
+}
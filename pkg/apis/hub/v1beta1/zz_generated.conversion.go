@@ -0,0 +1,3295 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	unsafe "unsafe"
+
+	v1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*API)(nil), (*v1alpha1.API)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_API_To_v1alpha1_API(a.(*API), b.(*v1alpha1.API), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.API)(nil), (*API)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_API_To_v1beta1_API(a.(*v1alpha1.API), b.(*API), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIAuth)(nil), (*v1alpha1.APIAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIAuth_To_v1alpha1_APIAuth(a.(*APIAuth), b.(*v1alpha1.APIAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIAuth)(nil), (*APIAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIAuth_To_v1beta1_APIAuth(a.(*v1alpha1.APIAuth), b.(*APIAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIAuthList)(nil), (*v1alpha1.APIAuthList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIAuthList_To_v1alpha1_APIAuthList(a.(*APIAuthList), b.(*v1alpha1.APIAuthList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIAuthList)(nil), (*APIAuthList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIAuthList_To_v1beta1_APIAuthList(a.(*v1alpha1.APIAuthList), b.(*APIAuthList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIAuthSpec)(nil), (*v1alpha1.APIAuthSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIAuthSpec_To_v1alpha1_APIAuthSpec(a.(*APIAuthSpec), b.(*v1alpha1.APIAuthSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIAuthSpec)(nil), (*APIAuthSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIAuthSpec_To_v1beta1_APIAuthSpec(a.(*v1alpha1.APIAuthSpec), b.(*APIAuthSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIAuthStatus)(nil), (*v1alpha1.APIAuthStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIAuthStatus_To_v1alpha1_APIAuthStatus(a.(*APIAuthStatus), b.(*v1alpha1.APIAuthStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIAuthStatus)(nil), (*APIAuthStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIAuthStatus_To_v1beta1_APIAuthStatus(a.(*v1alpha1.APIAuthStatus), b.(*APIAuthStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIBundle)(nil), (*v1alpha1.APIBundle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIBundle_To_v1alpha1_APIBundle(a.(*APIBundle), b.(*v1alpha1.APIBundle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIBundle)(nil), (*APIBundle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIBundle_To_v1beta1_APIBundle(a.(*v1alpha1.APIBundle), b.(*APIBundle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIBundleList)(nil), (*v1alpha1.APIBundleList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIBundleList_To_v1alpha1_APIBundleList(a.(*APIBundleList), b.(*v1alpha1.APIBundleList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIBundleList)(nil), (*APIBundleList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIBundleList_To_v1beta1_APIBundleList(a.(*v1alpha1.APIBundleList), b.(*APIBundleList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIBundleReference)(nil), (*v1alpha1.APIBundleReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIBundleReference_To_v1alpha1_APIBundleReference(a.(*APIBundleReference), b.(*v1alpha1.APIBundleReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIBundleReference)(nil), (*APIBundleReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIBundleReference_To_v1beta1_APIBundleReference(a.(*v1alpha1.APIBundleReference), b.(*APIBundleReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIBundleSpec)(nil), (*v1alpha1.APIBundleSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIBundleSpec_To_v1alpha1_APIBundleSpec(a.(*APIBundleSpec), b.(*v1alpha1.APIBundleSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIBundleSpec)(nil), (*APIBundleSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIBundleSpec_To_v1beta1_APIBundleSpec(a.(*v1alpha1.APIBundleSpec), b.(*APIBundleSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIBundleStatus)(nil), (*v1alpha1.APIBundleStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIBundleStatus_To_v1alpha1_APIBundleStatus(a.(*APIBundleStatus), b.(*v1alpha1.APIBundleStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIBundleStatus)(nil), (*APIBundleStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIBundleStatus_To_v1beta1_APIBundleStatus(a.(*v1alpha1.APIBundleStatus), b.(*APIBundleStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APICatalogItem)(nil), (*v1alpha1.APICatalogItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APICatalogItem_To_v1alpha1_APICatalogItem(a.(*APICatalogItem), b.(*v1alpha1.APICatalogItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APICatalogItem)(nil), (*APICatalogItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APICatalogItem_To_v1beta1_APICatalogItem(a.(*v1alpha1.APICatalogItem), b.(*APICatalogItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APICatalogItemList)(nil), (*v1alpha1.APICatalogItemList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APICatalogItemList_To_v1alpha1_APICatalogItemList(a.(*APICatalogItemList), b.(*v1alpha1.APICatalogItemList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APICatalogItemList)(nil), (*APICatalogItemList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APICatalogItemList_To_v1beta1_APICatalogItemList(a.(*v1alpha1.APICatalogItemList), b.(*APICatalogItemList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APICatalogItemSpec)(nil), (*v1alpha1.APICatalogItemSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APICatalogItemSpec_To_v1alpha1_APICatalogItemSpec(a.(*APICatalogItemSpec), b.(*v1alpha1.APICatalogItemSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APICatalogItemSpec)(nil), (*APICatalogItemSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APICatalogItemSpec_To_v1beta1_APICatalogItemSpec(a.(*v1alpha1.APICatalogItemSpec), b.(*APICatalogItemSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APICatalogItemStatus)(nil), (*v1alpha1.APICatalogItemStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APICatalogItemStatus_To_v1alpha1_APICatalogItemStatus(a.(*APICatalogItemStatus), b.(*v1alpha1.APICatalogItemStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APICatalogItemStatus)(nil), (*APICatalogItemStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APICatalogItemStatus_To_v1beta1_APICatalogItemStatus(a.(*v1alpha1.APICatalogItemStatus), b.(*APICatalogItemStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIKey)(nil), (*v1alpha1.APIKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIKey_To_v1alpha1_APIKey(a.(*APIKey), b.(*v1alpha1.APIKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIKey)(nil), (*APIKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIKey_To_v1beta1_APIKey(a.(*v1alpha1.APIKey), b.(*APIKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIKeyAuthSpec)(nil), (*v1alpha1.APIKeyAuthSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIKeyAuthSpec_To_v1alpha1_APIKeyAuthSpec(a.(*APIKeyAuthSpec), b.(*v1alpha1.APIKeyAuthSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIKeyAuthSpec)(nil), (*APIKeyAuthSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIKeyAuthSpec_To_v1beta1_APIKeyAuthSpec(a.(*v1alpha1.APIKeyAuthSpec), b.(*APIKeyAuthSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIKeySource)(nil), (*v1alpha1.APIKeySource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIKeySource_To_v1alpha1_APIKeySource(a.(*APIKeySource), b.(*v1alpha1.APIKeySource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIKeySource)(nil), (*APIKeySource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIKeySource_To_v1beta1_APIKeySource(a.(*v1alpha1.APIKeySource), b.(*APIKeySource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIList)(nil), (*v1alpha1.APIList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIList_To_v1alpha1_APIList(a.(*APIList), b.(*v1alpha1.APIList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIList)(nil), (*APIList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIList_To_v1beta1_APIList(a.(*v1alpha1.APIList), b.(*APIList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIPlan)(nil), (*v1alpha1.APIPlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIPlan_To_v1alpha1_APIPlan(a.(*APIPlan), b.(*v1alpha1.APIPlan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIPlan)(nil), (*APIPlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIPlan_To_v1beta1_APIPlan(a.(*v1alpha1.APIPlan), b.(*APIPlan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIPlanList)(nil), (*v1alpha1.APIPlanList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIPlanList_To_v1alpha1_APIPlanList(a.(*APIPlanList), b.(*v1alpha1.APIPlanList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIPlanList)(nil), (*APIPlanList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIPlanList_To_v1beta1_APIPlanList(a.(*v1alpha1.APIPlanList), b.(*APIPlanList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIPlanReference)(nil), (*v1alpha1.APIPlanReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIPlanReference_To_v1alpha1_APIPlanReference(a.(*APIPlanReference), b.(*v1alpha1.APIPlanReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIPlanReference)(nil), (*APIPlanReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIPlanReference_To_v1beta1_APIPlanReference(a.(*v1alpha1.APIPlanReference), b.(*APIPlanReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIPlanSpec)(nil), (*v1alpha1.APIPlanSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIPlanSpec_To_v1alpha1_APIPlanSpec(a.(*APIPlanSpec), b.(*v1alpha1.APIPlanSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIPlanSpec)(nil), (*APIPlanSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIPlanSpec_To_v1beta1_APIPlanSpec(a.(*v1alpha1.APIPlanSpec), b.(*APIPlanSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIPlanStatus)(nil), (*v1alpha1.APIPlanStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIPlanStatus_To_v1alpha1_APIPlanStatus(a.(*APIPlanStatus), b.(*v1alpha1.APIPlanStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIPlanStatus)(nil), (*APIPlanStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIPlanStatus_To_v1beta1_APIPlanStatus(a.(*v1alpha1.APIPlanStatus), b.(*APIPlanStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIPortal)(nil), (*v1alpha1.APIPortal)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIPortal_To_v1alpha1_APIPortal(a.(*APIPortal), b.(*v1alpha1.APIPortal), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIPortal)(nil), (*APIPortal)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIPortal_To_v1beta1_APIPortal(a.(*v1alpha1.APIPortal), b.(*APIPortal), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIPortalAuth)(nil), (*v1alpha1.APIPortalAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIPortalAuth_To_v1alpha1_APIPortalAuth(a.(*APIPortalAuth), b.(*v1alpha1.APIPortalAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIPortalAuth)(nil), (*APIPortalAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIPortalAuth_To_v1beta1_APIPortalAuth(a.(*v1alpha1.APIPortalAuth), b.(*APIPortalAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIPortalAuthList)(nil), (*v1alpha1.APIPortalAuthList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIPortalAuthList_To_v1alpha1_APIPortalAuthList(a.(*APIPortalAuthList), b.(*v1alpha1.APIPortalAuthList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIPortalAuthList)(nil), (*APIPortalAuthList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIPortalAuthList_To_v1beta1_APIPortalAuthList(a.(*v1alpha1.APIPortalAuthList), b.(*APIPortalAuthList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIPortalAuthReference)(nil), (*v1alpha1.APIPortalAuthReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIPortalAuthReference_To_v1alpha1_APIPortalAuthReference(a.(*APIPortalAuthReference), b.(*v1alpha1.APIPortalAuthReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIPortalAuthReference)(nil), (*APIPortalAuthReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIPortalAuthReference_To_v1beta1_APIPortalAuthReference(a.(*v1alpha1.APIPortalAuthReference), b.(*APIPortalAuthReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIPortalAuthSpec)(nil), (*v1alpha1.APIPortalAuthSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIPortalAuthSpec_To_v1alpha1_APIPortalAuthSpec(a.(*APIPortalAuthSpec), b.(*v1alpha1.APIPortalAuthSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIPortalAuthSpec)(nil), (*APIPortalAuthSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIPortalAuthSpec_To_v1beta1_APIPortalAuthSpec(a.(*v1alpha1.APIPortalAuthSpec), b.(*APIPortalAuthSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIPortalAuthStatus)(nil), (*v1alpha1.APIPortalAuthStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIPortalAuthStatus_To_v1alpha1_APIPortalAuthStatus(a.(*APIPortalAuthStatus), b.(*v1alpha1.APIPortalAuthStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIPortalAuthStatus)(nil), (*APIPortalAuthStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIPortalAuthStatus_To_v1beta1_APIPortalAuthStatus(a.(*v1alpha1.APIPortalAuthStatus), b.(*APIPortalAuthStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIPortalList)(nil), (*v1alpha1.APIPortalList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIPortalList_To_v1alpha1_APIPortalList(a.(*APIPortalList), b.(*v1alpha1.APIPortalList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIPortalList)(nil), (*APIPortalList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIPortalList_To_v1beta1_APIPortalList(a.(*v1alpha1.APIPortalList), b.(*APIPortalList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIPortalSpec)(nil), (*v1alpha1.APIPortalSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIPortalSpec_To_v1alpha1_APIPortalSpec(a.(*APIPortalSpec), b.(*v1alpha1.APIPortalSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIPortalSpec)(nil), (*APIPortalSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIPortalSpec_To_v1beta1_APIPortalSpec(a.(*v1alpha1.APIPortalSpec), b.(*APIPortalSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIPortalStatus)(nil), (*v1alpha1.APIPortalStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIPortalStatus_To_v1alpha1_APIPortalStatus(a.(*APIPortalStatus), b.(*v1alpha1.APIPortalStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIPortalStatus)(nil), (*APIPortalStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIPortalStatus_To_v1beta1_APIPortalStatus(a.(*v1alpha1.APIPortalStatus), b.(*APIPortalStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIReference)(nil), (*v1alpha1.APIReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIReference_To_v1alpha1_APIReference(a.(*APIReference), b.(*v1alpha1.APIReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIReference)(nil), (*APIReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIReference_To_v1beta1_APIReference(a.(*v1alpha1.APIReference), b.(*APIReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APISpec)(nil), (*v1alpha1.APISpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APISpec_To_v1alpha1_APISpec(a.(*APISpec), b.(*v1alpha1.APISpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APISpec)(nil), (*APISpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APISpec_To_v1beta1_APISpec(a.(*v1alpha1.APISpec), b.(*APISpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIStatus)(nil), (*v1alpha1.APIStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIStatus_To_v1alpha1_APIStatus(a.(*APIStatus), b.(*v1alpha1.APIStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIStatus)(nil), (*APIStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIStatus_To_v1beta1_APIStatus(a.(*v1alpha1.APIStatus), b.(*APIStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIVersion)(nil), (*v1alpha1.APIVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIVersion_To_v1alpha1_APIVersion(a.(*APIVersion), b.(*v1alpha1.APIVersion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIVersion)(nil), (*APIVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIVersion_To_v1beta1_APIVersion(a.(*v1alpha1.APIVersion), b.(*APIVersion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIVersionList)(nil), (*v1alpha1.APIVersionList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIVersionList_To_v1alpha1_APIVersionList(a.(*APIVersionList), b.(*v1alpha1.APIVersionList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIVersionList)(nil), (*APIVersionList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIVersionList_To_v1beta1_APIVersionList(a.(*v1alpha1.APIVersionList), b.(*APIVersionList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIVersionRef)(nil), (*v1alpha1.APIVersionRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIVersionRef_To_v1alpha1_APIVersionRef(a.(*APIVersionRef), b.(*v1alpha1.APIVersionRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIVersionRef)(nil), (*APIVersionRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIVersionRef_To_v1beta1_APIVersionRef(a.(*v1alpha1.APIVersionRef), b.(*APIVersionRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIVersionSpec)(nil), (*v1alpha1.APIVersionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIVersionSpec_To_v1alpha1_APIVersionSpec(a.(*APIVersionSpec), b.(*v1alpha1.APIVersionSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIVersionSpec)(nil), (*APIVersionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIVersionSpec_To_v1beta1_APIVersionSpec(a.(*v1alpha1.APIVersionSpec), b.(*APIVersionSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIVersionStatus)(nil), (*v1alpha1.APIVersionStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIVersionStatus_To_v1alpha1_APIVersionStatus(a.(*APIVersionStatus), b.(*v1alpha1.APIVersionStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIVersionStatus)(nil), (*APIVersionStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIVersionStatus_To_v1beta1_APIVersionStatus(a.(*v1alpha1.APIVersionStatus), b.(*APIVersionStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Attributes)(nil), (*v1alpha1.Attributes)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Attributes_To_v1alpha1_Attributes(a.(*Attributes), b.(*v1alpha1.Attributes), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.Attributes)(nil), (*Attributes)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Attributes_To_v1beta1_Attributes(a.(*v1alpha1.Attributes), b.(*Attributes), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Claims)(nil), (*v1alpha1.Claims)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Claims_To_v1alpha1_Claims(a.(*Claims), b.(*v1alpha1.Claims), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.Claims)(nil), (*Claims)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Claims_To_v1beta1_Claims(a.(*v1alpha1.Claims), b.(*Claims), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ContentItem)(nil), (*v1alpha1.ContentItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ContentItem_To_v1alpha1_ContentItem(a.(*ContentItem), b.(*v1alpha1.ContentItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ContentItem)(nil), (*ContentItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ContentItem_To_v1beta1_ContentItem(a.(*v1alpha1.ContentItem), b.(*ContentItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ContentItemList)(nil), (*v1alpha1.ContentItemList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ContentItemList_To_v1alpha1_ContentItemList(a.(*ContentItemList), b.(*v1alpha1.ContentItemList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ContentItemList)(nil), (*ContentItemList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ContentItemList_To_v1beta1_ContentItemList(a.(*v1alpha1.ContentItemList), b.(*ContentItemList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ContentItemParentRef)(nil), (*v1alpha1.ContentItemParentRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ContentItemParentRef_To_v1alpha1_ContentItemParentRef(a.(*ContentItemParentRef), b.(*v1alpha1.ContentItemParentRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ContentItemParentRef)(nil), (*ContentItemParentRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ContentItemParentRef_To_v1beta1_ContentItemParentRef(a.(*v1alpha1.ContentItemParentRef), b.(*ContentItemParentRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ContentItemSpec)(nil), (*v1alpha1.ContentItemSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ContentItemSpec_To_v1alpha1_ContentItemSpec(a.(*ContentItemSpec), b.(*v1alpha1.ContentItemSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ContentItemSpec)(nil), (*ContentItemSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ContentItemSpec_To_v1beta1_ContentItemSpec(a.(*v1alpha1.ContentItemSpec), b.(*ContentItemSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ContentItemStatus)(nil), (*v1alpha1.ContentItemStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ContentItemStatus_To_v1alpha1_ContentItemStatus(a.(*ContentItemStatus), b.(*v1alpha1.ContentItemStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ContentItemStatus)(nil), (*ContentItemStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ContentItemStatus_To_v1beta1_ContentItemStatus(a.(*v1alpha1.ContentItemStatus), b.(*ContentItemStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Cors)(nil), (*v1alpha1.Cors)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Cors_To_v1alpha1_Cors(a.(*Cors), b.(*v1alpha1.Cors), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.Cors)(nil), (*Cors)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Cors_To_v1beta1_Cors(a.(*v1alpha1.Cors), b.(*Cors), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HTTPClientConfig)(nil), (*v1alpha1.HTTPClientConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HTTPClientConfig_To_v1alpha1_HTTPClientConfig(a.(*HTTPClientConfig), b.(*v1alpha1.HTTPClientConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.HTTPClientConfig)(nil), (*HTTPClientConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HTTPClientConfig_To_v1beta1_HTTPClientConfig(a.(*v1alpha1.HTTPClientConfig), b.(*HTTPClientConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HTTPClientConfigTLS)(nil), (*v1alpha1.HTTPClientConfigTLS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HTTPClientConfigTLS_To_v1alpha1_HTTPClientConfigTLS(a.(*HTTPClientConfigTLS), b.(*v1alpha1.HTTPClientConfigTLS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.HTTPClientConfigTLS)(nil), (*HTTPClientConfigTLS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HTTPClientConfigTLS_To_v1beta1_HTTPClientConfigTLS(a.(*v1alpha1.HTTPClientConfigTLS), b.(*HTTPClientConfigTLS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*JWTAuthSpec)(nil), (*v1alpha1.JWTAuthSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_JWTAuthSpec_To_v1alpha1_JWTAuthSpec(a.(*JWTAuthSpec), b.(*v1alpha1.JWTAuthSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LDAPConnectionConfig)(nil), (*v1alpha1.LDAPConnectionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LDAPConnectionConfig_To_v1alpha1_LDAPConnectionConfig(a.(*LDAPConnectionConfig), b.(*v1alpha1.LDAPConnectionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.LDAPConnectionConfig)(nil), (*LDAPConnectionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LDAPConnectionConfig_To_v1beta1_LDAPConnectionConfig(a.(*v1alpha1.LDAPConnectionConfig), b.(*LDAPConnectionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LDAPGroups)(nil), (*v1alpha1.LDAPGroups)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LDAPGroups_To_v1alpha1_LDAPGroups(a.(*LDAPGroups), b.(*v1alpha1.LDAPGroups), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.LDAPGroups)(nil), (*LDAPGroups)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LDAPGroups_To_v1beta1_LDAPGroups(a.(*v1alpha1.LDAPGroups), b.(*LDAPGroups), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LinkDetails)(nil), (*v1alpha1.LinkDetails)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LinkDetails_To_v1alpha1_LinkDetails(a.(*LinkDetails), b.(*v1alpha1.LinkDetails), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.LinkDetails)(nil), (*LinkDetails)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LinkDetails_To_v1beta1_LinkDetails(a.(*v1alpha1.LinkDetails), b.(*LinkDetails), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ManagedApplication)(nil), (*v1alpha1.ManagedApplication)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ManagedApplication_To_v1alpha1_ManagedApplication(a.(*ManagedApplication), b.(*v1alpha1.ManagedApplication), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ManagedApplication)(nil), (*ManagedApplication)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ManagedApplication_To_v1beta1_ManagedApplication(a.(*v1alpha1.ManagedApplication), b.(*ManagedApplication), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ManagedApplicationList)(nil), (*v1alpha1.ManagedApplicationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ManagedApplicationList_To_v1alpha1_ManagedApplicationList(a.(*ManagedApplicationList), b.(*v1alpha1.ManagedApplicationList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ManagedApplicationList)(nil), (*ManagedApplicationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ManagedApplicationList_To_v1beta1_ManagedApplicationList(a.(*v1alpha1.ManagedApplicationList), b.(*ManagedApplicationList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ManagedApplicationReference)(nil), (*v1alpha1.ManagedApplicationReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ManagedApplicationReference_To_v1alpha1_ManagedApplicationReference(a.(*ManagedApplicationReference), b.(*v1alpha1.ManagedApplicationReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ManagedApplicationReference)(nil), (*ManagedApplicationReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ManagedApplicationReference_To_v1beta1_ManagedApplicationReference(a.(*v1alpha1.ManagedApplicationReference), b.(*ManagedApplicationReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ManagedApplicationSpec)(nil), (*v1alpha1.ManagedApplicationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ManagedApplicationSpec_To_v1alpha1_ManagedApplicationSpec(a.(*ManagedApplicationSpec), b.(*v1alpha1.ManagedApplicationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ManagedApplicationSpec)(nil), (*ManagedApplicationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ManagedApplicationSpec_To_v1beta1_ManagedApplicationSpec(a.(*v1alpha1.ManagedApplicationSpec), b.(*ManagedApplicationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ManagedSubscription)(nil), (*v1alpha1.ManagedSubscription)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ManagedSubscription_To_v1alpha1_ManagedSubscription(a.(*ManagedSubscription), b.(*v1alpha1.ManagedSubscription), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ManagedSubscription)(nil), (*ManagedSubscription)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ManagedSubscription_To_v1beta1_ManagedSubscription(a.(*v1alpha1.ManagedSubscription), b.(*ManagedSubscription), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ManagedSubscriptionList)(nil), (*v1alpha1.ManagedSubscriptionList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ManagedSubscriptionList_To_v1alpha1_ManagedSubscriptionList(a.(*ManagedSubscriptionList), b.(*v1alpha1.ManagedSubscriptionList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ManagedSubscriptionList)(nil), (*ManagedSubscriptionList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ManagedSubscriptionList_To_v1beta1_ManagedSubscriptionList(a.(*v1alpha1.ManagedSubscriptionList), b.(*ManagedSubscriptionList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ManagedSubscriptionSpec)(nil), (*v1alpha1.ManagedSubscriptionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ManagedSubscriptionSpec_To_v1alpha1_ManagedSubscriptionSpec(a.(*ManagedSubscriptionSpec), b.(*v1alpha1.ManagedSubscriptionSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ManagedSubscriptionStatus)(nil), (*v1alpha1.ManagedSubscriptionStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ManagedSubscriptionStatus_To_v1alpha1_ManagedSubscriptionStatus(a.(*ManagedSubscriptionStatus), b.(*v1alpha1.ManagedSubscriptionStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ManagedSubscriptionStatus)(nil), (*ManagedSubscriptionStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ManagedSubscriptionStatus_To_v1beta1_ManagedSubscriptionStatus(a.(*v1alpha1.ManagedSubscriptionStatus), b.(*ManagedSubscriptionStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OIDCConfig)(nil), (*v1alpha1.OIDCConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_OIDCConfig_To_v1alpha1_OIDCConfig(a.(*OIDCConfig), b.(*v1alpha1.OIDCConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.OIDCConfig)(nil), (*OIDCConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OIDCConfig_To_v1beta1_OIDCConfig(a.(*v1alpha1.OIDCConfig), b.(*OIDCConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OIDCConfigStatus)(nil), (*v1alpha1.OIDCConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_OIDCConfigStatus_To_v1alpha1_OIDCConfigStatus(a.(*OIDCConfigStatus), b.(*v1alpha1.OIDCConfigStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.OIDCConfigStatus)(nil), (*OIDCConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OIDCConfigStatus_To_v1beta1_OIDCConfigStatus(a.(*v1alpha1.OIDCConfigStatus), b.(*OIDCConfigStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OpenAPISpec)(nil), (*v1alpha1.OpenAPISpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_OpenAPISpec_To_v1alpha1_OpenAPISpec(a.(*OpenAPISpec), b.(*v1alpha1.OpenAPISpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.OpenAPISpec)(nil), (*OpenAPISpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OpenAPISpec_To_v1beta1_OpenAPISpec(a.(*v1alpha1.OpenAPISpec), b.(*OpenAPISpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OperationFilter)(nil), (*v1alpha1.OperationFilter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_OperationFilter_To_v1alpha1_OperationFilter(a.(*OperationFilter), b.(*v1alpha1.OperationFilter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.OperationFilter)(nil), (*OperationFilter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OperationFilter_To_v1beta1_OperationFilter(a.(*v1alpha1.OperationFilter), b.(*OperationFilter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OperationMatcher)(nil), (*v1alpha1.OperationMatcher)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_OperationMatcher_To_v1alpha1_OperationMatcher(a.(*OperationMatcher), b.(*v1alpha1.OperationMatcher), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.OperationMatcher)(nil), (*OperationMatcher)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OperationMatcher_To_v1beta1_OperationMatcher(a.(*v1alpha1.OperationMatcher), b.(*OperationMatcher), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OperationSet)(nil), (*v1alpha1.OperationSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_OperationSet_To_v1alpha1_OperationSet(a.(*OperationSet), b.(*v1alpha1.OperationSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.OperationSet)(nil), (*OperationSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OperationSet_To_v1beta1_OperationSet(a.(*v1alpha1.OperationSet), b.(*OperationSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Override)(nil), (*v1alpha1.Override)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Override_To_v1alpha1_Override(a.(*Override), b.(*v1alpha1.Override), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.Override)(nil), (*Override)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Override_To_v1beta1_Override(a.(*v1alpha1.Override), b.(*Override), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PortalAuthLDAPConfig)(nil), (*v1alpha1.PortalAuthLDAPConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PortalAuthLDAPConfig_To_v1alpha1_PortalAuthLDAPConfig(a.(*PortalAuthLDAPConfig), b.(*v1alpha1.PortalAuthLDAPConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PortalAuthLDAPConfig)(nil), (*PortalAuthLDAPConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PortalAuthLDAPConfig_To_v1beta1_PortalAuthLDAPConfig(a.(*v1alpha1.PortalAuthLDAPConfig), b.(*PortalAuthLDAPConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Quota)(nil), (*v1alpha1.Quota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Quota_To_v1alpha1_Quota(a.(*Quota), b.(*v1alpha1.Quota), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.Quota)(nil), (*Quota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Quota_To_v1beta1_Quota(a.(*v1alpha1.Quota), b.(*Quota), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RateLimit)(nil), (*v1alpha1.RateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RateLimit_To_v1alpha1_RateLimit(a.(*RateLimit), b.(*v1alpha1.RateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RateLimit)(nil), (*RateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RateLimit_To_v1beta1_RateLimit(a.(*v1alpha1.RateLimit), b.(*RateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ResolvedAPIReference)(nil), (*v1alpha1.ResolvedAPIReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ResolvedAPIReference_To_v1alpha1_ResolvedAPIReference(a.(*ResolvedAPIReference), b.(*v1alpha1.ResolvedAPIReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ResolvedAPIReference)(nil), (*ResolvedAPIReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResolvedAPIReference_To_v1beta1_ResolvedAPIReference(a.(*v1alpha1.ResolvedAPIReference), b.(*ResolvedAPIReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ResolvedManagedApplicationReference)(nil), (*v1alpha1.ResolvedManagedApplicationReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ResolvedManagedApplicationReference_To_v1alpha1_ResolvedManagedApplicationReference(a.(*ResolvedManagedApplicationReference), b.(*v1alpha1.ResolvedManagedApplicationReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ResolvedManagedApplicationReference)(nil), (*ResolvedManagedApplicationReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResolvedManagedApplicationReference_To_v1beta1_ResolvedManagedApplicationReference(a.(*v1alpha1.ResolvedManagedApplicationReference), b.(*ResolvedManagedApplicationReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Server)(nil), (*v1alpha1.Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Server_To_v1alpha1_Server(a.(*Server), b.(*v1alpha1.Server), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.Server)(nil), (*Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Server_To_v1beta1_Server(a.(*v1alpha1.Server), b.(*Server), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TokenQuota)(nil), (*v1alpha1.TokenQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TokenQuota_To_v1alpha1_TokenQuota(a.(*TokenQuota), b.(*v1alpha1.TokenQuota), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.TokenQuota)(nil), (*TokenQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TokenQuota_To_v1beta1_TokenQuota(a.(*v1alpha1.TokenQuota), b.(*TokenQuota), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TrustedIssuer)(nil), (*v1alpha1.TrustedIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TrustedIssuer_To_v1alpha1_TrustedIssuer(a.(*TrustedIssuer), b.(*v1alpha1.TrustedIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.TrustedIssuer)(nil), (*TrustedIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TrustedIssuer_To_v1beta1_TrustedIssuer(a.(*v1alpha1.TrustedIssuer), b.(*TrustedIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UISpec)(nil), (*v1alpha1.UISpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_UISpec_To_v1alpha1_UISpec(a.(*UISpec), b.(*v1alpha1.UISpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.UISpec)(nil), (*UISpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_UISpec_To_v1beta1_UISpec(a.(*v1alpha1.UISpec), b.(*UISpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Uplink)(nil), (*v1alpha1.Uplink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Uplink_To_v1alpha1_Uplink(a.(*Uplink), b.(*v1alpha1.Uplink), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.Uplink)(nil), (*Uplink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Uplink_To_v1beta1_Uplink(a.(*v1alpha1.Uplink), b.(*Uplink), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UplinkHealthCheck)(nil), (*v1alpha1.UplinkHealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_UplinkHealthCheck_To_v1alpha1_UplinkHealthCheck(a.(*UplinkHealthCheck), b.(*v1alpha1.UplinkHealthCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.UplinkHealthCheck)(nil), (*UplinkHealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_UplinkHealthCheck_To_v1beta1_UplinkHealthCheck(a.(*v1alpha1.UplinkHealthCheck), b.(*UplinkHealthCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UplinkList)(nil), (*v1alpha1.UplinkList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_UplinkList_To_v1alpha1_UplinkList(a.(*UplinkList), b.(*v1alpha1.UplinkList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.UplinkList)(nil), (*UplinkList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_UplinkList_To_v1beta1_UplinkList(a.(*v1alpha1.UplinkList), b.(*UplinkList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UplinkPassiveHealthCheck)(nil), (*v1alpha1.UplinkPassiveHealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_UplinkPassiveHealthCheck_To_v1alpha1_UplinkPassiveHealthCheck(a.(*UplinkPassiveHealthCheck), b.(*v1alpha1.UplinkPassiveHealthCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.UplinkPassiveHealthCheck)(nil), (*UplinkPassiveHealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_UplinkPassiveHealthCheck_To_v1beta1_UplinkPassiveHealthCheck(a.(*v1alpha1.UplinkPassiveHealthCheck), b.(*UplinkPassiveHealthCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UplinkSpec)(nil), (*v1alpha1.UplinkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_UplinkSpec_To_v1alpha1_UplinkSpec(a.(*UplinkSpec), b.(*v1alpha1.UplinkSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.UplinkSpec)(nil), (*UplinkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_UplinkSpec_To_v1beta1_UplinkSpec(a.(*v1alpha1.UplinkSpec), b.(*UplinkSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UplinkStatus)(nil), (*v1alpha1.UplinkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_UplinkStatus_To_v1alpha1_UplinkStatus(a.(*UplinkStatus), b.(*v1alpha1.UplinkStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.UplinkStatus)(nil), (*UplinkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_UplinkStatus_To_v1beta1_UplinkStatus(a.(*v1alpha1.UplinkStatus), b.(*UplinkStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((**intstr.IntOrString)(nil), (**Period)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_Pointer_intstr_IntOrString_To_Pointer_v1beta1_Period(a.(**intstr.IntOrString), b.(**Period), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((**Period)(nil), (**intstr.IntOrString)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_Pointer_v1beta1_Period_To_Pointer_intstr_IntOrString(a.(**Period), b.(**intstr.IntOrString), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((**Period)(nil), (*intstr.IntOrString)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_Pointer_v1beta1_Period_To_intstr_IntOrString(a.(**Period), b.(*intstr.IntOrString), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*intstr.IntOrString)(nil), (**Period)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_intstr_IntOrString_To_Pointer_v1beta1_Period(a.(*intstr.IntOrString), b.(**Period), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha1.JWTAuthSpec)(nil), (*JWTAuthSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_JWTAuthSpec_To_v1beta1_JWTAuthSpec(a.(*v1alpha1.JWTAuthSpec), b.(*JWTAuthSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha1.ManagedApplicationStatus)(nil), (*ManagedApplicationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ManagedApplicationStatus_To_v1beta1_ManagedApplicationStatus(a.(*v1alpha1.ManagedApplicationStatus), b.(*ManagedApplicationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha1.ManagedSubscriptionSpec)(nil), (*ManagedSubscriptionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ManagedSubscriptionSpec_To_v1beta1_ManagedSubscriptionSpec(a.(*v1alpha1.ManagedSubscriptionSpec), b.(*ManagedSubscriptionSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*ManagedApplicationStatus)(nil), (*v1alpha1.ManagedApplicationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ManagedApplicationStatus_To_v1alpha1_ManagedApplicationStatus(a.(*ManagedApplicationStatus), b.(*v1alpha1.ManagedApplicationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1beta1_API_To_v1alpha1_API(in *API, out *v1alpha1.API, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_APISpec_To_v1alpha1_APISpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_APIStatus_To_v1alpha1_APIStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_API_To_v1alpha1_API is an autogenerated conversion function.
+func Convert_v1beta1_API_To_v1alpha1_API(in *API, out *v1alpha1.API, s conversion.Scope) error {
+	return autoConvert_v1beta1_API_To_v1alpha1_API(in, out, s)
+}
+
+func autoConvert_v1alpha1_API_To_v1beta1_API(in *v1alpha1.API, out *API, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_APISpec_To_v1beta1_APISpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_APIStatus_To_v1beta1_APIStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_API_To_v1beta1_API is an autogenerated conversion function.
+func Convert_v1alpha1_API_To_v1beta1_API(in *v1alpha1.API, out *API, s conversion.Scope) error {
+	return autoConvert_v1alpha1_API_To_v1beta1_API(in, out, s)
+}
+
+func autoConvert_v1beta1_APIAuth_To_v1alpha1_APIAuth(in *APIAuth, out *v1alpha1.APIAuth, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_APIAuthSpec_To_v1alpha1_APIAuthSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_APIAuthStatus_To_v1alpha1_APIAuthStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_APIAuth_To_v1alpha1_APIAuth is an autogenerated conversion function.
+func Convert_v1beta1_APIAuth_To_v1alpha1_APIAuth(in *APIAuth, out *v1alpha1.APIAuth, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIAuth_To_v1alpha1_APIAuth(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIAuth_To_v1beta1_APIAuth(in *v1alpha1.APIAuth, out *APIAuth, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_APIAuthSpec_To_v1beta1_APIAuthSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_APIAuthStatus_To_v1beta1_APIAuthStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_APIAuth_To_v1beta1_APIAuth is an autogenerated conversion function.
+func Convert_v1alpha1_APIAuth_To_v1beta1_APIAuth(in *v1alpha1.APIAuth, out *APIAuth, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIAuth_To_v1beta1_APIAuth(in, out, s)
+}
+
+func autoConvert_v1beta1_APIAuthList_To_v1alpha1_APIAuthList(in *APIAuthList, out *v1alpha1.APIAuthList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha1.APIAuth, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_APIAuth_To_v1alpha1_APIAuth(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_APIAuthList_To_v1alpha1_APIAuthList is an autogenerated conversion function.
+func Convert_v1beta1_APIAuthList_To_v1alpha1_APIAuthList(in *APIAuthList, out *v1alpha1.APIAuthList, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIAuthList_To_v1alpha1_APIAuthList(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIAuthList_To_v1beta1_APIAuthList(in *v1alpha1.APIAuthList, out *APIAuthList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]APIAuth, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_APIAuth_To_v1beta1_APIAuth(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_APIAuthList_To_v1beta1_APIAuthList is an autogenerated conversion function.
+func Convert_v1alpha1_APIAuthList_To_v1beta1_APIAuthList(in *v1alpha1.APIAuthList, out *APIAuthList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIAuthList_To_v1beta1_APIAuthList(in, out, s)
+}
+
+func autoConvert_v1beta1_APIAuthSpec_To_v1alpha1_APIAuthSpec(in *APIAuthSpec, out *v1alpha1.APIAuthSpec, s conversion.Scope) error {
+	out.IsDefault = in.IsDefault
+	out.APIKey = (*v1alpha1.APIKeyAuthSpec)(unsafe.Pointer(in.APIKey))
+	if in.JWT != nil {
+		in, out := &in.JWT, &out.JWT
+		*out = new(v1alpha1.JWTAuthSpec)
+		if err := Convert_v1beta1_JWTAuthSpec_To_v1alpha1_JWTAuthSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.JWT = nil
+	}
+	out.LDAP = (*v1alpha1.LDAPConnectionConfig)(unsafe.Pointer(in.LDAP))
+	return nil
+}
+
+// Convert_v1beta1_APIAuthSpec_To_v1alpha1_APIAuthSpec is an autogenerated conversion function.
+func Convert_v1beta1_APIAuthSpec_To_v1alpha1_APIAuthSpec(in *APIAuthSpec, out *v1alpha1.APIAuthSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIAuthSpec_To_v1alpha1_APIAuthSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIAuthSpec_To_v1beta1_APIAuthSpec(in *v1alpha1.APIAuthSpec, out *APIAuthSpec, s conversion.Scope) error {
+	out.IsDefault = in.IsDefault
+	out.APIKey = (*APIKeyAuthSpec)(unsafe.Pointer(in.APIKey))
+	if in.JWT != nil {
+		in, out := &in.JWT, &out.JWT
+		*out = new(JWTAuthSpec)
+		if err := Convert_v1alpha1_JWTAuthSpec_To_v1beta1_JWTAuthSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.JWT = nil
+	}
+	out.LDAP = (*LDAPConnectionConfig)(unsafe.Pointer(in.LDAP))
+	return nil
+}
+
+// Convert_v1alpha1_APIAuthSpec_To_v1beta1_APIAuthSpec is an autogenerated conversion function.
+func Convert_v1alpha1_APIAuthSpec_To_v1beta1_APIAuthSpec(in *v1alpha1.APIAuthSpec, out *APIAuthSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIAuthSpec_To_v1beta1_APIAuthSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_APIAuthStatus_To_v1alpha1_APIAuthStatus(in *APIAuthStatus, out *v1alpha1.APIAuthStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.SyncedAt = (*v1.Time)(unsafe.Pointer(in.SyncedAt))
+	out.Hash = in.Hash
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1beta1_APIAuthStatus_To_v1alpha1_APIAuthStatus is an autogenerated conversion function.
+func Convert_v1beta1_APIAuthStatus_To_v1alpha1_APIAuthStatus(in *APIAuthStatus, out *v1alpha1.APIAuthStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIAuthStatus_To_v1alpha1_APIAuthStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIAuthStatus_To_v1beta1_APIAuthStatus(in *v1alpha1.APIAuthStatus, out *APIAuthStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.SyncedAt = (*v1.Time)(unsafe.Pointer(in.SyncedAt))
+	out.Hash = in.Hash
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1alpha1_APIAuthStatus_To_v1beta1_APIAuthStatus is an autogenerated conversion function.
+func Convert_v1alpha1_APIAuthStatus_To_v1beta1_APIAuthStatus(in *v1alpha1.APIAuthStatus, out *APIAuthStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIAuthStatus_To_v1beta1_APIAuthStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_APIBundle_To_v1alpha1_APIBundle(in *APIBundle, out *v1alpha1.APIBundle, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_APIBundleSpec_To_v1alpha1_APIBundleSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_APIBundleStatus_To_v1alpha1_APIBundleStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_APIBundle_To_v1alpha1_APIBundle is an autogenerated conversion function.
+func Convert_v1beta1_APIBundle_To_v1alpha1_APIBundle(in *APIBundle, out *v1alpha1.APIBundle, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIBundle_To_v1alpha1_APIBundle(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIBundle_To_v1beta1_APIBundle(in *v1alpha1.APIBundle, out *APIBundle, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_APIBundleSpec_To_v1beta1_APIBundleSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_APIBundleStatus_To_v1beta1_APIBundleStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_APIBundle_To_v1beta1_APIBundle is an autogenerated conversion function.
+func Convert_v1alpha1_APIBundle_To_v1beta1_APIBundle(in *v1alpha1.APIBundle, out *APIBundle, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIBundle_To_v1beta1_APIBundle(in, out, s)
+}
+
+func autoConvert_v1beta1_APIBundleList_To_v1alpha1_APIBundleList(in *APIBundleList, out *v1alpha1.APIBundleList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.APIBundle)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_APIBundleList_To_v1alpha1_APIBundleList is an autogenerated conversion function.
+func Convert_v1beta1_APIBundleList_To_v1alpha1_APIBundleList(in *APIBundleList, out *v1alpha1.APIBundleList, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIBundleList_To_v1alpha1_APIBundleList(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIBundleList_To_v1beta1_APIBundleList(in *v1alpha1.APIBundleList, out *APIBundleList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]APIBundle)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_APIBundleList_To_v1beta1_APIBundleList is an autogenerated conversion function.
+func Convert_v1alpha1_APIBundleList_To_v1beta1_APIBundleList(in *v1alpha1.APIBundleList, out *APIBundleList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIBundleList_To_v1beta1_APIBundleList(in, out, s)
+}
+
+func autoConvert_v1beta1_APIBundleReference_To_v1alpha1_APIBundleReference(in *APIBundleReference, out *v1alpha1.APIBundleReference, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1beta1_APIBundleReference_To_v1alpha1_APIBundleReference is an autogenerated conversion function.
+func Convert_v1beta1_APIBundleReference_To_v1alpha1_APIBundleReference(in *APIBundleReference, out *v1alpha1.APIBundleReference, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIBundleReference_To_v1alpha1_APIBundleReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIBundleReference_To_v1beta1_APIBundleReference(in *v1alpha1.APIBundleReference, out *APIBundleReference, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_APIBundleReference_To_v1beta1_APIBundleReference is an autogenerated conversion function.
+func Convert_v1alpha1_APIBundleReference_To_v1beta1_APIBundleReference(in *v1alpha1.APIBundleReference, out *APIBundleReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIBundleReference_To_v1beta1_APIBundleReference(in, out, s)
+}
+
+func autoConvert_v1beta1_APIBundleSpec_To_v1alpha1_APIBundleSpec(in *APIBundleSpec, out *v1alpha1.APIBundleSpec, s conversion.Scope) error {
+	out.Title = in.Title
+	out.APISelector = (*v1.LabelSelector)(unsafe.Pointer(in.APISelector))
+	out.APIs = *(*[]v1alpha1.APIReference)(unsafe.Pointer(&in.APIs))
+	return nil
+}
+
+// Convert_v1beta1_APIBundleSpec_To_v1alpha1_APIBundleSpec is an autogenerated conversion function.
+func Convert_v1beta1_APIBundleSpec_To_v1alpha1_APIBundleSpec(in *APIBundleSpec, out *v1alpha1.APIBundleSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIBundleSpec_To_v1alpha1_APIBundleSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIBundleSpec_To_v1beta1_APIBundleSpec(in *v1alpha1.APIBundleSpec, out *APIBundleSpec, s conversion.Scope) error {
+	out.Title = in.Title
+	out.APISelector = (*v1.LabelSelector)(unsafe.Pointer(in.APISelector))
+	out.APIs = *(*[]APIReference)(unsafe.Pointer(&in.APIs))
+	return nil
+}
+
+// Convert_v1alpha1_APIBundleSpec_To_v1beta1_APIBundleSpec is an autogenerated conversion function.
+func Convert_v1alpha1_APIBundleSpec_To_v1beta1_APIBundleSpec(in *v1alpha1.APIBundleSpec, out *APIBundleSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIBundleSpec_To_v1beta1_APIBundleSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_APIBundleStatus_To_v1alpha1_APIBundleStatus(in *APIBundleStatus, out *v1alpha1.APIBundleStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.SyncedAt = (*v1.Time)(unsafe.Pointer(in.SyncedAt))
+	out.Hash = in.Hash
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.ResolvedAPIs = *(*[]v1alpha1.ResolvedAPIReference)(unsafe.Pointer(&in.ResolvedAPIs))
+	out.UnresolvedAPIs = *(*[]v1alpha1.ResolvedAPIReference)(unsafe.Pointer(&in.UnresolvedAPIs))
+	return nil
+}
+
+// Convert_v1beta1_APIBundleStatus_To_v1alpha1_APIBundleStatus is an autogenerated conversion function.
+func Convert_v1beta1_APIBundleStatus_To_v1alpha1_APIBundleStatus(in *APIBundleStatus, out *v1alpha1.APIBundleStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIBundleStatus_To_v1alpha1_APIBundleStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIBundleStatus_To_v1beta1_APIBundleStatus(in *v1alpha1.APIBundleStatus, out *APIBundleStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.SyncedAt = (*v1.Time)(unsafe.Pointer(in.SyncedAt))
+	out.Hash = in.Hash
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.ResolvedAPIs = *(*[]ResolvedAPIReference)(unsafe.Pointer(&in.ResolvedAPIs))
+	out.UnresolvedAPIs = *(*[]ResolvedAPIReference)(unsafe.Pointer(&in.UnresolvedAPIs))
+	return nil
+}
+
+// Convert_v1alpha1_APIBundleStatus_To_v1beta1_APIBundleStatus is an autogenerated conversion function.
+func Convert_v1alpha1_APIBundleStatus_To_v1beta1_APIBundleStatus(in *v1alpha1.APIBundleStatus, out *APIBundleStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIBundleStatus_To_v1beta1_APIBundleStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_APICatalogItem_To_v1alpha1_APICatalogItem(in *APICatalogItem, out *v1alpha1.APICatalogItem, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_APICatalogItemSpec_To_v1alpha1_APICatalogItemSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_APICatalogItemStatus_To_v1alpha1_APICatalogItemStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_APICatalogItem_To_v1alpha1_APICatalogItem is an autogenerated conversion function.
+func Convert_v1beta1_APICatalogItem_To_v1alpha1_APICatalogItem(in *APICatalogItem, out *v1alpha1.APICatalogItem, s conversion.Scope) error {
+	return autoConvert_v1beta1_APICatalogItem_To_v1alpha1_APICatalogItem(in, out, s)
+}
+
+func autoConvert_v1alpha1_APICatalogItem_To_v1beta1_APICatalogItem(in *v1alpha1.APICatalogItem, out *APICatalogItem, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_APICatalogItemSpec_To_v1beta1_APICatalogItemSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_APICatalogItemStatus_To_v1beta1_APICatalogItemStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_APICatalogItem_To_v1beta1_APICatalogItem is an autogenerated conversion function.
+func Convert_v1alpha1_APICatalogItem_To_v1beta1_APICatalogItem(in *v1alpha1.APICatalogItem, out *APICatalogItem, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APICatalogItem_To_v1beta1_APICatalogItem(in, out, s)
+}
+
+func autoConvert_v1beta1_APICatalogItemList_To_v1alpha1_APICatalogItemList(in *APICatalogItemList, out *v1alpha1.APICatalogItemList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.APICatalogItem)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_APICatalogItemList_To_v1alpha1_APICatalogItemList is an autogenerated conversion function.
+func Convert_v1beta1_APICatalogItemList_To_v1alpha1_APICatalogItemList(in *APICatalogItemList, out *v1alpha1.APICatalogItemList, s conversion.Scope) error {
+	return autoConvert_v1beta1_APICatalogItemList_To_v1alpha1_APICatalogItemList(in, out, s)
+}
+
+func autoConvert_v1alpha1_APICatalogItemList_To_v1beta1_APICatalogItemList(in *v1alpha1.APICatalogItemList, out *APICatalogItemList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]APICatalogItem)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_APICatalogItemList_To_v1beta1_APICatalogItemList is an autogenerated conversion function.
+func Convert_v1alpha1_APICatalogItemList_To_v1beta1_APICatalogItemList(in *v1alpha1.APICatalogItemList, out *APICatalogItemList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APICatalogItemList_To_v1beta1_APICatalogItemList(in, out, s)
+}
+
+func autoConvert_v1beta1_APICatalogItemSpec_To_v1alpha1_APICatalogItemSpec(in *APICatalogItemSpec, out *v1alpha1.APICatalogItemSpec, s conversion.Scope) error {
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	out.Everyone = in.Everyone
+	out.APIBundles = *(*[]v1alpha1.APIBundleReference)(unsafe.Pointer(&in.APIBundles))
+	out.APISelector = (*v1.LabelSelector)(unsafe.Pointer(in.APISelector))
+	out.APIs = *(*[]v1alpha1.APIReference)(unsafe.Pointer(&in.APIs))
+	out.OperationFilter = (*v1alpha1.OperationFilter)(unsafe.Pointer(in.OperationFilter))
+	out.APIPlan = (*v1alpha1.APIPlanReference)(unsafe.Pointer(in.APIPlan))
+	return nil
+}
+
+// Convert_v1beta1_APICatalogItemSpec_To_v1alpha1_APICatalogItemSpec is an autogenerated conversion function.
+func Convert_v1beta1_APICatalogItemSpec_To_v1alpha1_APICatalogItemSpec(in *APICatalogItemSpec, out *v1alpha1.APICatalogItemSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_APICatalogItemSpec_To_v1alpha1_APICatalogItemSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_APICatalogItemSpec_To_v1beta1_APICatalogItemSpec(in *v1alpha1.APICatalogItemSpec, out *APICatalogItemSpec, s conversion.Scope) error {
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	out.Everyone = in.Everyone
+	out.APIBundles = *(*[]APIBundleReference)(unsafe.Pointer(&in.APIBundles))
+	out.APISelector = (*v1.LabelSelector)(unsafe.Pointer(in.APISelector))
+	out.APIs = *(*[]APIReference)(unsafe.Pointer(&in.APIs))
+	out.OperationFilter = (*OperationFilter)(unsafe.Pointer(in.OperationFilter))
+	out.APIPlan = (*APIPlanReference)(unsafe.Pointer(in.APIPlan))
+	return nil
+}
+
+// Convert_v1alpha1_APICatalogItemSpec_To_v1beta1_APICatalogItemSpec is an autogenerated conversion function.
+func Convert_v1alpha1_APICatalogItemSpec_To_v1beta1_APICatalogItemSpec(in *v1alpha1.APICatalogItemSpec, out *APICatalogItemSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APICatalogItemSpec_To_v1beta1_APICatalogItemSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_APICatalogItemStatus_To_v1alpha1_APICatalogItemStatus(in *APICatalogItemStatus, out *v1alpha1.APICatalogItemStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.SyncedAt = (*v1.Time)(unsafe.Pointer(in.SyncedAt))
+	out.Hash = in.Hash
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.ResolvedAPIs = *(*[]v1alpha1.ResolvedAPIReference)(unsafe.Pointer(&in.ResolvedAPIs))
+	out.UnresolvedAPIs = *(*[]v1alpha1.ResolvedAPIReference)(unsafe.Pointer(&in.UnresolvedAPIs))
+	return nil
+}
+
+// Convert_v1beta1_APICatalogItemStatus_To_v1alpha1_APICatalogItemStatus is an autogenerated conversion function.
+func Convert_v1beta1_APICatalogItemStatus_To_v1alpha1_APICatalogItemStatus(in *APICatalogItemStatus, out *v1alpha1.APICatalogItemStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_APICatalogItemStatus_To_v1alpha1_APICatalogItemStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_APICatalogItemStatus_To_v1beta1_APICatalogItemStatus(in *v1alpha1.APICatalogItemStatus, out *APICatalogItemStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.SyncedAt = (*v1.Time)(unsafe.Pointer(in.SyncedAt))
+	out.Hash = in.Hash
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.ResolvedAPIs = *(*[]ResolvedAPIReference)(unsafe.Pointer(&in.ResolvedAPIs))
+	out.UnresolvedAPIs = *(*[]ResolvedAPIReference)(unsafe.Pointer(&in.UnresolvedAPIs))
+	return nil
+}
+
+// Convert_v1alpha1_APICatalogItemStatus_To_v1beta1_APICatalogItemStatus is an autogenerated conversion function.
+func Convert_v1alpha1_APICatalogItemStatus_To_v1beta1_APICatalogItemStatus(in *v1alpha1.APICatalogItemStatus, out *APICatalogItemStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APICatalogItemStatus_To_v1beta1_APICatalogItemStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_APIKey_To_v1alpha1_APIKey(in *APIKey, out *v1alpha1.APIKey, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.Value = in.Value
+	out.Title = in.Title
+	out.Suspended = in.Suspended
+	return nil
+}
+
+// Convert_v1beta1_APIKey_To_v1alpha1_APIKey is an autogenerated conversion function.
+func Convert_v1beta1_APIKey_To_v1alpha1_APIKey(in *APIKey, out *v1alpha1.APIKey, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIKey_To_v1alpha1_APIKey(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIKey_To_v1beta1_APIKey(in *v1alpha1.APIKey, out *APIKey, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.Value = in.Value
+	out.Title = in.Title
+	out.Suspended = in.Suspended
+	return nil
+}
+
+// Convert_v1alpha1_APIKey_To_v1beta1_APIKey is an autogenerated conversion function.
+func Convert_v1alpha1_APIKey_To_v1beta1_APIKey(in *v1alpha1.APIKey, out *APIKey, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIKey_To_v1beta1_APIKey(in, out, s)
+}
+
+func autoConvert_v1beta1_APIKeyAuthSpec_To_v1alpha1_APIKeyAuthSpec(in *APIKeyAuthSpec, out *v1alpha1.APIKeyAuthSpec, s conversion.Scope) error {
+	out.KeySource = (*v1alpha1.APIKeySource)(unsafe.Pointer(in.KeySource))
+	return nil
+}
+
+// Convert_v1beta1_APIKeyAuthSpec_To_v1alpha1_APIKeyAuthSpec is an autogenerated conversion function.
+func Convert_v1beta1_APIKeyAuthSpec_To_v1alpha1_APIKeyAuthSpec(in *APIKeyAuthSpec, out *v1alpha1.APIKeyAuthSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIKeyAuthSpec_To_v1alpha1_APIKeyAuthSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIKeyAuthSpec_To_v1beta1_APIKeyAuthSpec(in *v1alpha1.APIKeyAuthSpec, out *APIKeyAuthSpec, s conversion.Scope) error {
+	out.KeySource = (*APIKeySource)(unsafe.Pointer(in.KeySource))
+	return nil
+}
+
+// Convert_v1alpha1_APIKeyAuthSpec_To_v1beta1_APIKeyAuthSpec is an autogenerated conversion function.
+func Convert_v1alpha1_APIKeyAuthSpec_To_v1beta1_APIKeyAuthSpec(in *v1alpha1.APIKeyAuthSpec, out *APIKeyAuthSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIKeyAuthSpec_To_v1beta1_APIKeyAuthSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_APIKeySource_To_v1alpha1_APIKeySource(in *APIKeySource, out *v1alpha1.APIKeySource, s conversion.Scope) error {
+	out.Header = in.Header
+	out.HeaderAuthScheme = in.HeaderAuthScheme
+	out.Query = in.Query
+	return nil
+}
+
+// Convert_v1beta1_APIKeySource_To_v1alpha1_APIKeySource is an autogenerated conversion function.
+func Convert_v1beta1_APIKeySource_To_v1alpha1_APIKeySource(in *APIKeySource, out *v1alpha1.APIKeySource, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIKeySource_To_v1alpha1_APIKeySource(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIKeySource_To_v1beta1_APIKeySource(in *v1alpha1.APIKeySource, out *APIKeySource, s conversion.Scope) error {
+	out.Header = in.Header
+	out.HeaderAuthScheme = in.HeaderAuthScheme
+	out.Query = in.Query
+	return nil
+}
+
+// Convert_v1alpha1_APIKeySource_To_v1beta1_APIKeySource is an autogenerated conversion function.
+func Convert_v1alpha1_APIKeySource_To_v1beta1_APIKeySource(in *v1alpha1.APIKeySource, out *APIKeySource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIKeySource_To_v1beta1_APIKeySource(in, out, s)
+}
+
+func autoConvert_v1beta1_APIList_To_v1alpha1_APIList(in *APIList, out *v1alpha1.APIList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.API)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_APIList_To_v1alpha1_APIList is an autogenerated conversion function.
+func Convert_v1beta1_APIList_To_v1alpha1_APIList(in *APIList, out *v1alpha1.APIList, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIList_To_v1alpha1_APIList(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIList_To_v1beta1_APIList(in *v1alpha1.APIList, out *APIList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]API)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_APIList_To_v1beta1_APIList is an autogenerated conversion function.
+func Convert_v1alpha1_APIList_To_v1beta1_APIList(in *v1alpha1.APIList, out *APIList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIList_To_v1beta1_APIList(in, out, s)
+}
+
+func autoConvert_v1beta1_APIPlan_To_v1alpha1_APIPlan(in *APIPlan, out *v1alpha1.APIPlan, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_APIPlanSpec_To_v1alpha1_APIPlanSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_APIPlanStatus_To_v1alpha1_APIPlanStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_APIPlan_To_v1alpha1_APIPlan is an autogenerated conversion function.
+func Convert_v1beta1_APIPlan_To_v1alpha1_APIPlan(in *APIPlan, out *v1alpha1.APIPlan, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIPlan_To_v1alpha1_APIPlan(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIPlan_To_v1beta1_APIPlan(in *v1alpha1.APIPlan, out *APIPlan, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_APIPlanSpec_To_v1beta1_APIPlanSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_APIPlanStatus_To_v1beta1_APIPlanStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_APIPlan_To_v1beta1_APIPlan is an autogenerated conversion function.
+func Convert_v1alpha1_APIPlan_To_v1beta1_APIPlan(in *v1alpha1.APIPlan, out *APIPlan, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIPlan_To_v1beta1_APIPlan(in, out, s)
+}
+
+func autoConvert_v1beta1_APIPlanList_To_v1alpha1_APIPlanList(in *APIPlanList, out *v1alpha1.APIPlanList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.APIPlan)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_APIPlanList_To_v1alpha1_APIPlanList is an autogenerated conversion function.
+func Convert_v1beta1_APIPlanList_To_v1alpha1_APIPlanList(in *APIPlanList, out *v1alpha1.APIPlanList, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIPlanList_To_v1alpha1_APIPlanList(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIPlanList_To_v1beta1_APIPlanList(in *v1alpha1.APIPlanList, out *APIPlanList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]APIPlan)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_APIPlanList_To_v1beta1_APIPlanList is an autogenerated conversion function.
+func Convert_v1alpha1_APIPlanList_To_v1beta1_APIPlanList(in *v1alpha1.APIPlanList, out *APIPlanList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIPlanList_To_v1beta1_APIPlanList(in, out, s)
+}
+
+func autoConvert_v1beta1_APIPlanReference_To_v1alpha1_APIPlanReference(in *APIPlanReference, out *v1alpha1.APIPlanReference, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1beta1_APIPlanReference_To_v1alpha1_APIPlanReference is an autogenerated conversion function.
+func Convert_v1beta1_APIPlanReference_To_v1alpha1_APIPlanReference(in *APIPlanReference, out *v1alpha1.APIPlanReference, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIPlanReference_To_v1alpha1_APIPlanReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIPlanReference_To_v1beta1_APIPlanReference(in *v1alpha1.APIPlanReference, out *APIPlanReference, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_APIPlanReference_To_v1beta1_APIPlanReference is an autogenerated conversion function.
+func Convert_v1alpha1_APIPlanReference_To_v1beta1_APIPlanReference(in *v1alpha1.APIPlanReference, out *APIPlanReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIPlanReference_To_v1beta1_APIPlanReference(in, out, s)
+}
+
+func autoConvert_v1beta1_APIPlanSpec_To_v1alpha1_APIPlanSpec(in *APIPlanSpec, out *v1alpha1.APIPlanSpec, s conversion.Scope) error {
+	out.Title = in.Title
+	out.Description = in.Description
+	out.RateLimit = (*v1alpha1.RateLimit)(unsafe.Pointer(in.RateLimit))
+	out.Quota = (*v1alpha1.Quota)(unsafe.Pointer(in.Quota))
+	out.TokenQuota = (*v1alpha1.TokenQuota)(unsafe.Pointer(in.TokenQuota))
+	return nil
+}
+
+// Convert_v1beta1_APIPlanSpec_To_v1alpha1_APIPlanSpec is an autogenerated conversion function.
+func Convert_v1beta1_APIPlanSpec_To_v1alpha1_APIPlanSpec(in *APIPlanSpec, out *v1alpha1.APIPlanSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIPlanSpec_To_v1alpha1_APIPlanSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIPlanSpec_To_v1beta1_APIPlanSpec(in *v1alpha1.APIPlanSpec, out *APIPlanSpec, s conversion.Scope) error {
+	out.Title = in.Title
+	out.Description = in.Description
+	out.RateLimit = (*RateLimit)(unsafe.Pointer(in.RateLimit))
+	out.Quota = (*Quota)(unsafe.Pointer(in.Quota))
+	out.TokenQuota = (*TokenQuota)(unsafe.Pointer(in.TokenQuota))
+	return nil
+}
+
+// Convert_v1alpha1_APIPlanSpec_To_v1beta1_APIPlanSpec is an autogenerated conversion function.
+func Convert_v1alpha1_APIPlanSpec_To_v1beta1_APIPlanSpec(in *v1alpha1.APIPlanSpec, out *APIPlanSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIPlanSpec_To_v1beta1_APIPlanSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_APIPlanStatus_To_v1alpha1_APIPlanStatus(in *APIPlanStatus, out *v1alpha1.APIPlanStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.SyncedAt = (*v1.Time)(unsafe.Pointer(in.SyncedAt))
+	out.Hash = in.Hash
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1beta1_APIPlanStatus_To_v1alpha1_APIPlanStatus is an autogenerated conversion function.
+func Convert_v1beta1_APIPlanStatus_To_v1alpha1_APIPlanStatus(in *APIPlanStatus, out *v1alpha1.APIPlanStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIPlanStatus_To_v1alpha1_APIPlanStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIPlanStatus_To_v1beta1_APIPlanStatus(in *v1alpha1.APIPlanStatus, out *APIPlanStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.SyncedAt = (*v1.Time)(unsafe.Pointer(in.SyncedAt))
+	out.Hash = in.Hash
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1alpha1_APIPlanStatus_To_v1beta1_APIPlanStatus is an autogenerated conversion function.
+func Convert_v1alpha1_APIPlanStatus_To_v1beta1_APIPlanStatus(in *v1alpha1.APIPlanStatus, out *APIPlanStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIPlanStatus_To_v1beta1_APIPlanStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_APIPortal_To_v1alpha1_APIPortal(in *APIPortal, out *v1alpha1.APIPortal, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_APIPortalSpec_To_v1alpha1_APIPortalSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_APIPortalStatus_To_v1alpha1_APIPortalStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_APIPortal_To_v1alpha1_APIPortal is an autogenerated conversion function.
+func Convert_v1beta1_APIPortal_To_v1alpha1_APIPortal(in *APIPortal, out *v1alpha1.APIPortal, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIPortal_To_v1alpha1_APIPortal(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIPortal_To_v1beta1_APIPortal(in *v1alpha1.APIPortal, out *APIPortal, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_APIPortalSpec_To_v1beta1_APIPortalSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_APIPortalStatus_To_v1beta1_APIPortalStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_APIPortal_To_v1beta1_APIPortal is an autogenerated conversion function.
+func Convert_v1alpha1_APIPortal_To_v1beta1_APIPortal(in *v1alpha1.APIPortal, out *APIPortal, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIPortal_To_v1beta1_APIPortal(in, out, s)
+}
+
+func autoConvert_v1beta1_APIPortalAuth_To_v1alpha1_APIPortalAuth(in *APIPortalAuth, out *v1alpha1.APIPortalAuth, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_APIPortalAuthSpec_To_v1alpha1_APIPortalAuthSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_APIPortalAuthStatus_To_v1alpha1_APIPortalAuthStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_APIPortalAuth_To_v1alpha1_APIPortalAuth is an autogenerated conversion function.
+func Convert_v1beta1_APIPortalAuth_To_v1alpha1_APIPortalAuth(in *APIPortalAuth, out *v1alpha1.APIPortalAuth, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIPortalAuth_To_v1alpha1_APIPortalAuth(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIPortalAuth_To_v1beta1_APIPortalAuth(in *v1alpha1.APIPortalAuth, out *APIPortalAuth, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_APIPortalAuthSpec_To_v1beta1_APIPortalAuthSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_APIPortalAuthStatus_To_v1beta1_APIPortalAuthStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_APIPortalAuth_To_v1beta1_APIPortalAuth is an autogenerated conversion function.
+func Convert_v1alpha1_APIPortalAuth_To_v1beta1_APIPortalAuth(in *v1alpha1.APIPortalAuth, out *APIPortalAuth, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIPortalAuth_To_v1beta1_APIPortalAuth(in, out, s)
+}
+
+func autoConvert_v1beta1_APIPortalAuthList_To_v1alpha1_APIPortalAuthList(in *APIPortalAuthList, out *v1alpha1.APIPortalAuthList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.APIPortalAuth)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_APIPortalAuthList_To_v1alpha1_APIPortalAuthList is an autogenerated conversion function.
+func Convert_v1beta1_APIPortalAuthList_To_v1alpha1_APIPortalAuthList(in *APIPortalAuthList, out *v1alpha1.APIPortalAuthList, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIPortalAuthList_To_v1alpha1_APIPortalAuthList(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIPortalAuthList_To_v1beta1_APIPortalAuthList(in *v1alpha1.APIPortalAuthList, out *APIPortalAuthList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]APIPortalAuth)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_APIPortalAuthList_To_v1beta1_APIPortalAuthList is an autogenerated conversion function.
+func Convert_v1alpha1_APIPortalAuthList_To_v1beta1_APIPortalAuthList(in *v1alpha1.APIPortalAuthList, out *APIPortalAuthList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIPortalAuthList_To_v1beta1_APIPortalAuthList(in, out, s)
+}
+
+func autoConvert_v1beta1_APIPortalAuthReference_To_v1alpha1_APIPortalAuthReference(in *APIPortalAuthReference, out *v1alpha1.APIPortalAuthReference, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1beta1_APIPortalAuthReference_To_v1alpha1_APIPortalAuthReference is an autogenerated conversion function.
+func Convert_v1beta1_APIPortalAuthReference_To_v1alpha1_APIPortalAuthReference(in *APIPortalAuthReference, out *v1alpha1.APIPortalAuthReference, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIPortalAuthReference_To_v1alpha1_APIPortalAuthReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIPortalAuthReference_To_v1beta1_APIPortalAuthReference(in *v1alpha1.APIPortalAuthReference, out *APIPortalAuthReference, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_APIPortalAuthReference_To_v1beta1_APIPortalAuthReference is an autogenerated conversion function.
+func Convert_v1alpha1_APIPortalAuthReference_To_v1beta1_APIPortalAuthReference(in *v1alpha1.APIPortalAuthReference, out *APIPortalAuthReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIPortalAuthReference_To_v1beta1_APIPortalAuthReference(in, out, s)
+}
+
+func autoConvert_v1beta1_APIPortalAuthSpec_To_v1alpha1_APIPortalAuthSpec(in *APIPortalAuthSpec, out *v1alpha1.APIPortalAuthSpec, s conversion.Scope) error {
+	out.OIDC = (*v1alpha1.OIDCConfig)(unsafe.Pointer(in.OIDC))
+	out.LDAP = (*v1alpha1.PortalAuthLDAPConfig)(unsafe.Pointer(in.LDAP))
+	return nil
+}
+
+// Convert_v1beta1_APIPortalAuthSpec_To_v1alpha1_APIPortalAuthSpec is an autogenerated conversion function.
+func Convert_v1beta1_APIPortalAuthSpec_To_v1alpha1_APIPortalAuthSpec(in *APIPortalAuthSpec, out *v1alpha1.APIPortalAuthSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIPortalAuthSpec_To_v1alpha1_APIPortalAuthSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIPortalAuthSpec_To_v1beta1_APIPortalAuthSpec(in *v1alpha1.APIPortalAuthSpec, out *APIPortalAuthSpec, s conversion.Scope) error {
+	out.OIDC = (*OIDCConfig)(unsafe.Pointer(in.OIDC))
+	out.LDAP = (*PortalAuthLDAPConfig)(unsafe.Pointer(in.LDAP))
+	return nil
+}
+
+// Convert_v1alpha1_APIPortalAuthSpec_To_v1beta1_APIPortalAuthSpec is an autogenerated conversion function.
+func Convert_v1alpha1_APIPortalAuthSpec_To_v1beta1_APIPortalAuthSpec(in *v1alpha1.APIPortalAuthSpec, out *APIPortalAuthSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIPortalAuthSpec_To_v1beta1_APIPortalAuthSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_APIPortalAuthStatus_To_v1alpha1_APIPortalAuthStatus(in *APIPortalAuthStatus, out *v1alpha1.APIPortalAuthStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.SyncedAt = (*v1.Time)(unsafe.Pointer(in.SyncedAt))
+	out.Hash = in.Hash
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1beta1_APIPortalAuthStatus_To_v1alpha1_APIPortalAuthStatus is an autogenerated conversion function.
+func Convert_v1beta1_APIPortalAuthStatus_To_v1alpha1_APIPortalAuthStatus(in *APIPortalAuthStatus, out *v1alpha1.APIPortalAuthStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIPortalAuthStatus_To_v1alpha1_APIPortalAuthStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIPortalAuthStatus_To_v1beta1_APIPortalAuthStatus(in *v1alpha1.APIPortalAuthStatus, out *APIPortalAuthStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.SyncedAt = (*v1.Time)(unsafe.Pointer(in.SyncedAt))
+	out.Hash = in.Hash
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1alpha1_APIPortalAuthStatus_To_v1beta1_APIPortalAuthStatus is an autogenerated conversion function.
+func Convert_v1alpha1_APIPortalAuthStatus_To_v1beta1_APIPortalAuthStatus(in *v1alpha1.APIPortalAuthStatus, out *APIPortalAuthStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIPortalAuthStatus_To_v1beta1_APIPortalAuthStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_APIPortalList_To_v1alpha1_APIPortalList(in *APIPortalList, out *v1alpha1.APIPortalList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.APIPortal)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_APIPortalList_To_v1alpha1_APIPortalList is an autogenerated conversion function.
+func Convert_v1beta1_APIPortalList_To_v1alpha1_APIPortalList(in *APIPortalList, out *v1alpha1.APIPortalList, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIPortalList_To_v1alpha1_APIPortalList(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIPortalList_To_v1beta1_APIPortalList(in *v1alpha1.APIPortalList, out *APIPortalList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]APIPortal)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_APIPortalList_To_v1beta1_APIPortalList is an autogenerated conversion function.
+func Convert_v1alpha1_APIPortalList_To_v1beta1_APIPortalList(in *v1alpha1.APIPortalList, out *APIPortalList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIPortalList_To_v1beta1_APIPortalList(in, out, s)
+}
+
+func autoConvert_v1beta1_APIPortalSpec_To_v1alpha1_APIPortalSpec(in *APIPortalSpec, out *v1alpha1.APIPortalSpec, s conversion.Scope) error {
+	out.Title = in.Title
+	out.Description = in.Description
+	out.TrustedURLs = *(*[]string)(unsafe.Pointer(&in.TrustedURLs))
+	out.UI = (*v1alpha1.UISpec)(unsafe.Pointer(in.UI))
+	out.Auth = (*v1alpha1.APIPortalAuthReference)(unsafe.Pointer(in.Auth))
+	return nil
+}
+
+// Convert_v1beta1_APIPortalSpec_To_v1alpha1_APIPortalSpec is an autogenerated conversion function.
+func Convert_v1beta1_APIPortalSpec_To_v1alpha1_APIPortalSpec(in *APIPortalSpec, out *v1alpha1.APIPortalSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIPortalSpec_To_v1alpha1_APIPortalSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIPortalSpec_To_v1beta1_APIPortalSpec(in *v1alpha1.APIPortalSpec, out *APIPortalSpec, s conversion.Scope) error {
+	out.Title = in.Title
+	out.Description = in.Description
+	out.TrustedURLs = *(*[]string)(unsafe.Pointer(&in.TrustedURLs))
+	out.UI = (*UISpec)(unsafe.Pointer(in.UI))
+	out.Auth = (*APIPortalAuthReference)(unsafe.Pointer(in.Auth))
+	return nil
+}
+
+// Convert_v1alpha1_APIPortalSpec_To_v1beta1_APIPortalSpec is an autogenerated conversion function.
+func Convert_v1alpha1_APIPortalSpec_To_v1beta1_APIPortalSpec(in *v1alpha1.APIPortalSpec, out *APIPortalSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIPortalSpec_To_v1beta1_APIPortalSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_APIPortalStatus_To_v1alpha1_APIPortalStatus(in *APIPortalStatus, out *v1alpha1.APIPortalStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.SyncedAt = (*v1.Time)(unsafe.Pointer(in.SyncedAt))
+	out.OIDC = (*v1alpha1.OIDCConfigStatus)(unsafe.Pointer(in.OIDC))
+	out.Hash = in.Hash
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1beta1_APIPortalStatus_To_v1alpha1_APIPortalStatus is an autogenerated conversion function.
+func Convert_v1beta1_APIPortalStatus_To_v1alpha1_APIPortalStatus(in *APIPortalStatus, out *v1alpha1.APIPortalStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIPortalStatus_To_v1alpha1_APIPortalStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIPortalStatus_To_v1beta1_APIPortalStatus(in *v1alpha1.APIPortalStatus, out *APIPortalStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.SyncedAt = (*v1.Time)(unsafe.Pointer(in.SyncedAt))
+	out.OIDC = (*OIDCConfigStatus)(unsafe.Pointer(in.OIDC))
+	out.Hash = in.Hash
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1alpha1_APIPortalStatus_To_v1beta1_APIPortalStatus is an autogenerated conversion function.
+func Convert_v1alpha1_APIPortalStatus_To_v1beta1_APIPortalStatus(in *v1alpha1.APIPortalStatus, out *APIPortalStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIPortalStatus_To_v1beta1_APIPortalStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_APIReference_To_v1alpha1_APIReference(in *APIReference, out *v1alpha1.APIReference, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1beta1_APIReference_To_v1alpha1_APIReference is an autogenerated conversion function.
+func Convert_v1beta1_APIReference_To_v1alpha1_APIReference(in *APIReference, out *v1alpha1.APIReference, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIReference_To_v1alpha1_APIReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIReference_To_v1beta1_APIReference(in *v1alpha1.APIReference, out *APIReference, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_APIReference_To_v1beta1_APIReference is an autogenerated conversion function.
+func Convert_v1alpha1_APIReference_To_v1beta1_APIReference(in *v1alpha1.APIReference, out *APIReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIReference_To_v1beta1_APIReference(in, out, s)
+}
+
+func autoConvert_v1beta1_APISpec_To_v1alpha1_APISpec(in *APISpec, out *v1alpha1.APISpec, s conversion.Scope) error {
+	out.Title = in.Title
+	out.Description = in.Description
+	out.OpenAPISpec = (*v1alpha1.OpenAPISpec)(unsafe.Pointer(in.OpenAPISpec))
+	out.Versions = *(*[]v1alpha1.APIVersionRef)(unsafe.Pointer(&in.Versions))
+	out.Cors = (*v1alpha1.Cors)(unsafe.Pointer(in.Cors))
+	return nil
+}
+
+// Convert_v1beta1_APISpec_To_v1alpha1_APISpec is an autogenerated conversion function.
+func Convert_v1beta1_APISpec_To_v1alpha1_APISpec(in *APISpec, out *v1alpha1.APISpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_APISpec_To_v1alpha1_APISpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_APISpec_To_v1beta1_APISpec(in *v1alpha1.APISpec, out *APISpec, s conversion.Scope) error {
+	out.Title = in.Title
+	out.Description = in.Description
+	out.OpenAPISpec = (*OpenAPISpec)(unsafe.Pointer(in.OpenAPISpec))
+	out.Versions = *(*[]APIVersionRef)(unsafe.Pointer(&in.Versions))
+	out.Cors = (*Cors)(unsafe.Pointer(in.Cors))
+	return nil
+}
+
+// Convert_v1alpha1_APISpec_To_v1beta1_APISpec is an autogenerated conversion function.
+func Convert_v1alpha1_APISpec_To_v1beta1_APISpec(in *v1alpha1.APISpec, out *APISpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APISpec_To_v1beta1_APISpec(in, out, s)
+}
+
+func autoConvert_v1beta1_APIStatus_To_v1alpha1_APIStatus(in *APIStatus, out *v1alpha1.APIStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.SyncedAt = (*v1.Time)(unsafe.Pointer(in.SyncedAt))
+	out.Hash = in.Hash
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1beta1_APIStatus_To_v1alpha1_APIStatus is an autogenerated conversion function.
+func Convert_v1beta1_APIStatus_To_v1alpha1_APIStatus(in *APIStatus, out *v1alpha1.APIStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIStatus_To_v1alpha1_APIStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIStatus_To_v1beta1_APIStatus(in *v1alpha1.APIStatus, out *APIStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.SyncedAt = (*v1.Time)(unsafe.Pointer(in.SyncedAt))
+	out.Hash = in.Hash
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1alpha1_APIStatus_To_v1beta1_APIStatus is an autogenerated conversion function.
+func Convert_v1alpha1_APIStatus_To_v1beta1_APIStatus(in *v1alpha1.APIStatus, out *APIStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIStatus_To_v1beta1_APIStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_APIVersion_To_v1alpha1_APIVersion(in *APIVersion, out *v1alpha1.APIVersion, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_APIVersionSpec_To_v1alpha1_APIVersionSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_APIVersionStatus_To_v1alpha1_APIVersionStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_APIVersion_To_v1alpha1_APIVersion is an autogenerated conversion function.
+func Convert_v1beta1_APIVersion_To_v1alpha1_APIVersion(in *APIVersion, out *v1alpha1.APIVersion, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIVersion_To_v1alpha1_APIVersion(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIVersion_To_v1beta1_APIVersion(in *v1alpha1.APIVersion, out *APIVersion, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_APIVersionSpec_To_v1beta1_APIVersionSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_APIVersionStatus_To_v1beta1_APIVersionStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_APIVersion_To_v1beta1_APIVersion is an autogenerated conversion function.
+func Convert_v1alpha1_APIVersion_To_v1beta1_APIVersion(in *v1alpha1.APIVersion, out *APIVersion, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIVersion_To_v1beta1_APIVersion(in, out, s)
+}
+
+func autoConvert_v1beta1_APIVersionList_To_v1alpha1_APIVersionList(in *APIVersionList, out *v1alpha1.APIVersionList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.APIVersion)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_APIVersionList_To_v1alpha1_APIVersionList is an autogenerated conversion function.
+func Convert_v1beta1_APIVersionList_To_v1alpha1_APIVersionList(in *APIVersionList, out *v1alpha1.APIVersionList, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIVersionList_To_v1alpha1_APIVersionList(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIVersionList_To_v1beta1_APIVersionList(in *v1alpha1.APIVersionList, out *APIVersionList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]APIVersion)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_APIVersionList_To_v1beta1_APIVersionList is an autogenerated conversion function.
+func Convert_v1alpha1_APIVersionList_To_v1beta1_APIVersionList(in *v1alpha1.APIVersionList, out *APIVersionList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIVersionList_To_v1beta1_APIVersionList(in, out, s)
+}
+
+func autoConvert_v1beta1_APIVersionRef_To_v1alpha1_APIVersionRef(in *APIVersionRef, out *v1alpha1.APIVersionRef, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1beta1_APIVersionRef_To_v1alpha1_APIVersionRef is an autogenerated conversion function.
+func Convert_v1beta1_APIVersionRef_To_v1alpha1_APIVersionRef(in *APIVersionRef, out *v1alpha1.APIVersionRef, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIVersionRef_To_v1alpha1_APIVersionRef(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIVersionRef_To_v1beta1_APIVersionRef(in *v1alpha1.APIVersionRef, out *APIVersionRef, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_APIVersionRef_To_v1beta1_APIVersionRef is an autogenerated conversion function.
+func Convert_v1alpha1_APIVersionRef_To_v1beta1_APIVersionRef(in *v1alpha1.APIVersionRef, out *APIVersionRef, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIVersionRef_To_v1beta1_APIVersionRef(in, out, s)
+}
+
+func autoConvert_v1beta1_APIVersionSpec_To_v1alpha1_APIVersionSpec(in *APIVersionSpec, out *v1alpha1.APIVersionSpec, s conversion.Scope) error {
+	out.Title = in.Title
+	out.Description = in.Description
+	out.Release = in.Release
+	out.OpenAPISpec = (*v1alpha1.OpenAPISpec)(unsafe.Pointer(in.OpenAPISpec))
+	out.Cors = (*v1alpha1.Cors)(unsafe.Pointer(in.Cors))
+	return nil
+}
+
+// Convert_v1beta1_APIVersionSpec_To_v1alpha1_APIVersionSpec is an autogenerated conversion function.
+func Convert_v1beta1_APIVersionSpec_To_v1alpha1_APIVersionSpec(in *APIVersionSpec, out *v1alpha1.APIVersionSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIVersionSpec_To_v1alpha1_APIVersionSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIVersionSpec_To_v1beta1_APIVersionSpec(in *v1alpha1.APIVersionSpec, out *APIVersionSpec, s conversion.Scope) error {
+	out.Title = in.Title
+	out.Description = in.Description
+	out.Release = in.Release
+	out.OpenAPISpec = (*OpenAPISpec)(unsafe.Pointer(in.OpenAPISpec))
+	out.Cors = (*Cors)(unsafe.Pointer(in.Cors))
+	return nil
+}
+
+// Convert_v1alpha1_APIVersionSpec_To_v1beta1_APIVersionSpec is an autogenerated conversion function.
+func Convert_v1alpha1_APIVersionSpec_To_v1beta1_APIVersionSpec(in *v1alpha1.APIVersionSpec, out *APIVersionSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIVersionSpec_To_v1beta1_APIVersionSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_APIVersionStatus_To_v1alpha1_APIVersionStatus(in *APIVersionStatus, out *v1alpha1.APIVersionStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.SyncedAt = (*v1.Time)(unsafe.Pointer(in.SyncedAt))
+	out.Hash = in.Hash
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1beta1_APIVersionStatus_To_v1alpha1_APIVersionStatus is an autogenerated conversion function.
+func Convert_v1beta1_APIVersionStatus_To_v1alpha1_APIVersionStatus(in *APIVersionStatus, out *v1alpha1.APIVersionStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIVersionStatus_To_v1alpha1_APIVersionStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_APIVersionStatus_To_v1beta1_APIVersionStatus(in *v1alpha1.APIVersionStatus, out *APIVersionStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.SyncedAt = (*v1.Time)(unsafe.Pointer(in.SyncedAt))
+	out.Hash = in.Hash
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1alpha1_APIVersionStatus_To_v1beta1_APIVersionStatus is an autogenerated conversion function.
+func Convert_v1alpha1_APIVersionStatus_To_v1beta1_APIVersionStatus(in *v1alpha1.APIVersionStatus, out *APIVersionStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIVersionStatus_To_v1beta1_APIVersionStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_Attributes_To_v1alpha1_Attributes(in *Attributes, out *v1alpha1.Attributes, s conversion.Scope) error {
+	out.UserID = in.UserID
+	out.Firstname = in.Firstname
+	out.Lastname = in.Lastname
+	out.Email = in.Email
+	out.Company = in.Company
+	return nil
+}
+
+// Convert_v1beta1_Attributes_To_v1alpha1_Attributes is an autogenerated conversion function.
+func Convert_v1beta1_Attributes_To_v1alpha1_Attributes(in *Attributes, out *v1alpha1.Attributes, s conversion.Scope) error {
+	return autoConvert_v1beta1_Attributes_To_v1alpha1_Attributes(in, out, s)
+}
+
+func autoConvert_v1alpha1_Attributes_To_v1beta1_Attributes(in *v1alpha1.Attributes, out *Attributes, s conversion.Scope) error {
+	out.UserID = in.UserID
+	out.Firstname = in.Firstname
+	out.Lastname = in.Lastname
+	out.Email = in.Email
+	out.Company = in.Company
+	return nil
+}
+
+// Convert_v1alpha1_Attributes_To_v1beta1_Attributes is an autogenerated conversion function.
+func Convert_v1alpha1_Attributes_To_v1beta1_Attributes(in *v1alpha1.Attributes, out *Attributes, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Attributes_To_v1beta1_Attributes(in, out, s)
+}
+
+func autoConvert_v1beta1_Claims_To_v1alpha1_Claims(in *Claims, out *v1alpha1.Claims, s conversion.Scope) error {
+	out.Groups = in.Groups
+	out.UserID = in.UserID
+	out.Firstname = in.Firstname
+	out.Lastname = in.Lastname
+	out.Email = in.Email
+	out.Company = in.Company
+	out.OrganizationID = in.OrganizationID
+	out.OrganizationName = in.OrganizationName
+	return nil
+}
+
+// Convert_v1beta1_Claims_To_v1alpha1_Claims is an autogenerated conversion function.
+func Convert_v1beta1_Claims_To_v1alpha1_Claims(in *Claims, out *v1alpha1.Claims, s conversion.Scope) error {
+	return autoConvert_v1beta1_Claims_To_v1alpha1_Claims(in, out, s)
+}
+
+func autoConvert_v1alpha1_Claims_To_v1beta1_Claims(in *v1alpha1.Claims, out *Claims, s conversion.Scope) error {
+	out.Groups = in.Groups
+	out.UserID = in.UserID
+	out.Firstname = in.Firstname
+	out.Lastname = in.Lastname
+	out.Email = in.Email
+	out.Company = in.Company
+	out.OrganizationID = in.OrganizationID
+	out.OrganizationName = in.OrganizationName
+	return nil
+}
+
+// Convert_v1alpha1_Claims_To_v1beta1_Claims is an autogenerated conversion function.
+func Convert_v1alpha1_Claims_To_v1beta1_Claims(in *v1alpha1.Claims, out *Claims, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Claims_To_v1beta1_Claims(in, out, s)
+}
+
+func autoConvert_v1beta1_ContentItem_To_v1alpha1_ContentItem(in *ContentItem, out *v1alpha1.ContentItem, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ContentItemSpec_To_v1alpha1_ContentItemSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ContentItemStatus_To_v1alpha1_ContentItemStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ContentItem_To_v1alpha1_ContentItem is an autogenerated conversion function.
+func Convert_v1beta1_ContentItem_To_v1alpha1_ContentItem(in *ContentItem, out *v1alpha1.ContentItem, s conversion.Scope) error {
+	return autoConvert_v1beta1_ContentItem_To_v1alpha1_ContentItem(in, out, s)
+}
+
+func autoConvert_v1alpha1_ContentItem_To_v1beta1_ContentItem(in *v1alpha1.ContentItem, out *ContentItem, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ContentItemSpec_To_v1beta1_ContentItemSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_ContentItemStatus_To_v1beta1_ContentItemStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ContentItem_To_v1beta1_ContentItem is an autogenerated conversion function.
+func Convert_v1alpha1_ContentItem_To_v1beta1_ContentItem(in *v1alpha1.ContentItem, out *ContentItem, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ContentItem_To_v1beta1_ContentItem(in, out, s)
+}
+
+func autoConvert_v1beta1_ContentItemList_To_v1alpha1_ContentItemList(in *ContentItemList, out *v1alpha1.ContentItemList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.ContentItem)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_ContentItemList_To_v1alpha1_ContentItemList is an autogenerated conversion function.
+func Convert_v1beta1_ContentItemList_To_v1alpha1_ContentItemList(in *ContentItemList, out *v1alpha1.ContentItemList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ContentItemList_To_v1alpha1_ContentItemList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ContentItemList_To_v1beta1_ContentItemList(in *v1alpha1.ContentItemList, out *ContentItemList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ContentItem)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_ContentItemList_To_v1beta1_ContentItemList is an autogenerated conversion function.
+func Convert_v1alpha1_ContentItemList_To_v1beta1_ContentItemList(in *v1alpha1.ContentItemList, out *ContentItemList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ContentItemList_To_v1beta1_ContentItemList(in, out, s)
+}
+
+func autoConvert_v1beta1_ContentItemParentRef_To_v1alpha1_ContentItemParentRef(in *ContentItemParentRef, out *v1alpha1.ContentItemParentRef, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1beta1_ContentItemParentRef_To_v1alpha1_ContentItemParentRef is an autogenerated conversion function.
+func Convert_v1beta1_ContentItemParentRef_To_v1alpha1_ContentItemParentRef(in *ContentItemParentRef, out *v1alpha1.ContentItemParentRef, s conversion.Scope) error {
+	return autoConvert_v1beta1_ContentItemParentRef_To_v1alpha1_ContentItemParentRef(in, out, s)
+}
+
+func autoConvert_v1alpha1_ContentItemParentRef_To_v1beta1_ContentItemParentRef(in *v1alpha1.ContentItemParentRef, out *ContentItemParentRef, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_ContentItemParentRef_To_v1beta1_ContentItemParentRef is an autogenerated conversion function.
+func Convert_v1alpha1_ContentItemParentRef_To_v1beta1_ContentItemParentRef(in *v1alpha1.ContentItemParentRef, out *ContentItemParentRef, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ContentItemParentRef_To_v1beta1_ContentItemParentRef(in, out, s)
+}
+
+func autoConvert_v1beta1_ContentItemSpec_To_v1alpha1_ContentItemSpec(in *ContentItemSpec, out *v1alpha1.ContentItemSpec, s conversion.Scope) error {
+	out.Title = in.Title
+	out.Order = in.Order
+	if err := Convert_v1beta1_ContentItemParentRef_To_v1alpha1_ContentItemParentRef(&in.ParentRef, &out.ParentRef, s); err != nil {
+		return err
+	}
+	out.Link = (*v1alpha1.LinkDetails)(unsafe.Pointer(in.Link))
+	out.Content = in.Content
+	return nil
+}
+
+// Convert_v1beta1_ContentItemSpec_To_v1alpha1_ContentItemSpec is an autogenerated conversion function.
+func Convert_v1beta1_ContentItemSpec_To_v1alpha1_ContentItemSpec(in *ContentItemSpec, out *v1alpha1.ContentItemSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ContentItemSpec_To_v1alpha1_ContentItemSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_ContentItemSpec_To_v1beta1_ContentItemSpec(in *v1alpha1.ContentItemSpec, out *ContentItemSpec, s conversion.Scope) error {
+	out.Title = in.Title
+	out.Order = in.Order
+	if err := Convert_v1alpha1_ContentItemParentRef_To_v1beta1_ContentItemParentRef(&in.ParentRef, &out.ParentRef, s); err != nil {
+		return err
+	}
+	out.Link = (*LinkDetails)(unsafe.Pointer(in.Link))
+	out.Content = in.Content
+	return nil
+}
+
+// Convert_v1alpha1_ContentItemSpec_To_v1beta1_ContentItemSpec is an autogenerated conversion function.
+func Convert_v1alpha1_ContentItemSpec_To_v1beta1_ContentItemSpec(in *v1alpha1.ContentItemSpec, out *ContentItemSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ContentItemSpec_To_v1beta1_ContentItemSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ContentItemStatus_To_v1alpha1_ContentItemStatus(in *ContentItemStatus, out *v1alpha1.ContentItemStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.SyncedAt = (*v1.Time)(unsafe.Pointer(in.SyncedAt))
+	out.Hash = in.Hash
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1beta1_ContentItemStatus_To_v1alpha1_ContentItemStatus is an autogenerated conversion function.
+func Convert_v1beta1_ContentItemStatus_To_v1alpha1_ContentItemStatus(in *ContentItemStatus, out *v1alpha1.ContentItemStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ContentItemStatus_To_v1alpha1_ContentItemStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ContentItemStatus_To_v1beta1_ContentItemStatus(in *v1alpha1.ContentItemStatus, out *ContentItemStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.SyncedAt = (*v1.Time)(unsafe.Pointer(in.SyncedAt))
+	out.Hash = in.Hash
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1alpha1_ContentItemStatus_To_v1beta1_ContentItemStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ContentItemStatus_To_v1beta1_ContentItemStatus(in *v1alpha1.ContentItemStatus, out *ContentItemStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ContentItemStatus_To_v1beta1_ContentItemStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_Cors_To_v1alpha1_Cors(in *Cors, out *v1alpha1.Cors, s conversion.Scope) error {
+	out.AllowOriginsList = *(*[]string)(unsafe.Pointer(&in.AllowOriginsList))
+	out.AllowOriginListRegex = *(*[]string)(unsafe.Pointer(&in.AllowOriginListRegex))
+	out.AllowMethodsList = *(*[]string)(unsafe.Pointer(&in.AllowMethodsList))
+	out.AllowHeadersList = *(*[]string)(unsafe.Pointer(&in.AllowHeadersList))
+	out.ExposeHeadersList = *(*[]string)(unsafe.Pointer(&in.ExposeHeadersList))
+	out.MaxAge = in.MaxAge
+	out.AddVaryHeader = in.AddVaryHeader
+	out.AllowCredentials = in.AllowCredentials
+	return nil
+}
+
+// Convert_v1beta1_Cors_To_v1alpha1_Cors is an autogenerated conversion function.
+func Convert_v1beta1_Cors_To_v1alpha1_Cors(in *Cors, out *v1alpha1.Cors, s conversion.Scope) error {
+	return autoConvert_v1beta1_Cors_To_v1alpha1_Cors(in, out, s)
+}
+
+func autoConvert_v1alpha1_Cors_To_v1beta1_Cors(in *v1alpha1.Cors, out *Cors, s conversion.Scope) error {
+	out.AllowOriginsList = *(*[]string)(unsafe.Pointer(&in.AllowOriginsList))
+	out.AllowOriginListRegex = *(*[]string)(unsafe.Pointer(&in.AllowOriginListRegex))
+	out.AllowMethodsList = *(*[]string)(unsafe.Pointer(&in.AllowMethodsList))
+	out.AllowHeadersList = *(*[]string)(unsafe.Pointer(&in.AllowHeadersList))
+	out.ExposeHeadersList = *(*[]string)(unsafe.Pointer(&in.ExposeHeadersList))
+	out.MaxAge = in.MaxAge
+	out.AddVaryHeader = in.AddVaryHeader
+	out.AllowCredentials = in.AllowCredentials
+	return nil
+}
+
+// Convert_v1alpha1_Cors_To_v1beta1_Cors is an autogenerated conversion function.
+func Convert_v1alpha1_Cors_To_v1beta1_Cors(in *v1alpha1.Cors, out *Cors, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Cors_To_v1beta1_Cors(in, out, s)
+}
+
+func autoConvert_v1beta1_HTTPClientConfig_To_v1alpha1_HTTPClientConfig(in *HTTPClientConfig, out *v1alpha1.HTTPClientConfig, s conversion.Scope) error {
+	out.TLS = (*v1alpha1.HTTPClientConfigTLS)(unsafe.Pointer(in.TLS))
+	out.TimeoutSeconds = in.TimeoutSeconds
+	out.MaxRetries = in.MaxRetries
+	return nil
+}
+
+// Convert_v1beta1_HTTPClientConfig_To_v1alpha1_HTTPClientConfig is an autogenerated conversion function.
+func Convert_v1beta1_HTTPClientConfig_To_v1alpha1_HTTPClientConfig(in *HTTPClientConfig, out *v1alpha1.HTTPClientConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_HTTPClientConfig_To_v1alpha1_HTTPClientConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_HTTPClientConfig_To_v1beta1_HTTPClientConfig(in *v1alpha1.HTTPClientConfig, out *HTTPClientConfig, s conversion.Scope) error {
+	out.TLS = (*HTTPClientConfigTLS)(unsafe.Pointer(in.TLS))
+	out.TimeoutSeconds = in.TimeoutSeconds
+	out.MaxRetries = in.MaxRetries
+	return nil
+}
+
+// Convert_v1alpha1_HTTPClientConfig_To_v1beta1_HTTPClientConfig is an autogenerated conversion function.
+func Convert_v1alpha1_HTTPClientConfig_To_v1beta1_HTTPClientConfig(in *v1alpha1.HTTPClientConfig, out *HTTPClientConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HTTPClientConfig_To_v1beta1_HTTPClientConfig(in, out, s)
+}
+
+func autoConvert_v1beta1_HTTPClientConfigTLS_To_v1alpha1_HTTPClientConfigTLS(in *HTTPClientConfigTLS, out *v1alpha1.HTTPClientConfigTLS, s conversion.Scope) error {
+	out.CA = in.CA
+	out.InsecureSkipVerify = in.InsecureSkipVerify
+	return nil
+}
+
+// Convert_v1beta1_HTTPClientConfigTLS_To_v1alpha1_HTTPClientConfigTLS is an autogenerated conversion function.
+func Convert_v1beta1_HTTPClientConfigTLS_To_v1alpha1_HTTPClientConfigTLS(in *HTTPClientConfigTLS, out *v1alpha1.HTTPClientConfigTLS, s conversion.Scope) error {
+	return autoConvert_v1beta1_HTTPClientConfigTLS_To_v1alpha1_HTTPClientConfigTLS(in, out, s)
+}
+
+func autoConvert_v1alpha1_HTTPClientConfigTLS_To_v1beta1_HTTPClientConfigTLS(in *v1alpha1.HTTPClientConfigTLS, out *HTTPClientConfigTLS, s conversion.Scope) error {
+	out.CA = in.CA
+	out.InsecureSkipVerify = in.InsecureSkipVerify
+	return nil
+}
+
+// Convert_v1alpha1_HTTPClientConfigTLS_To_v1beta1_HTTPClientConfigTLS is an autogenerated conversion function.
+func Convert_v1alpha1_HTTPClientConfigTLS_To_v1beta1_HTTPClientConfigTLS(in *v1alpha1.HTTPClientConfigTLS, out *HTTPClientConfigTLS, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HTTPClientConfigTLS_To_v1beta1_HTTPClientConfigTLS(in, out, s)
+}
+
+func autoConvert_v1beta1_JWTAuthSpec_To_v1alpha1_JWTAuthSpec(in *JWTAuthSpec, out *v1alpha1.JWTAuthSpec, s conversion.Scope) error {
+	out.StripAuthorizationHeader = in.StripAuthorizationHeader
+	out.TokenQueryKey = in.TokenQueryKey
+	out.AppIDClaim = in.AppIDClaim
+	out.TokenNameClaim = in.TokenNameClaim
+	out.ForwardHeaders = *(*map[string]string)(unsafe.Pointer(&in.ForwardHeaders))
+	out.SigningSecretName = in.SigningSecretName
+	out.PublicKey = in.PublicKey
+	out.JWKSFile = in.JWKSFile
+	out.TrustedIssuers = *(*[]v1alpha1.TrustedIssuer)(unsafe.Pointer(&in.TrustedIssuers))
+	out.ClientConfig = (*v1alpha1.HTTPClientConfig)(unsafe.Pointer(in.ClientConfig))
+	return nil
+}
+
+// Convert_v1beta1_JWTAuthSpec_To_v1alpha1_JWTAuthSpec is an autogenerated conversion function.
+func Convert_v1beta1_JWTAuthSpec_To_v1alpha1_JWTAuthSpec(in *JWTAuthSpec, out *v1alpha1.JWTAuthSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_JWTAuthSpec_To_v1alpha1_JWTAuthSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_JWTAuthSpec_To_v1beta1_JWTAuthSpec(in *v1alpha1.JWTAuthSpec, out *JWTAuthSpec, s conversion.Scope) error {
+	out.StripAuthorizationHeader = in.StripAuthorizationHeader
+	out.TokenQueryKey = in.TokenQueryKey
+	out.AppIDClaim = in.AppIDClaim
+	out.TokenNameClaim = in.TokenNameClaim
+	out.ForwardHeaders = *(*map[string]string)(unsafe.Pointer(&in.ForwardHeaders))
+	out.SigningSecretName = in.SigningSecretName
+	out.PublicKey = in.PublicKey
+	out.JWKSFile = in.JWKSFile
+	// WARNING: in.JWKSURL requires manual conversion: does not exist in peer-type
+	out.TrustedIssuers = *(*[]TrustedIssuer)(unsafe.Pointer(&in.TrustedIssuers))
+	out.ClientConfig = (*HTTPClientConfig)(unsafe.Pointer(in.ClientConfig))
+	return nil
+}
+
+func autoConvert_v1beta1_LDAPConnectionConfig_To_v1alpha1_LDAPConnectionConfig(in *LDAPConnectionConfig, out *v1alpha1.LDAPConnectionConfig, s conversion.Scope) error {
+	out.URL = in.URL
+	out.StartTLS = in.StartTLS
+	out.InsecureSkipVerify = in.InsecureSkipVerify
+	out.CertificateAuthority = in.CertificateAuthority
+	out.BindDN = in.BindDN
+	out.BindPasswordSecretName = in.BindPasswordSecretName
+	out.BaseDN = in.BaseDN
+	out.Attribute = in.Attribute
+	out.SearchFilter = in.SearchFilter
+	return nil
+}
+
+// Convert_v1beta1_LDAPConnectionConfig_To_v1alpha1_LDAPConnectionConfig is an autogenerated conversion function.
+func Convert_v1beta1_LDAPConnectionConfig_To_v1alpha1_LDAPConnectionConfig(in *LDAPConnectionConfig, out *v1alpha1.LDAPConnectionConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_LDAPConnectionConfig_To_v1alpha1_LDAPConnectionConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_LDAPConnectionConfig_To_v1beta1_LDAPConnectionConfig(in *v1alpha1.LDAPConnectionConfig, out *LDAPConnectionConfig, s conversion.Scope) error {
+	out.URL = in.URL
+	out.StartTLS = in.StartTLS
+	out.InsecureSkipVerify = in.InsecureSkipVerify
+	out.CertificateAuthority = in.CertificateAuthority
+	out.BindDN = in.BindDN
+	out.BindPasswordSecretName = in.BindPasswordSecretName
+	out.BaseDN = in.BaseDN
+	out.Attribute = in.Attribute
+	out.SearchFilter = in.SearchFilter
+	return nil
+}
+
+// Convert_v1alpha1_LDAPConnectionConfig_To_v1beta1_LDAPConnectionConfig is an autogenerated conversion function.
+func Convert_v1alpha1_LDAPConnectionConfig_To_v1beta1_LDAPConnectionConfig(in *v1alpha1.LDAPConnectionConfig, out *LDAPConnectionConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LDAPConnectionConfig_To_v1beta1_LDAPConnectionConfig(in, out, s)
+}
+
+func autoConvert_v1beta1_LDAPGroups_To_v1alpha1_LDAPGroups(in *LDAPGroups, out *v1alpha1.LDAPGroups, s conversion.Scope) error {
+	out.MemberOfAttribute = in.MemberOfAttribute
+	return nil
+}
+
+// Convert_v1beta1_LDAPGroups_To_v1alpha1_LDAPGroups is an autogenerated conversion function.
+func Convert_v1beta1_LDAPGroups_To_v1alpha1_LDAPGroups(in *LDAPGroups, out *v1alpha1.LDAPGroups, s conversion.Scope) error {
+	return autoConvert_v1beta1_LDAPGroups_To_v1alpha1_LDAPGroups(in, out, s)
+}
+
+func autoConvert_v1alpha1_LDAPGroups_To_v1beta1_LDAPGroups(in *v1alpha1.LDAPGroups, out *LDAPGroups, s conversion.Scope) error {
+	out.MemberOfAttribute = in.MemberOfAttribute
+	return nil
+}
+
+// Convert_v1alpha1_LDAPGroups_To_v1beta1_LDAPGroups is an autogenerated conversion function.
+func Convert_v1alpha1_LDAPGroups_To_v1beta1_LDAPGroups(in *v1alpha1.LDAPGroups, out *LDAPGroups, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LDAPGroups_To_v1beta1_LDAPGroups(in, out, s)
+}
+
+func autoConvert_v1beta1_LinkDetails_To_v1alpha1_LinkDetails(in *LinkDetails, out *v1alpha1.LinkDetails, s conversion.Scope) error {
+	out.Href = in.Href
+	return nil
+}
+
+// Convert_v1beta1_LinkDetails_To_v1alpha1_LinkDetails is an autogenerated conversion function.
+func Convert_v1beta1_LinkDetails_To_v1alpha1_LinkDetails(in *LinkDetails, out *v1alpha1.LinkDetails, s conversion.Scope) error {
+	return autoConvert_v1beta1_LinkDetails_To_v1alpha1_LinkDetails(in, out, s)
+}
+
+func autoConvert_v1alpha1_LinkDetails_To_v1beta1_LinkDetails(in *v1alpha1.LinkDetails, out *LinkDetails, s conversion.Scope) error {
+	out.Href = in.Href
+	return nil
+}
+
+// Convert_v1alpha1_LinkDetails_To_v1beta1_LinkDetails is an autogenerated conversion function.
+func Convert_v1alpha1_LinkDetails_To_v1beta1_LinkDetails(in *v1alpha1.LinkDetails, out *LinkDetails, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LinkDetails_To_v1beta1_LinkDetails(in, out, s)
+}
+
+func autoConvert_v1beta1_ManagedApplication_To_v1alpha1_ManagedApplication(in *ManagedApplication, out *v1alpha1.ManagedApplication, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ManagedApplicationSpec_To_v1alpha1_ManagedApplicationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ManagedApplicationStatus_To_v1alpha1_ManagedApplicationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ManagedApplication_To_v1alpha1_ManagedApplication is an autogenerated conversion function.
+func Convert_v1beta1_ManagedApplication_To_v1alpha1_ManagedApplication(in *ManagedApplication, out *v1alpha1.ManagedApplication, s conversion.Scope) error {
+	return autoConvert_v1beta1_ManagedApplication_To_v1alpha1_ManagedApplication(in, out, s)
+}
+
+func autoConvert_v1alpha1_ManagedApplication_To_v1beta1_ManagedApplication(in *v1alpha1.ManagedApplication, out *ManagedApplication, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ManagedApplicationSpec_To_v1beta1_ManagedApplicationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_ManagedApplicationStatus_To_v1beta1_ManagedApplicationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ManagedApplication_To_v1beta1_ManagedApplication is an autogenerated conversion function.
+func Convert_v1alpha1_ManagedApplication_To_v1beta1_ManagedApplication(in *v1alpha1.ManagedApplication, out *ManagedApplication, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ManagedApplication_To_v1beta1_ManagedApplication(in, out, s)
+}
+
+func autoConvert_v1beta1_ManagedApplicationList_To_v1alpha1_ManagedApplicationList(in *ManagedApplicationList, out *v1alpha1.ManagedApplicationList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha1.ManagedApplication, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_ManagedApplication_To_v1alpha1_ManagedApplication(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_ManagedApplicationList_To_v1alpha1_ManagedApplicationList is an autogenerated conversion function.
+func Convert_v1beta1_ManagedApplicationList_To_v1alpha1_ManagedApplicationList(in *ManagedApplicationList, out *v1alpha1.ManagedApplicationList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ManagedApplicationList_To_v1alpha1_ManagedApplicationList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ManagedApplicationList_To_v1beta1_ManagedApplicationList(in *v1alpha1.ManagedApplicationList, out *ManagedApplicationList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ManagedApplication, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_ManagedApplication_To_v1beta1_ManagedApplication(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ManagedApplicationList_To_v1beta1_ManagedApplicationList is an autogenerated conversion function.
+func Convert_v1alpha1_ManagedApplicationList_To_v1beta1_ManagedApplicationList(in *v1alpha1.ManagedApplicationList, out *ManagedApplicationList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ManagedApplicationList_To_v1beta1_ManagedApplicationList(in, out, s)
+}
+
+func autoConvert_v1beta1_ManagedApplicationReference_To_v1alpha1_ManagedApplicationReference(in *ManagedApplicationReference, out *v1alpha1.ManagedApplicationReference, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1beta1_ManagedApplicationReference_To_v1alpha1_ManagedApplicationReference is an autogenerated conversion function.
+func Convert_v1beta1_ManagedApplicationReference_To_v1alpha1_ManagedApplicationReference(in *ManagedApplicationReference, out *v1alpha1.ManagedApplicationReference, s conversion.Scope) error {
+	return autoConvert_v1beta1_ManagedApplicationReference_To_v1alpha1_ManagedApplicationReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_ManagedApplicationReference_To_v1beta1_ManagedApplicationReference(in *v1alpha1.ManagedApplicationReference, out *ManagedApplicationReference, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_ManagedApplicationReference_To_v1beta1_ManagedApplicationReference is an autogenerated conversion function.
+func Convert_v1alpha1_ManagedApplicationReference_To_v1beta1_ManagedApplicationReference(in *v1alpha1.ManagedApplicationReference, out *ManagedApplicationReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ManagedApplicationReference_To_v1beta1_ManagedApplicationReference(in, out, s)
+}
+
+func autoConvert_v1beta1_ManagedApplicationSpec_To_v1alpha1_ManagedApplicationSpec(in *ManagedApplicationSpec, out *v1alpha1.ManagedApplicationSpec, s conversion.Scope) error {
+	out.AppID = in.AppID
+	out.Owner = in.Owner
+	out.Notes = in.Notes
+	out.APIKeys = *(*[]v1alpha1.APIKey)(unsafe.Pointer(&in.APIKeys))
+	return nil
+}
+
+// Convert_v1beta1_ManagedApplicationSpec_To_v1alpha1_ManagedApplicationSpec is an autogenerated conversion function.
+func Convert_v1beta1_ManagedApplicationSpec_To_v1alpha1_ManagedApplicationSpec(in *ManagedApplicationSpec, out *v1alpha1.ManagedApplicationSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ManagedApplicationSpec_To_v1alpha1_ManagedApplicationSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_ManagedApplicationSpec_To_v1beta1_ManagedApplicationSpec(in *v1alpha1.ManagedApplicationSpec, out *ManagedApplicationSpec, s conversion.Scope) error {
+	out.AppID = in.AppID
+	out.Owner = in.Owner
+	out.Notes = in.Notes
+	out.APIKeys = *(*[]APIKey)(unsafe.Pointer(&in.APIKeys))
+	return nil
+}
+
+// Convert_v1alpha1_ManagedApplicationSpec_To_v1beta1_ManagedApplicationSpec is an autogenerated conversion function.
+func Convert_v1alpha1_ManagedApplicationSpec_To_v1beta1_ManagedApplicationSpec(in *v1alpha1.ManagedApplicationSpec, out *ManagedApplicationSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ManagedApplicationSpec_To_v1beta1_ManagedApplicationSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ManagedApplicationStatus_To_v1alpha1_ManagedApplicationStatus(in *ManagedApplicationStatus, out *v1alpha1.ManagedApplicationStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	// WARNING: in.APIKeyVersions requires manual conversion: inconvertible types ([]github.com/traefik/hub-crds/pkg/apis/hub/v1beta1.APIKeyVersion vs map[string]string)
+	out.SyncedAt = (*v1.Time)(unsafe.Pointer(in.SyncedAt))
+	out.Hash = in.Hash
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+func autoConvert_v1alpha1_ManagedApplicationStatus_To_v1beta1_ManagedApplicationStatus(in *v1alpha1.ManagedApplicationStatus, out *ManagedApplicationStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	// WARNING: in.APIKeyVersions requires manual conversion: inconvertible types (map[string]string vs []github.com/traefik/hub-crds/pkg/apis/hub/v1beta1.APIKeyVersion)
+	out.SyncedAt = (*v1.Time)(unsafe.Pointer(in.SyncedAt))
+	out.Hash = in.Hash
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+func autoConvert_v1beta1_ManagedSubscription_To_v1alpha1_ManagedSubscription(in *ManagedSubscription, out *v1alpha1.ManagedSubscription, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ManagedSubscriptionSpec_To_v1alpha1_ManagedSubscriptionSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ManagedSubscriptionStatus_To_v1alpha1_ManagedSubscriptionStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ManagedSubscription_To_v1alpha1_ManagedSubscription is an autogenerated conversion function.
+func Convert_v1beta1_ManagedSubscription_To_v1alpha1_ManagedSubscription(in *ManagedSubscription, out *v1alpha1.ManagedSubscription, s conversion.Scope) error {
+	return autoConvert_v1beta1_ManagedSubscription_To_v1alpha1_ManagedSubscription(in, out, s)
+}
+
+func autoConvert_v1alpha1_ManagedSubscription_To_v1beta1_ManagedSubscription(in *v1alpha1.ManagedSubscription, out *ManagedSubscription, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ManagedSubscriptionSpec_To_v1beta1_ManagedSubscriptionSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_ManagedSubscriptionStatus_To_v1beta1_ManagedSubscriptionStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ManagedSubscription_To_v1beta1_ManagedSubscription is an autogenerated conversion function.
+func Convert_v1alpha1_ManagedSubscription_To_v1beta1_ManagedSubscription(in *v1alpha1.ManagedSubscription, out *ManagedSubscription, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ManagedSubscription_To_v1beta1_ManagedSubscription(in, out, s)
+}
+
+func autoConvert_v1beta1_ManagedSubscriptionList_To_v1alpha1_ManagedSubscriptionList(in *ManagedSubscriptionList, out *v1alpha1.ManagedSubscriptionList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha1.ManagedSubscription, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_ManagedSubscription_To_v1alpha1_ManagedSubscription(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_ManagedSubscriptionList_To_v1alpha1_ManagedSubscriptionList is an autogenerated conversion function.
+func Convert_v1beta1_ManagedSubscriptionList_To_v1alpha1_ManagedSubscriptionList(in *ManagedSubscriptionList, out *v1alpha1.ManagedSubscriptionList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ManagedSubscriptionList_To_v1alpha1_ManagedSubscriptionList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ManagedSubscriptionList_To_v1beta1_ManagedSubscriptionList(in *v1alpha1.ManagedSubscriptionList, out *ManagedSubscriptionList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ManagedSubscription, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_ManagedSubscription_To_v1beta1_ManagedSubscription(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ManagedSubscriptionList_To_v1beta1_ManagedSubscriptionList is an autogenerated conversion function.
+func Convert_v1alpha1_ManagedSubscriptionList_To_v1beta1_ManagedSubscriptionList(in *v1alpha1.ManagedSubscriptionList, out *ManagedSubscriptionList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ManagedSubscriptionList_To_v1beta1_ManagedSubscriptionList(in, out, s)
+}
+
+func autoConvert_v1beta1_ManagedSubscriptionSpec_To_v1alpha1_ManagedSubscriptionSpec(in *ManagedSubscriptionSpec, out *v1alpha1.ManagedSubscriptionSpec, s conversion.Scope) error {
+	out.ManagedApplications = *(*[]v1alpha1.ManagedApplicationReference)(unsafe.Pointer(&in.ManagedApplications))
+	out.ManagedApplicationSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ManagedApplicationSelector))
+	out.APIBundles = *(*[]v1alpha1.APIBundleReference)(unsafe.Pointer(&in.APIBundles))
+	out.APISelector = (*v1.LabelSelector)(unsafe.Pointer(in.APISelector))
+	out.APIs = *(*[]v1alpha1.APIReference)(unsafe.Pointer(&in.APIs))
+	out.OperationFilter = (*v1alpha1.OperationFilter)(unsafe.Pointer(in.OperationFilter))
+	if err := Convert_v1beta1_APIPlanReference_To_v1alpha1_APIPlanReference(&in.APIPlan, &out.APIPlan, s); err != nil {
+		return err
+	}
+	out.Weight = in.Weight
+	out.Claims = in.Claims
+	return nil
+}
+
+// Convert_v1beta1_ManagedSubscriptionSpec_To_v1alpha1_ManagedSubscriptionSpec is an autogenerated conversion function.
+func Convert_v1beta1_ManagedSubscriptionSpec_To_v1alpha1_ManagedSubscriptionSpec(in *ManagedSubscriptionSpec, out *v1alpha1.ManagedSubscriptionSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ManagedSubscriptionSpec_To_v1alpha1_ManagedSubscriptionSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_ManagedSubscriptionSpec_To_v1beta1_ManagedSubscriptionSpec(in *v1alpha1.ManagedSubscriptionSpec, out *ManagedSubscriptionSpec, s conversion.Scope) error {
+	// WARNING: in.Applications requires manual conversion: does not exist in peer-type
+	out.ManagedApplications = *(*[]ManagedApplicationReference)(unsafe.Pointer(&in.ManagedApplications))
+	out.ManagedApplicationSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ManagedApplicationSelector))
+	out.APIBundles = *(*[]APIBundleReference)(unsafe.Pointer(&in.APIBundles))
+	out.APISelector = (*v1.LabelSelector)(unsafe.Pointer(in.APISelector))
+	out.APIs = *(*[]APIReference)(unsafe.Pointer(&in.APIs))
+	out.OperationFilter = (*OperationFilter)(unsafe.Pointer(in.OperationFilter))
+	if err := Convert_v1alpha1_APIPlanReference_To_v1beta1_APIPlanReference(&in.APIPlan, &out.APIPlan, s); err != nil {
+		return err
+	}
+	out.Weight = in.Weight
+	out.Claims = in.Claims
+	return nil
+}
+
+func autoConvert_v1beta1_ManagedSubscriptionStatus_To_v1alpha1_ManagedSubscriptionStatus(in *ManagedSubscriptionStatus, out *v1alpha1.ManagedSubscriptionStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.SyncedAt = (*v1.Time)(unsafe.Pointer(in.SyncedAt))
+	out.Hash = in.Hash
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.ResolvedAPIs = *(*[]v1alpha1.ResolvedAPIReference)(unsafe.Pointer(&in.ResolvedAPIs))
+	out.UnresolvedAPIs = *(*[]v1alpha1.ResolvedAPIReference)(unsafe.Pointer(&in.UnresolvedAPIs))
+	out.ResolvedManagedApplications = *(*[]v1alpha1.ResolvedManagedApplicationReference)(unsafe.Pointer(&in.ResolvedManagedApplications))
+	out.UnresolvedManagedApplications = *(*[]v1alpha1.ResolvedManagedApplicationReference)(unsafe.Pointer(&in.UnresolvedManagedApplications))
+	return nil
+}
+
+// Convert_v1beta1_ManagedSubscriptionStatus_To_v1alpha1_ManagedSubscriptionStatus is an autogenerated conversion function.
+func Convert_v1beta1_ManagedSubscriptionStatus_To_v1alpha1_ManagedSubscriptionStatus(in *ManagedSubscriptionStatus, out *v1alpha1.ManagedSubscriptionStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ManagedSubscriptionStatus_To_v1alpha1_ManagedSubscriptionStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ManagedSubscriptionStatus_To_v1beta1_ManagedSubscriptionStatus(in *v1alpha1.ManagedSubscriptionStatus, out *ManagedSubscriptionStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.SyncedAt = (*v1.Time)(unsafe.Pointer(in.SyncedAt))
+	out.Hash = in.Hash
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.ResolvedAPIs = *(*[]ResolvedAPIReference)(unsafe.Pointer(&in.ResolvedAPIs))
+	out.UnresolvedAPIs = *(*[]ResolvedAPIReference)(unsafe.Pointer(&in.UnresolvedAPIs))
+	out.ResolvedManagedApplications = *(*[]ResolvedManagedApplicationReference)(unsafe.Pointer(&in.ResolvedManagedApplications))
+	out.UnresolvedManagedApplications = *(*[]ResolvedManagedApplicationReference)(unsafe.Pointer(&in.UnresolvedManagedApplications))
+	return nil
+}
+
+// Convert_v1alpha1_ManagedSubscriptionStatus_To_v1beta1_ManagedSubscriptionStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ManagedSubscriptionStatus_To_v1beta1_ManagedSubscriptionStatus(in *v1alpha1.ManagedSubscriptionStatus, out *ManagedSubscriptionStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ManagedSubscriptionStatus_To_v1beta1_ManagedSubscriptionStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_OIDCConfig_To_v1alpha1_OIDCConfig(in *OIDCConfig, out *v1alpha1.OIDCConfig, s conversion.Scope) error {
+	out.IssuerURL = in.IssuerURL
+	out.SecretName = in.SecretName
+	out.Scopes = (*[]string)(unsafe.Pointer(in.Scopes))
+	if err := Convert_v1beta1_Claims_To_v1alpha1_Claims(&in.Claims, &out.Claims, s); err != nil {
+		return err
+	}
+	out.SyncedAttributes = *(*[]string)(unsafe.Pointer(&in.SyncedAttributes))
+	out.ClientConfig = (*v1alpha1.HTTPClientConfig)(unsafe.Pointer(in.ClientConfig))
+	return nil
+}
+
+// Convert_v1beta1_OIDCConfig_To_v1alpha1_OIDCConfig is an autogenerated conversion function.
+func Convert_v1beta1_OIDCConfig_To_v1alpha1_OIDCConfig(in *OIDCConfig, out *v1alpha1.OIDCConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_OIDCConfig_To_v1alpha1_OIDCConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_OIDCConfig_To_v1beta1_OIDCConfig(in *v1alpha1.OIDCConfig, out *OIDCConfig, s conversion.Scope) error {
+	out.IssuerURL = in.IssuerURL
+	out.SecretName = in.SecretName
+	out.Scopes = (*[]string)(unsafe.Pointer(in.Scopes))
+	if err := Convert_v1alpha1_Claims_To_v1beta1_Claims(&in.Claims, &out.Claims, s); err != nil {
+		return err
+	}
+	out.SyncedAttributes = *(*[]string)(unsafe.Pointer(&in.SyncedAttributes))
+	out.ClientConfig = (*HTTPClientConfig)(unsafe.Pointer(in.ClientConfig))
+	return nil
+}
+
+// Convert_v1alpha1_OIDCConfig_To_v1beta1_OIDCConfig is an autogenerated conversion function.
+func Convert_v1alpha1_OIDCConfig_To_v1beta1_OIDCConfig(in *v1alpha1.OIDCConfig, out *OIDCConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OIDCConfig_To_v1beta1_OIDCConfig(in, out, s)
+}
+
+func autoConvert_v1beta1_OIDCConfigStatus_To_v1alpha1_OIDCConfigStatus(in *OIDCConfigStatus, out *v1alpha1.OIDCConfigStatus, s conversion.Scope) error {
+	out.Generic = in.Generic
+	out.Issuer = in.Issuer
+	out.ClientID = in.ClientID
+	out.SecretName = in.SecretName
+	out.Scopes = in.Scopes
+	out.UserIDClaim = in.UserIDClaim
+	out.FirstnameClaim = in.FirstnameClaim
+	out.LastnameClaim = in.LastnameClaim
+	out.EmailClaim = in.EmailClaim
+	out.GroupsClaim = in.GroupsClaim
+	out.CompanyClaim = in.CompanyClaim
+	out.SyncedAttributes = *(*[]string)(unsafe.Pointer(&in.SyncedAttributes))
+	return nil
+}
+
+// Convert_v1beta1_OIDCConfigStatus_To_v1alpha1_OIDCConfigStatus is an autogenerated conversion function.
+func Convert_v1beta1_OIDCConfigStatus_To_v1alpha1_OIDCConfigStatus(in *OIDCConfigStatus, out *v1alpha1.OIDCConfigStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_OIDCConfigStatus_To_v1alpha1_OIDCConfigStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_OIDCConfigStatus_To_v1beta1_OIDCConfigStatus(in *v1alpha1.OIDCConfigStatus, out *OIDCConfigStatus, s conversion.Scope) error {
+	out.Generic = in.Generic
+	out.Issuer = in.Issuer
+	out.ClientID = in.ClientID
+	out.SecretName = in.SecretName
+	out.Scopes = in.Scopes
+	out.UserIDClaim = in.UserIDClaim
+	out.FirstnameClaim = in.FirstnameClaim
+	out.LastnameClaim = in.LastnameClaim
+	out.EmailClaim = in.EmailClaim
+	out.GroupsClaim = in.GroupsClaim
+	out.CompanyClaim = in.CompanyClaim
+	out.SyncedAttributes = *(*[]string)(unsafe.Pointer(&in.SyncedAttributes))
+	return nil
+}
+
+// Convert_v1alpha1_OIDCConfigStatus_To_v1beta1_OIDCConfigStatus is an autogenerated conversion function.
+func Convert_v1alpha1_OIDCConfigStatus_To_v1beta1_OIDCConfigStatus(in *v1alpha1.OIDCConfigStatus, out *OIDCConfigStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OIDCConfigStatus_To_v1beta1_OIDCConfigStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_OpenAPISpec_To_v1alpha1_OpenAPISpec(in *OpenAPISpec, out *v1alpha1.OpenAPISpec, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Override = (*v1alpha1.Override)(unsafe.Pointer(in.Override))
+	out.Path = in.Path
+	out.OperationSets = *(*[]v1alpha1.OperationSet)(unsafe.Pointer(&in.OperationSets))
+	out.ValidateRequestMethodAndPath = (*bool)(unsafe.Pointer(in.ValidateRequestMethodAndPath))
+	out.ValidateRequestBodySchema = (*bool)(unsafe.Pointer(in.ValidateRequestBodySchema))
+	out.RefreshInterval = (*v1alpha1.Period)(unsafe.Pointer(in.RefreshInterval))
+	return nil
+}
+
+// Convert_v1beta1_OpenAPISpec_To_v1alpha1_OpenAPISpec is an autogenerated conversion function.
+func Convert_v1beta1_OpenAPISpec_To_v1alpha1_OpenAPISpec(in *OpenAPISpec, out *v1alpha1.OpenAPISpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_OpenAPISpec_To_v1alpha1_OpenAPISpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_OpenAPISpec_To_v1beta1_OpenAPISpec(in *v1alpha1.OpenAPISpec, out *OpenAPISpec, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Override = (*Override)(unsafe.Pointer(in.Override))
+	out.Path = in.Path
+	out.OperationSets = *(*[]OperationSet)(unsafe.Pointer(&in.OperationSets))
+	out.ValidateRequestMethodAndPath = (*bool)(unsafe.Pointer(in.ValidateRequestMethodAndPath))
+	out.ValidateRequestBodySchema = (*bool)(unsafe.Pointer(in.ValidateRequestBodySchema))
+	out.RefreshInterval = (*Period)(unsafe.Pointer(in.RefreshInterval))
+	return nil
+}
+
+// Convert_v1alpha1_OpenAPISpec_To_v1beta1_OpenAPISpec is an autogenerated conversion function.
+func Convert_v1alpha1_OpenAPISpec_To_v1beta1_OpenAPISpec(in *v1alpha1.OpenAPISpec, out *OpenAPISpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OpenAPISpec_To_v1beta1_OpenAPISpec(in, out, s)
+}
+
+func autoConvert_v1beta1_OperationFilter_To_v1alpha1_OperationFilter(in *OperationFilter, out *v1alpha1.OperationFilter, s conversion.Scope) error {
+	out.Include = *(*[]string)(unsafe.Pointer(&in.Include))
+	return nil
+}
+
+// Convert_v1beta1_OperationFilter_To_v1alpha1_OperationFilter is an autogenerated conversion function.
+func Convert_v1beta1_OperationFilter_To_v1alpha1_OperationFilter(in *OperationFilter, out *v1alpha1.OperationFilter, s conversion.Scope) error {
+	return autoConvert_v1beta1_OperationFilter_To_v1alpha1_OperationFilter(in, out, s)
+}
+
+func autoConvert_v1alpha1_OperationFilter_To_v1beta1_OperationFilter(in *v1alpha1.OperationFilter, out *OperationFilter, s conversion.Scope) error {
+	out.Include = *(*[]string)(unsafe.Pointer(&in.Include))
+	return nil
+}
+
+// Convert_v1alpha1_OperationFilter_To_v1beta1_OperationFilter is an autogenerated conversion function.
+func Convert_v1alpha1_OperationFilter_To_v1beta1_OperationFilter(in *v1alpha1.OperationFilter, out *OperationFilter, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OperationFilter_To_v1beta1_OperationFilter(in, out, s)
+}
+
+func autoConvert_v1beta1_OperationMatcher_To_v1alpha1_OperationMatcher(in *OperationMatcher, out *v1alpha1.OperationMatcher, s conversion.Scope) error {
+	out.Path = in.Path
+	out.PathPrefix = in.PathPrefix
+	out.PathRegex = in.PathRegex
+	out.Methods = (*[]string)(unsafe.Pointer(in.Methods))
+	return nil
+}
+
+// Convert_v1beta1_OperationMatcher_To_v1alpha1_OperationMatcher is an autogenerated conversion function.
+func Convert_v1beta1_OperationMatcher_To_v1alpha1_OperationMatcher(in *OperationMatcher, out *v1alpha1.OperationMatcher, s conversion.Scope) error {
+	return autoConvert_v1beta1_OperationMatcher_To_v1alpha1_OperationMatcher(in, out, s)
+}
+
+func autoConvert_v1alpha1_OperationMatcher_To_v1beta1_OperationMatcher(in *v1alpha1.OperationMatcher, out *OperationMatcher, s conversion.Scope) error {
+	out.Path = in.Path
+	out.PathPrefix = in.PathPrefix
+	out.PathRegex = in.PathRegex
+	out.Methods = (*[]string)(unsafe.Pointer(in.Methods))
+	return nil
+}
+
+// Convert_v1alpha1_OperationMatcher_To_v1beta1_OperationMatcher is an autogenerated conversion function.
+func Convert_v1alpha1_OperationMatcher_To_v1beta1_OperationMatcher(in *v1alpha1.OperationMatcher, out *OperationMatcher, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OperationMatcher_To_v1beta1_OperationMatcher(in, out, s)
+}
+
+func autoConvert_v1beta1_OperationSet_To_v1alpha1_OperationSet(in *OperationSet, out *v1alpha1.OperationSet, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Matchers = *(*[]v1alpha1.OperationMatcher)(unsafe.Pointer(&in.Matchers))
+	return nil
+}
+
+// Convert_v1beta1_OperationSet_To_v1alpha1_OperationSet is an autogenerated conversion function.
+func Convert_v1beta1_OperationSet_To_v1alpha1_OperationSet(in *OperationSet, out *v1alpha1.OperationSet, s conversion.Scope) error {
+	return autoConvert_v1beta1_OperationSet_To_v1alpha1_OperationSet(in, out, s)
+}
+
+func autoConvert_v1alpha1_OperationSet_To_v1beta1_OperationSet(in *v1alpha1.OperationSet, out *OperationSet, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Matchers = *(*[]OperationMatcher)(unsafe.Pointer(&in.Matchers))
+	return nil
+}
+
+// Convert_v1alpha1_OperationSet_To_v1beta1_OperationSet is an autogenerated conversion function.
+func Convert_v1alpha1_OperationSet_To_v1beta1_OperationSet(in *v1alpha1.OperationSet, out *OperationSet, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OperationSet_To_v1beta1_OperationSet(in, out, s)
+}
+
+func autoConvert_v1beta1_Override_To_v1alpha1_Override(in *Override, out *v1alpha1.Override, s conversion.Scope) error {
+	out.Servers = *(*[]v1alpha1.Server)(unsafe.Pointer(&in.Servers))
+	return nil
+}
+
+// Convert_v1beta1_Override_To_v1alpha1_Override is an autogenerated conversion function.
+func Convert_v1beta1_Override_To_v1alpha1_Override(in *Override, out *v1alpha1.Override, s conversion.Scope) error {
+	return autoConvert_v1beta1_Override_To_v1alpha1_Override(in, out, s)
+}
+
+func autoConvert_v1alpha1_Override_To_v1beta1_Override(in *v1alpha1.Override, out *Override, s conversion.Scope) error {
+	out.Servers = *(*[]Server)(unsafe.Pointer(&in.Servers))
+	return nil
+}
+
+// Convert_v1alpha1_Override_To_v1beta1_Override is an autogenerated conversion function.
+func Convert_v1alpha1_Override_To_v1beta1_Override(in *v1alpha1.Override, out *Override, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Override_To_v1beta1_Override(in, out, s)
+}
+
+func autoConvert_v1beta1_PortalAuthLDAPConfig_To_v1alpha1_PortalAuthLDAPConfig(in *PortalAuthLDAPConfig, out *v1alpha1.PortalAuthLDAPConfig, s conversion.Scope) error {
+	if err := Convert_v1beta1_LDAPConnectionConfig_To_v1alpha1_LDAPConnectionConfig(&in.LDAPConnectionConfig, &out.LDAPConnectionConfig, s); err != nil {
+		return err
+	}
+	out.Groups = (*v1alpha1.LDAPGroups)(unsafe.Pointer(in.Groups))
+	out.Attributes = (*v1alpha1.Attributes)(unsafe.Pointer(in.Attributes))
+	out.SyncedAttributes = *(*[]string)(unsafe.Pointer(&in.SyncedAttributes))
+	return nil
+}
+
+// Convert_v1beta1_PortalAuthLDAPConfig_To_v1alpha1_PortalAuthLDAPConfig is an autogenerated conversion function.
+func Convert_v1beta1_PortalAuthLDAPConfig_To_v1alpha1_PortalAuthLDAPConfig(in *PortalAuthLDAPConfig, out *v1alpha1.PortalAuthLDAPConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_PortalAuthLDAPConfig_To_v1alpha1_PortalAuthLDAPConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_PortalAuthLDAPConfig_To_v1beta1_PortalAuthLDAPConfig(in *v1alpha1.PortalAuthLDAPConfig, out *PortalAuthLDAPConfig, s conversion.Scope) error {
+	if err := Convert_v1alpha1_LDAPConnectionConfig_To_v1beta1_LDAPConnectionConfig(&in.LDAPConnectionConfig, &out.LDAPConnectionConfig, s); err != nil {
+		return err
+	}
+	out.Groups = (*LDAPGroups)(unsafe.Pointer(in.Groups))
+	out.Attributes = (*Attributes)(unsafe.Pointer(in.Attributes))
+	out.SyncedAttributes = *(*[]string)(unsafe.Pointer(&in.SyncedAttributes))
+	return nil
+}
+
+// Convert_v1alpha1_PortalAuthLDAPConfig_To_v1beta1_PortalAuthLDAPConfig is an autogenerated conversion function.
+func Convert_v1alpha1_PortalAuthLDAPConfig_To_v1beta1_PortalAuthLDAPConfig(in *v1alpha1.PortalAuthLDAPConfig, out *PortalAuthLDAPConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PortalAuthLDAPConfig_To_v1beta1_PortalAuthLDAPConfig(in, out, s)
+}
+
+func autoConvert_v1beta1_Quota_To_v1alpha1_Quota(in *Quota, out *v1alpha1.Quota, s conversion.Scope) error {
+	out.Limit = in.Limit
+	out.Period = (*v1alpha1.Period)(unsafe.Pointer(in.Period))
+	out.Bucket = v1alpha1.Bucket(in.Bucket)
+	out.Window = v1alpha1.QuotaWindow(in.Window)
+	return nil
+}
+
+// Convert_v1beta1_Quota_To_v1alpha1_Quota is an autogenerated conversion function.
+func Convert_v1beta1_Quota_To_v1alpha1_Quota(in *Quota, out *v1alpha1.Quota, s conversion.Scope) error {
+	return autoConvert_v1beta1_Quota_To_v1alpha1_Quota(in, out, s)
+}
+
+func autoConvert_v1alpha1_Quota_To_v1beta1_Quota(in *v1alpha1.Quota, out *Quota, s conversion.Scope) error {
+	out.Limit = in.Limit
+	out.Period = (*Period)(unsafe.Pointer(in.Period))
+	out.Bucket = Bucket(in.Bucket)
+	out.Window = QuotaWindow(in.Window)
+	return nil
+}
+
+// Convert_v1alpha1_Quota_To_v1beta1_Quota is an autogenerated conversion function.
+func Convert_v1alpha1_Quota_To_v1beta1_Quota(in *v1alpha1.Quota, out *Quota, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Quota_To_v1beta1_Quota(in, out, s)
+}
+
+func autoConvert_v1beta1_RateLimit_To_v1alpha1_RateLimit(in *RateLimit, out *v1alpha1.RateLimit, s conversion.Scope) error {
+	out.Limit = in.Limit
+	out.Period = (*v1alpha1.Period)(unsafe.Pointer(in.Period))
+	out.Bucket = v1alpha1.Bucket(in.Bucket)
+	return nil
+}
+
+// Convert_v1beta1_RateLimit_To_v1alpha1_RateLimit is an autogenerated conversion function.
+func Convert_v1beta1_RateLimit_To_v1alpha1_RateLimit(in *RateLimit, out *v1alpha1.RateLimit, s conversion.Scope) error {
+	return autoConvert_v1beta1_RateLimit_To_v1alpha1_RateLimit(in, out, s)
+}
+
+func autoConvert_v1alpha1_RateLimit_To_v1beta1_RateLimit(in *v1alpha1.RateLimit, out *RateLimit, s conversion.Scope) error {
+	out.Limit = in.Limit
+	out.Period = (*Period)(unsafe.Pointer(in.Period))
+	out.Bucket = Bucket(in.Bucket)
+	return nil
+}
+
+// Convert_v1alpha1_RateLimit_To_v1beta1_RateLimit is an autogenerated conversion function.
+func Convert_v1alpha1_RateLimit_To_v1beta1_RateLimit(in *v1alpha1.RateLimit, out *RateLimit, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RateLimit_To_v1beta1_RateLimit(in, out, s)
+}
+
+func autoConvert_v1beta1_ResolvedAPIReference_To_v1alpha1_ResolvedAPIReference(in *ResolvedAPIReference, out *v1alpha1.ResolvedAPIReference, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1beta1_ResolvedAPIReference_To_v1alpha1_ResolvedAPIReference is an autogenerated conversion function.
+func Convert_v1beta1_ResolvedAPIReference_To_v1alpha1_ResolvedAPIReference(in *ResolvedAPIReference, out *v1alpha1.ResolvedAPIReference, s conversion.Scope) error {
+	return autoConvert_v1beta1_ResolvedAPIReference_To_v1alpha1_ResolvedAPIReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_ResolvedAPIReference_To_v1beta1_ResolvedAPIReference(in *v1alpha1.ResolvedAPIReference, out *ResolvedAPIReference, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_ResolvedAPIReference_To_v1beta1_ResolvedAPIReference is an autogenerated conversion function.
+func Convert_v1alpha1_ResolvedAPIReference_To_v1beta1_ResolvedAPIReference(in *v1alpha1.ResolvedAPIReference, out *ResolvedAPIReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResolvedAPIReference_To_v1beta1_ResolvedAPIReference(in, out, s)
+}
+
+func autoConvert_v1beta1_ResolvedManagedApplicationReference_To_v1alpha1_ResolvedManagedApplicationReference(in *ResolvedManagedApplicationReference, out *v1alpha1.ResolvedManagedApplicationReference, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1beta1_ResolvedManagedApplicationReference_To_v1alpha1_ResolvedManagedApplicationReference is an autogenerated conversion function.
+func Convert_v1beta1_ResolvedManagedApplicationReference_To_v1alpha1_ResolvedManagedApplicationReference(in *ResolvedManagedApplicationReference, out *v1alpha1.ResolvedManagedApplicationReference, s conversion.Scope) error {
+	return autoConvert_v1beta1_ResolvedManagedApplicationReference_To_v1alpha1_ResolvedManagedApplicationReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_ResolvedManagedApplicationReference_To_v1beta1_ResolvedManagedApplicationReference(in *v1alpha1.ResolvedManagedApplicationReference, out *ResolvedManagedApplicationReference, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_ResolvedManagedApplicationReference_To_v1beta1_ResolvedManagedApplicationReference is an autogenerated conversion function.
+func Convert_v1alpha1_ResolvedManagedApplicationReference_To_v1beta1_ResolvedManagedApplicationReference(in *v1alpha1.ResolvedManagedApplicationReference, out *ResolvedManagedApplicationReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResolvedManagedApplicationReference_To_v1beta1_ResolvedManagedApplicationReference(in, out, s)
+}
+
+func autoConvert_v1beta1_Server_To_v1alpha1_Server(in *Server, out *v1alpha1.Server, s conversion.Scope) error {
+	out.URL = in.URL
+	return nil
+}
+
+// Convert_v1beta1_Server_To_v1alpha1_Server is an autogenerated conversion function.
+func Convert_v1beta1_Server_To_v1alpha1_Server(in *Server, out *v1alpha1.Server, s conversion.Scope) error {
+	return autoConvert_v1beta1_Server_To_v1alpha1_Server(in, out, s)
+}
+
+func autoConvert_v1alpha1_Server_To_v1beta1_Server(in *v1alpha1.Server, out *Server, s conversion.Scope) error {
+	out.URL = in.URL
+	return nil
+}
+
+// Convert_v1alpha1_Server_To_v1beta1_Server is an autogenerated conversion function.
+func Convert_v1alpha1_Server_To_v1beta1_Server(in *v1alpha1.Server, out *Server, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Server_To_v1beta1_Server(in, out, s)
+}
+
+func autoConvert_v1beta1_TokenQuota_To_v1alpha1_TokenQuota(in *TokenQuota, out *v1alpha1.TokenQuota, s conversion.Scope) error {
+	out.Limit = in.Limit
+	out.Period = (*v1alpha1.Period)(unsafe.Pointer(in.Period))
+	out.Bucket = v1alpha1.Bucket(in.Bucket)
+	out.Window = v1alpha1.QuotaWindow(in.Window)
+	out.Tokens = v1alpha1.TokenType(in.Tokens)
+	return nil
+}
+
+// Convert_v1beta1_TokenQuota_To_v1alpha1_TokenQuota is an autogenerated conversion function.
+func Convert_v1beta1_TokenQuota_To_v1alpha1_TokenQuota(in *TokenQuota, out *v1alpha1.TokenQuota, s conversion.Scope) error {
+	return autoConvert_v1beta1_TokenQuota_To_v1alpha1_TokenQuota(in, out, s)
+}
+
+func autoConvert_v1alpha1_TokenQuota_To_v1beta1_TokenQuota(in *v1alpha1.TokenQuota, out *TokenQuota, s conversion.Scope) error {
+	out.Limit = in.Limit
+	out.Period = (*Period)(unsafe.Pointer(in.Period))
+	out.Bucket = Bucket(in.Bucket)
+	out.Window = QuotaWindow(in.Window)
+	out.Tokens = TokenType(in.Tokens)
+	return nil
+}
+
+// Convert_v1alpha1_TokenQuota_To_v1beta1_TokenQuota is an autogenerated conversion function.
+func Convert_v1alpha1_TokenQuota_To_v1beta1_TokenQuota(in *v1alpha1.TokenQuota, out *TokenQuota, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TokenQuota_To_v1beta1_TokenQuota(in, out, s)
+}
+
+func autoConvert_v1beta1_TrustedIssuer_To_v1alpha1_TrustedIssuer(in *TrustedIssuer, out *v1alpha1.TrustedIssuer, s conversion.Scope) error {
+	out.JWKSURL = in.JWKSURL
+	out.Issuer = in.Issuer
+	return nil
+}
+
+// Convert_v1beta1_TrustedIssuer_To_v1alpha1_TrustedIssuer is an autogenerated conversion function.
+func Convert_v1beta1_TrustedIssuer_To_v1alpha1_TrustedIssuer(in *TrustedIssuer, out *v1alpha1.TrustedIssuer, s conversion.Scope) error {
+	return autoConvert_v1beta1_TrustedIssuer_To_v1alpha1_TrustedIssuer(in, out, s)
+}
+
+func autoConvert_v1alpha1_TrustedIssuer_To_v1beta1_TrustedIssuer(in *v1alpha1.TrustedIssuer, out *TrustedIssuer, s conversion.Scope) error {
+	out.JWKSURL = in.JWKSURL
+	out.Issuer = in.Issuer
+	return nil
+}
+
+// Convert_v1alpha1_TrustedIssuer_To_v1beta1_TrustedIssuer is an autogenerated conversion function.
+func Convert_v1alpha1_TrustedIssuer_To_v1beta1_TrustedIssuer(in *v1alpha1.TrustedIssuer, out *TrustedIssuer, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TrustedIssuer_To_v1beta1_TrustedIssuer(in, out, s)
+}
+
+func autoConvert_v1beta1_UISpec_To_v1alpha1_UISpec(in *UISpec, out *v1alpha1.UISpec, s conversion.Scope) error {
+	out.LogoURL = in.LogoURL
+	return nil
+}
+
+// Convert_v1beta1_UISpec_To_v1alpha1_UISpec is an autogenerated conversion function.
+func Convert_v1beta1_UISpec_To_v1alpha1_UISpec(in *UISpec, out *v1alpha1.UISpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_UISpec_To_v1alpha1_UISpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_UISpec_To_v1beta1_UISpec(in *v1alpha1.UISpec, out *UISpec, s conversion.Scope) error {
+	out.LogoURL = in.LogoURL
+	return nil
+}
+
+// Convert_v1alpha1_UISpec_To_v1beta1_UISpec is an autogenerated conversion function.
+func Convert_v1alpha1_UISpec_To_v1beta1_UISpec(in *v1alpha1.UISpec, out *UISpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_UISpec_To_v1beta1_UISpec(in, out, s)
+}
+
+func autoConvert_v1beta1_Uplink_To_v1alpha1_Uplink(in *Uplink, out *v1alpha1.Uplink, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_UplinkSpec_To_v1alpha1_UplinkSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_UplinkStatus_To_v1alpha1_UplinkStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_Uplink_To_v1alpha1_Uplink is an autogenerated conversion function.
+func Convert_v1beta1_Uplink_To_v1alpha1_Uplink(in *Uplink, out *v1alpha1.Uplink, s conversion.Scope) error {
+	return autoConvert_v1beta1_Uplink_To_v1alpha1_Uplink(in, out, s)
+}
+
+func autoConvert_v1alpha1_Uplink_To_v1beta1_Uplink(in *v1alpha1.Uplink, out *Uplink, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_UplinkSpec_To_v1beta1_UplinkSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_UplinkStatus_To_v1beta1_UplinkStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_Uplink_To_v1beta1_Uplink is an autogenerated conversion function.
+func Convert_v1alpha1_Uplink_To_v1beta1_Uplink(in *v1alpha1.Uplink, out *Uplink, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Uplink_To_v1beta1_Uplink(in, out, s)
+}
+
+func autoConvert_v1beta1_UplinkHealthCheck_To_v1alpha1_UplinkHealthCheck(in *UplinkHealthCheck, out *v1alpha1.UplinkHealthCheck, s conversion.Scope) error {
+	out.Path = in.Path
+	out.Scheme = in.Scheme
+	out.Mode = in.Mode
+	out.Method = in.Method
+	out.Status = in.Status
+	out.Port = in.Port
+	if err := Convert_Pointer_v1beta1_Period_To_intstr_IntOrString(&in.Interval, &out.Interval, s); err != nil {
+		return err
+	}
+	if err := Convert_Pointer_v1beta1_Period_To_Pointer_intstr_IntOrString(&in.UnhealthyInterval, &out.UnhealthyInterval, s); err != nil {
+		return err
+	}
+	if err := Convert_Pointer_v1beta1_Period_To_intstr_IntOrString(&in.Timeout, &out.Timeout, s); err != nil {
+		return err
+	}
+	out.Hostname = in.Hostname
+	out.FollowRedirects = (*bool)(unsafe.Pointer(in.FollowRedirects))
+	out.Headers = *(*map[string]string)(unsafe.Pointer(&in.Headers))
+	return nil
+}
+
+// Convert_v1beta1_UplinkHealthCheck_To_v1alpha1_UplinkHealthCheck is an autogenerated conversion function.
+func Convert_v1beta1_UplinkHealthCheck_To_v1alpha1_UplinkHealthCheck(in *UplinkHealthCheck, out *v1alpha1.UplinkHealthCheck, s conversion.Scope) error {
+	return autoConvert_v1beta1_UplinkHealthCheck_To_v1alpha1_UplinkHealthCheck(in, out, s)
+}
+
+func autoConvert_v1alpha1_UplinkHealthCheck_To_v1beta1_UplinkHealthCheck(in *v1alpha1.UplinkHealthCheck, out *UplinkHealthCheck, s conversion.Scope) error {
+	out.Path = in.Path
+	out.Scheme = in.Scheme
+	out.Mode = in.Mode
+	out.Method = in.Method
+	out.Status = in.Status
+	out.Port = in.Port
+	if err := Convert_intstr_IntOrString_To_Pointer_v1beta1_Period(&in.Interval, &out.Interval, s); err != nil {
+		return err
+	}
+	if err := Convert_Pointer_intstr_IntOrString_To_Pointer_v1beta1_Period(&in.UnhealthyInterval, &out.UnhealthyInterval, s); err != nil {
+		return err
+	}
+	if err := Convert_intstr_IntOrString_To_Pointer_v1beta1_Period(&in.Timeout, &out.Timeout, s); err != nil {
+		return err
+	}
+	out.Hostname = in.Hostname
+	out.FollowRedirects = (*bool)(unsafe.Pointer(in.FollowRedirects))
+	out.Headers = *(*map[string]string)(unsafe.Pointer(&in.Headers))
+	return nil
+}
+
+// Convert_v1alpha1_UplinkHealthCheck_To_v1beta1_UplinkHealthCheck is an autogenerated conversion function.
+func Convert_v1alpha1_UplinkHealthCheck_To_v1beta1_UplinkHealthCheck(in *v1alpha1.UplinkHealthCheck, out *UplinkHealthCheck, s conversion.Scope) error {
+	return autoConvert_v1alpha1_UplinkHealthCheck_To_v1beta1_UplinkHealthCheck(in, out, s)
+}
+
+func autoConvert_v1beta1_UplinkList_To_v1alpha1_UplinkList(in *UplinkList, out *v1alpha1.UplinkList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha1.Uplink, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_Uplink_To_v1alpha1_Uplink(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_UplinkList_To_v1alpha1_UplinkList is an autogenerated conversion function.
+func Convert_v1beta1_UplinkList_To_v1alpha1_UplinkList(in *UplinkList, out *v1alpha1.UplinkList, s conversion.Scope) error {
+	return autoConvert_v1beta1_UplinkList_To_v1alpha1_UplinkList(in, out, s)
+}
+
+func autoConvert_v1alpha1_UplinkList_To_v1beta1_UplinkList(in *v1alpha1.UplinkList, out *UplinkList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Uplink, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_Uplink_To_v1beta1_Uplink(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_UplinkList_To_v1beta1_UplinkList is an autogenerated conversion function.
+func Convert_v1alpha1_UplinkList_To_v1beta1_UplinkList(in *v1alpha1.UplinkList, out *UplinkList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_UplinkList_To_v1beta1_UplinkList(in, out, s)
+}
+
+func autoConvert_v1beta1_UplinkPassiveHealthCheck_To_v1alpha1_UplinkPassiveHealthCheck(in *UplinkPassiveHealthCheck, out *v1alpha1.UplinkPassiveHealthCheck, s conversion.Scope) error {
+	if err := Convert_Pointer_v1beta1_Period_To_intstr_IntOrString(&in.FailureWindow, &out.FailureWindow, s); err != nil {
+		return err
+	}
+	out.MaxFailedAttempts = in.MaxFailedAttempts
+	return nil
+}
+
+// Convert_v1beta1_UplinkPassiveHealthCheck_To_v1alpha1_UplinkPassiveHealthCheck is an autogenerated conversion function.
+func Convert_v1beta1_UplinkPassiveHealthCheck_To_v1alpha1_UplinkPassiveHealthCheck(in *UplinkPassiveHealthCheck, out *v1alpha1.UplinkPassiveHealthCheck, s conversion.Scope) error {
+	return autoConvert_v1beta1_UplinkPassiveHealthCheck_To_v1alpha1_UplinkPassiveHealthCheck(in, out, s)
+}
+
+func autoConvert_v1alpha1_UplinkPassiveHealthCheck_To_v1beta1_UplinkPassiveHealthCheck(in *v1alpha1.UplinkPassiveHealthCheck, out *UplinkPassiveHealthCheck, s conversion.Scope) error {
+	if err := Convert_intstr_IntOrString_To_Pointer_v1beta1_Period(&in.FailureWindow, &out.FailureWindow, s); err != nil {
+		return err
+	}
+	out.MaxFailedAttempts = in.MaxFailedAttempts
+	return nil
+}
+
+// Convert_v1alpha1_UplinkPassiveHealthCheck_To_v1beta1_UplinkPassiveHealthCheck is an autogenerated conversion function.
+func Convert_v1alpha1_UplinkPassiveHealthCheck_To_v1beta1_UplinkPassiveHealthCheck(in *v1alpha1.UplinkPassiveHealthCheck, out *UplinkPassiveHealthCheck, s conversion.Scope) error {
+	return autoConvert_v1alpha1_UplinkPassiveHealthCheck_To_v1beta1_UplinkPassiveHealthCheck(in, out, s)
+}
+
+func autoConvert_v1beta1_UplinkSpec_To_v1alpha1_UplinkSpec(in *UplinkSpec, out *v1alpha1.UplinkSpec, s conversion.Scope) error {
+	out.ExposeName = in.ExposeName
+	out.EntryPoints = *(*[]string)(unsafe.Pointer(&in.EntryPoints))
+	out.Weight = (*int)(unsafe.Pointer(in.Weight))
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(v1alpha1.UplinkHealthCheck)
+		if err := Convert_v1beta1_UplinkHealthCheck_To_v1alpha1_UplinkHealthCheck(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.HealthCheck = nil
+	}
+	if in.PassiveHealthCheck != nil {
+		in, out := &in.PassiveHealthCheck, &out.PassiveHealthCheck
+		*out = new(v1alpha1.UplinkPassiveHealthCheck)
+		if err := Convert_v1beta1_UplinkPassiveHealthCheck_To_v1alpha1_UplinkPassiveHealthCheck(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PassiveHealthCheck = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_UplinkSpec_To_v1alpha1_UplinkSpec is an autogenerated conversion function.
+func Convert_v1beta1_UplinkSpec_To_v1alpha1_UplinkSpec(in *UplinkSpec, out *v1alpha1.UplinkSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_UplinkSpec_To_v1alpha1_UplinkSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_UplinkSpec_To_v1beta1_UplinkSpec(in *v1alpha1.UplinkSpec, out *UplinkSpec, s conversion.Scope) error {
+	out.ExposeName = in.ExposeName
+	out.EntryPoints = *(*[]string)(unsafe.Pointer(&in.EntryPoints))
+	out.Weight = (*int)(unsafe.Pointer(in.Weight))
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(UplinkHealthCheck)
+		if err := Convert_v1alpha1_UplinkHealthCheck_To_v1beta1_UplinkHealthCheck(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.HealthCheck = nil
+	}
+	if in.PassiveHealthCheck != nil {
+		in, out := &in.PassiveHealthCheck, &out.PassiveHealthCheck
+		*out = new(UplinkPassiveHealthCheck)
+		if err := Convert_v1alpha1_UplinkPassiveHealthCheck_To_v1beta1_UplinkPassiveHealthCheck(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PassiveHealthCheck = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_UplinkSpec_To_v1beta1_UplinkSpec is an autogenerated conversion function.
+func Convert_v1alpha1_UplinkSpec_To_v1beta1_UplinkSpec(in *v1alpha1.UplinkSpec, out *UplinkSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_UplinkSpec_To_v1beta1_UplinkSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_UplinkStatus_To_v1alpha1_UplinkStatus(in *UplinkStatus, out *v1alpha1.UplinkStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1beta1_UplinkStatus_To_v1alpha1_UplinkStatus is an autogenerated conversion function.
+func Convert_v1beta1_UplinkStatus_To_v1alpha1_UplinkStatus(in *UplinkStatus, out *v1alpha1.UplinkStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_UplinkStatus_To_v1alpha1_UplinkStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_UplinkStatus_To_v1beta1_UplinkStatus(in *v1alpha1.UplinkStatus, out *UplinkStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1alpha1_UplinkStatus_To_v1beta1_UplinkStatus is an autogenerated conversion function.
+func Convert_v1alpha1_UplinkStatus_To_v1beta1_UplinkStatus(in *v1alpha1.UplinkStatus, out *UplinkStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_UplinkStatus_To_v1beta1_UplinkStatus(in, out, s)
+}
@@ -0,0 +1,237 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package claims_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/hub-crds/pkg/claims"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc string
+		expr string
+		want string
+	}{
+		{
+			desc: "call",
+			expr: "Equals(`group`, `admin`)",
+			want: "Equals(`group`, `admin`)",
+		},
+		{
+			desc: "double quoted strings",
+			expr: `Equals("group", "ad\"min")`,
+			want: "Equals(`group`, `ad\"min`)",
+		},
+		{
+			desc: "and binds tighter than or",
+			expr: "Equals(`a`, `1`) || Equals(`b`, `2`) && Equals(`c`, `3`)",
+			want: "(Equals(`a`, `1`) || (Equals(`b`, `2`) && Equals(`c`, `3`)))",
+		},
+		{
+			desc: "parentheses and negation",
+			expr: "!(Prefix(`sub`, `test-`) || OneOf(`grp`, `a`, `b`, `c`)) && SplitContains(`scope`, ` `, `read`)",
+			want: "(!(Prefix(`sub`, `test-`) || OneOf(`grp`, `a`, `b`, `c`)) && SplitContains(`scope`, ` `, `read`))",
+		},
+		{
+			desc: "maximum nesting",
+			expr: strings.Repeat("!(", 16) + "Equals(`a`, `b`)" + strings.Repeat(")", 16),
+			want: strings.Repeat("!", 16) + "Equals(`a`, `b`)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			expr, err := claims.Parse(test.expr)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.want, expr.String())
+		})
+	}
+}
+
+func TestParse_errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc    string
+		expr    string
+		wantErr *claims.SyntaxError
+	}{
+		{
+			desc:    "empty",
+			expr:    "  ",
+			wantErr: &claims.SyntaxError{Offset: 0, Message: "empty expression"},
+		},
+		{
+			desc:    "unknown function",
+			expr:    "Equals(`a`, `b`) && Matches(`a`, `b`)",
+			wantErr: &claims.SyntaxError{Offset: 20, Message: `unknown function "Matches"`},
+		},
+		{
+			desc:    "wrong number of arguments",
+			expr:    "Equals(`a`)",
+			wantErr: &claims.SyntaxError{Offset: 0, Message: "Equals expects 2 arguments, got 1"},
+		},
+		{
+			desc:    "not enough arguments for a variadic function",
+			expr:    "OneOf(`a`)",
+			wantErr: &claims.SyntaxError{Offset: 0, Message: "OneOf expects at least 2 arguments, got 1"},
+		},
+		{
+			desc:    "missing parenthesis",
+			expr:    "(Equals(`a`, `b`)",
+			wantErr: &claims.SyntaxError{Offset: 17, Message: `expected ")", got end of expression`},
+		},
+		{
+			desc:    "missing call",
+			expr:    "Equals",
+			wantErr: &claims.SyntaxError{Offset: 6, Message: `expected "(", got end of expression`},
+		},
+		{
+			desc:    "unquoted argument",
+			expr:    "Equals(group, `admin`)",
+			wantErr: &claims.SyntaxError{Offset: 7, Message: `expected a string, got "group"`},
+		},
+		{
+			desc:    "missing comma",
+			expr:    "Equals(`group` `admin`)",
+			wantErr: &claims.SyntaxError{Offset: 15, Message: `expected "," or ")", got "admin"`},
+		},
+		{
+			desc:    "trailing operator",
+			expr:    "Equals(`a`, `b`) ||",
+			wantErr: &claims.SyntaxError{Offset: 19, Message: "unexpected end of expression"},
+		},
+		{
+			desc:    "trailing token",
+			expr:    "Equals(`a`, `b`) )",
+			wantErr: &claims.SyntaxError{Offset: 17, Message: `unexpected ")"`},
+		},
+		{
+			desc:    "single ampersand",
+			expr:    "Equals(`a`, `b`) & Equals(`a`, `b`)",
+			wantErr: &claims.SyntaxError{Offset: 17, Message: `unexpected character '&'`},
+		},
+		{
+			desc:    "unterminated string",
+			expr:    "Equals(`a`, `b)",
+			wantErr: &claims.SyntaxError{Offset: 12, Message: "unterminated string"},
+		},
+		{
+			desc:    "too deeply nested negations",
+			expr:    strings.Repeat("!", 33) + "Equals(`a`, `b`)",
+			wantErr: &claims.SyntaxError{Offset: 32, Message: "expression nested more than 32 levels deep"},
+		},
+		{
+			desc:    "too deeply nested parentheses",
+			expr:    strings.Repeat("(", 100000),
+			wantErr: &claims.SyntaxError{Offset: 32, Message: "expression nested more than 32 levels deep"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := claims.Parse(test.expr)
+
+			var syntaxErr *claims.SyntaxError
+			require.ErrorAs(t, err, &syntaxErr)
+			assert.Equal(t, test.wantErr, syntaxErr)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	path := field.NewPath("spec", "claims")
+
+	assert.Empty(t, claims.Validate("Equals(`a`, `b`)", path))
+
+	want := field.ErrorList{field.Invalid(path, "Equals(`a`)", "Equals expects 2 arguments, got 1 at offset 0")}
+	assert.Equal(t, want, claims.Validate("Equals(`a`)", path))
+}
+
+func TestExpr_Eval(t *testing.T) {
+	t.Parallel()
+
+	decoder := json.NewDecoder(strings.NewReader(`{
+		"sub": "test-user",
+		"group": "admin",
+		"groups": ["dev", "ops"],
+		"scope": "read write",
+		"age": 42,
+		"verified": true,
+		"user": {"team": {"name": "core"}},
+		"user.team": "flat"
+	}`))
+	decoder.UseNumber()
+
+	var claimsMap map[string]any
+	require.NoError(t, decoder.Decode(&claimsMap))
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{expr: "Equals(`group`, `admin`)", want: true},
+		{expr: "Equals(`group`, `dev`)", want: false},
+		{expr: "Equals(`missing`, ``)", want: false},
+		{expr: "Equals(`groups`, `ops`)", want: true},
+		{expr: "Equals(`age`, `42`)", want: true},
+		{expr: "Equals(`verified`, `true`)", want: true},
+		{expr: "Prefix(`sub`, `test-`)", want: true},
+		{expr: "Prefix(`groups`, `de`)", want: true},
+		{expr: "Contains(`scope`, `wri`)", want: true},
+		{expr: "Contains(`groups`, `de`)", want: false},
+		{expr: "Contains(`groups`, `dev`)", want: true},
+		{expr: "SplitContains(`scope`, ` `, `write`)", want: true},
+		{expr: "SplitContains(`scope`, ` `, `wri`)", want: false},
+		{expr: "OneOf(`group`, `dev`, `admin`)", want: true},
+		{expr: "OneOf(`groups`, `admin`, `qa`)", want: false},
+		{expr: "Equals(`user.team.name`, `core`)", want: true},
+		{expr: "Equals(`user.team`, `flat`)", want: true},
+		{expr: "Equals(`user.missing.name`, `core`)", want: false},
+		{expr: "Equals(`group`, `admin`) && !Equals(`group`, `dev`)", want: true},
+		{expr: "Equals(`group`, `dev`) || Equals(`groups`, `dev`)", want: true},
+		{expr: "!(Equals(`group`, `admin`) || Equals(`group`, `dev`))", want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			t.Parallel()
+
+			expr, err := claims.Parse(test.expr)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.want, expr.Eval(claimsMap))
+		})
+	}
+}
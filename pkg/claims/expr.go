@@ -0,0 +1,198 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package claims parses and evaluates the claims expressions of ManagedSubscriptions and AccessControlPolicies.
+//
+// An expression combines function calls with the "&&", "||" and "!" operators and parentheses:
+//
+//	Equals(`group`, `admin`) || (Contains(`scope`, `read`) && !Prefix(`sub`, `test-`))
+//
+// Arguments are strings quoted with backticks or double quotes. The first argument of every function is the name
+// of a claim, nested claims being reached with a dot-separated path (`user.group`) when no top-level claim has
+// this exact name. The supported functions are:
+//
+//   - Equals(claim, value): the claim is equal to value.
+//   - Prefix(claim, value): the claim starts with value.
+//   - Contains(claim, value): the claim contains value, as a substring for strings and as an item for lists.
+//   - SplitContains(claim, separator, value): the claim, split by separator, contains value.
+//   - OneOf(claim, values...): the claim is equal to one of the values.
+//
+// Equals, Prefix and OneOf match a list claim when one of its items matches. Numbers and booleans are compared
+// using their JSON representation.
+package claims
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Expr is a parsed claims expression.
+type Expr interface {
+	// Eval evaluates the expression against the given claims.
+	Eval(claims map[string]any) bool
+	// String returns the expression in its canonical form.
+	String() string
+}
+
+// And is the conjunction of two expressions.
+type And struct {
+	Left, Right Expr
+}
+
+// Eval evaluates the expression against the given claims.
+func (e And) Eval(claims map[string]any) bool {
+	return e.Left.Eval(claims) && e.Right.Eval(claims)
+}
+
+func (e And) String() string {
+	return "(" + e.Left.String() + " && " + e.Right.String() + ")"
+}
+
+// Or is the disjunction of two expressions.
+type Or struct {
+	Left, Right Expr
+}
+
+// Eval evaluates the expression against the given claims.
+func (e Or) Eval(claims map[string]any) bool {
+	return e.Left.Eval(claims) || e.Right.Eval(claims)
+}
+
+func (e Or) String() string {
+	return "(" + e.Left.String() + " || " + e.Right.String() + ")"
+}
+
+// Not is the negation of an expression.
+type Not struct {
+	Expr Expr
+}
+
+// Eval evaluates the expression against the given claims.
+func (e Not) Eval(claims map[string]any) bool {
+	return !e.Expr.Eval(claims)
+}
+
+func (e Not) String() string {
+	return "!" + e.Expr.String()
+}
+
+// Call is a function call.
+type Call struct {
+	Func string
+	// Args are the arguments of the call, the first one being the claim name.
+	Args []string
+}
+
+// Eval evaluates the expression against the given claims.
+// A call on a missing claim is false.
+func (e Call) Eval(claims map[string]any) bool {
+	value, ok := lookup(claims, e.Args[0])
+	if !ok {
+		return false
+	}
+
+	switch e.Func {
+	case "Equals":
+		return matchAny(value, func(s string) bool { return s == e.Args[1] })
+	case "Prefix":
+		return matchAny(value, func(s string) bool { return strings.HasPrefix(s, e.Args[1]) })
+	case "Contains":
+		if s, ok := value.(string); ok {
+			return strings.Contains(s, e.Args[1])
+		}
+
+		return matchAny(value, func(s string) bool { return s == e.Args[1] })
+	case "SplitContains":
+		s, ok := value.(string)
+
+		return ok && slices.Contains(strings.Split(s, e.Args[1]), e.Args[2])
+	case "OneOf":
+		return matchAny(value, func(s string) bool { return slices.Contains(e.Args[1:], s) })
+	default:
+		return false
+	}
+}
+
+func (e Call) String() string {
+	args := make([]string, 0, len(e.Args))
+	for _, arg := range e.Args {
+		if strings.Contains(arg, "`") {
+			args = append(args, strconv.Quote(arg))
+			continue
+		}
+
+		args = append(args, "`"+arg+"`")
+	}
+
+	return e.Func + "(" + strings.Join(args, ", ") + ")"
+}
+
+// lookup returns the value of the named claim. A name which isn't a top-level claim is looked up as a
+// dot-separated path through nested claims.
+func lookup(claims map[string]any, name string) (any, bool) {
+	if value, ok := claims[name]; ok {
+		return value, true
+	}
+
+	var value any = claims
+	for _, part := range strings.Split(name, ".") {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+
+		if value, ok = m[part]; !ok {
+			return nil, false
+		}
+	}
+
+	return value, true
+}
+
+// matchAny calls match with the given value, or with each of its items when it is a list.
+func matchAny(value any, match func(string) bool) bool {
+	if items, ok := value.([]any); ok {
+		return slices.ContainsFunc(items, func(item any) bool {
+			s, ok := stringify(item)
+			return ok && match(s)
+		})
+	}
+
+	s, ok := stringify(value)
+
+	return ok && match(s)
+}
+
+func stringify(value any) (string, bool) {
+	switch typed := value.(type) {
+	case string:
+		return typed, true
+	case bool:
+		return strconv.FormatBool(typed), true
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64), true
+	case int64:
+		return strconv.FormatInt(typed, 10), true
+	case fmt.Stringer:
+		// json.Number.
+		return typed.String(), true
+	default:
+		return "", false
+	}
+}
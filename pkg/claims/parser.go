@@ -0,0 +1,361 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package claims
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// arities holds the minimum and maximum number of arguments of the supported functions, -1 meaning unbounded.
+var arities = map[string][2]int{
+	"Equals":        {2, 2},
+	"Prefix":        {2, 2},
+	"Contains":      {2, 2},
+	"SplitContains": {3, 3},
+	"OneOf":         {2, -1},
+}
+
+// maxDepth is the maximum nesting depth of negations and parentheses, which bounds the recursion of the parser.
+const maxDepth = 32
+
+// SyntaxError is an error in the syntax of an expression.
+type SyntaxError struct {
+	// Offset is the byte offset of the error in the expression.
+	Offset  int
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%s at offset %d", e.Message, e.Offset)
+}
+
+// Validate parses the given expression, and reports its syntax error as an invalid field.
+func Validate(expr string, fieldPath *field.Path) field.ErrorList {
+	if _, err := Parse(expr); err != nil {
+		return field.ErrorList{field.Invalid(fieldPath, expr, err.Error())}
+	}
+
+	return nil
+}
+
+// Parse parses the given expression. Syntax errors are returned as *SyntaxError.
+func Parse(expr string) (Expr, error) {
+	p := &parser{lexer: lexer{input: expr}}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	if p.token.kind == tokenEOF {
+		return nil, &SyntaxError{Offset: 0, Message: "empty expression"}
+	}
+
+	e, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.token.kind != tokenEOF {
+		return nil, p.unexpected()
+	}
+
+	return e, nil
+}
+
+type parser struct {
+	lexer lexer
+	token token
+	// depth is the number of negations and parentheses enclosing the current token.
+	depth int
+}
+
+func (p *parser) next() error {
+	t, err := p.lexer.next()
+	if err != nil {
+		return err
+	}
+
+	p.token = t
+
+	return nil
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.token.kind == tokenOr {
+		if err = p.next(); err != nil {
+			return nil, err
+		}
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = Or{Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.token.kind == tokenAnd {
+		if err = p.next(); err != nil {
+			return nil, err
+		}
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		left = And{Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseUnary() (Expr, error) {
+	if p.token.kind == tokenNot || p.token.kind == tokenLeftParen {
+		if p.depth == maxDepth {
+			return nil, &SyntaxError{Offset: p.token.offset, Message: fmt.Sprintf("expression nested more than %d levels deep", maxDepth)}
+		}
+
+		p.depth++
+		defer func() { p.depth-- }()
+	}
+
+	switch p.token.kind {
+	case tokenNot:
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+
+		e, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return Not{Expr: e}, nil
+
+	case tokenLeftParen:
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+
+		e, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.token.kind != tokenRightParen {
+			return nil, p.expected(`")"`)
+		}
+
+		return e, p.next()
+
+	case tokenIdent:
+		return p.parseCall()
+
+	default:
+		return nil, p.unexpected()
+	}
+}
+
+func (p *parser) parseCall() (Expr, error) {
+	name, offset := p.token.value, p.token.offset
+
+	arity, ok := arities[name]
+	if !ok {
+		return nil, &SyntaxError{Offset: offset, Message: fmt.Sprintf("unknown function %q", name)}
+	}
+
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	if p.token.kind != tokenLeftParen {
+		return nil, p.expected(`"("`)
+	}
+
+	var args []string
+	for {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		if p.token.kind != tokenString {
+			return nil, p.expected("a string")
+		}
+
+		args = append(args, p.token.value)
+
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		if p.token.kind == tokenRightParen {
+			break
+		}
+		if p.token.kind != tokenComma {
+			return nil, p.expected(`"," or ")"`)
+		}
+	}
+
+	if len(args) < arity[0] || (arity[1] >= 0 && len(args) > arity[1]) {
+		return nil, &SyntaxError{Offset: offset, Message: fmt.Sprintf("%s expects %s, got %d", name, arityString(arity), len(args))}
+	}
+
+	return Call{Func: name, Args: args}, p.next()
+}
+
+func (p *parser) expected(what string) error {
+	if p.token.kind == tokenEOF {
+		return &SyntaxError{Offset: p.token.offset, Message: "expected " + what + ", got end of expression"}
+	}
+
+	return &SyntaxError{Offset: p.token.offset, Message: fmt.Sprintf("expected %s, got %q", what, p.token.value)}
+}
+
+func (p *parser) unexpected() error {
+	if p.token.kind == tokenEOF {
+		return &SyntaxError{Offset: p.token.offset, Message: "unexpected end of expression"}
+	}
+
+	return &SyntaxError{Offset: p.token.offset, Message: fmt.Sprintf("unexpected %q", p.token.value)}
+}
+
+func arityString(arity [2]int) string {
+	switch {
+	case arity[1] < 0:
+		return fmt.Sprintf("at least %d arguments", arity[0])
+	case arity[0] == arity[1]:
+		return fmt.Sprintf("%d arguments", arity[0])
+	default:
+		return fmt.Sprintf("%d to %d arguments", arity[0], arity[1])
+	}
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenLeftParen
+	tokenRightParen
+	tokenComma
+	tokenAnd
+	tokenOr
+	tokenNot
+)
+
+type token struct {
+	kind   tokenKind
+	value  string
+	offset int
+}
+
+type lexer struct {
+	input string
+	pos   int
+}
+
+func (l *lexer) next() (token, error) {
+	for l.pos < len(l.input) && strings.ContainsRune(" \t\r\n", rune(l.input[l.pos])) {
+		l.pos++
+	}
+
+	start := l.pos
+	if start >= len(l.input) {
+		return token{kind: tokenEOF, offset: start}, nil
+	}
+
+	switch c := l.input[start]; {
+	case c == '(':
+		l.pos++
+		return token{kind: tokenLeftParen, value: "(", offset: start}, nil
+	case c == ')':
+		l.pos++
+		return token{kind: tokenRightParen, value: ")", offset: start}, nil
+	case c == ',':
+		l.pos++
+		return token{kind: tokenComma, value: ",", offset: start}, nil
+	case c == '!':
+		l.pos++
+		return token{kind: tokenNot, value: "!", offset: start}, nil
+	case strings.HasPrefix(l.input[start:], "&&"):
+		l.pos += 2
+		return token{kind: tokenAnd, value: "&&", offset: start}, nil
+	case strings.HasPrefix(l.input[start:], "||"):
+		l.pos += 2
+		return token{kind: tokenOr, value: "||", offset: start}, nil
+	case c == '`':
+		end := strings.IndexByte(l.input[start+1:], '`')
+		if end < 0 {
+			return token{}, &SyntaxError{Offset: start, Message: "unterminated string"}
+		}
+
+		l.pos = start + end + 2
+
+		return token{kind: tokenString, value: l.input[start+1 : start+1+end], offset: start}, nil
+	case c == '"':
+		return l.quotedString(start)
+	case isLetter(c):
+		for l.pos < len(l.input) && (isLetter(l.input[l.pos]) || l.input[l.pos] >= '0' && l.input[l.pos] <= '9') {
+			l.pos++
+		}
+
+		return token{kind: tokenIdent, value: l.input[start:l.pos], offset: start}, nil
+	default:
+		return token{}, &SyntaxError{Offset: start, Message: fmt.Sprintf("unexpected character %q", c)}
+	}
+}
+
+func (l *lexer) quotedString(start int) (token, error) {
+	for i := start + 1; i < len(l.input); i++ {
+		switch l.input[i] {
+		case '\\':
+			i++
+		case '"':
+			value, err := strconv.Unquote(l.input[start : i+1])
+			if err != nil {
+				return token{}, &SyntaxError{Offset: start, Message: "invalid string"}
+			}
+
+			l.pos = i + 1
+
+			return token{kind: tokenString, value: value, offset: start}, nil
+		}
+	}
+
+	return token{}, &SyntaxError{Offset: start, Message: "unterminated string"}
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_'
+}
@@ -0,0 +1,75 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AccessControlOAuthIntroApplyConfiguration represents an declarative configuration of the AccessControlOAuthIntro type for use
+// with apply.
+type AccessControlOAuthIntroApplyConfiguration struct {
+	ClientConfig   *AccessControlOAuthIntroClientConfigApplyConfiguration `json:"clientConfig,omitempty"`
+	TokenSource    *TokenSourceApplyConfiguration                         `json:"tokenSource,omitempty"`
+	Claims         *string                                                `json:"claims,omitempty"`
+	ForwardHeaders map[string]string                                      `json:"forwardHeaders,omitempty"`
+}
+
+// AccessControlOAuthIntroApplyConfiguration constructs an declarative configuration of the AccessControlOAuthIntro type for use with
+// apply.
+func AccessControlOAuthIntro() *AccessControlOAuthIntroApplyConfiguration {
+	return &AccessControlOAuthIntroApplyConfiguration{}
+}
+
+// WithClientConfig sets the ClientConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClientConfig field is set to the value of the last call.
+func (b *AccessControlOAuthIntroApplyConfiguration) WithClientConfig(value *AccessControlOAuthIntroClientConfigApplyConfiguration) *AccessControlOAuthIntroApplyConfiguration {
+	b.ClientConfig = value
+	return b
+}
+
+// WithTokenSource sets the TokenSource field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TokenSource field is set to the value of the last call.
+func (b *AccessControlOAuthIntroApplyConfiguration) WithTokenSource(value *TokenSourceApplyConfiguration) *AccessControlOAuthIntroApplyConfiguration {
+	b.TokenSource = value
+	return b
+}
+
+// WithClaims sets the Claims field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Claims field is set to the value of the last call.
+func (b *AccessControlOAuthIntroApplyConfiguration) WithClaims(value string) *AccessControlOAuthIntroApplyConfiguration {
+	b.Claims = &value
+	return b
+}
+
+// WithForwardHeaders puts the entries into the ForwardHeaders field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ForwardHeaders field,
+// overwriting an existing map entries in ForwardHeaders field with the same key.
+func (b *AccessControlOAuthIntroApplyConfiguration) WithForwardHeaders(entries map[string]string) *AccessControlOAuthIntroApplyConfiguration {
+	if b.ForwardHeaders == nil && len(entries) > 0 {
+		b.ForwardHeaders = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ForwardHeaders[k] = v
+	}
+	return b
+}
@@ -0,0 +1,91 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AccessControlOAuthIntroClientConfigApplyConfiguration represents an declarative configuration of the AccessControlOAuthIntroClientConfig type for use
+// with apply.
+type AccessControlOAuthIntroClientConfigApplyConfiguration struct {
+	HTTPClientConfigApplyConfiguration `json:",inline"`
+	URL                                *string           `json:"url,omitempty"`
+	Headers                            map[string]string `json:"headers,omitempty"`
+	TokenTypeHint                      *string           `json:"tokenTypeHint,omitempty"`
+}
+
+// AccessControlOAuthIntroClientConfigApplyConfiguration constructs an declarative configuration of the AccessControlOAuthIntroClientConfig type for use with
+// apply.
+func AccessControlOAuthIntroClientConfig() *AccessControlOAuthIntroClientConfigApplyConfiguration {
+	return &AccessControlOAuthIntroClientConfigApplyConfiguration{}
+}
+
+// WithTLS sets the TLS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TLS field is set to the value of the last call.
+func (b *AccessControlOAuthIntroClientConfigApplyConfiguration) WithTLS(value *HTTPClientConfigTLSApplyConfiguration) *AccessControlOAuthIntroClientConfigApplyConfiguration {
+	b.TLS = value
+	return b
+}
+
+// WithTimeoutSeconds sets the TimeoutSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TimeoutSeconds field is set to the value of the last call.
+func (b *AccessControlOAuthIntroClientConfigApplyConfiguration) WithTimeoutSeconds(value int) *AccessControlOAuthIntroClientConfigApplyConfiguration {
+	b.TimeoutSeconds = &value
+	return b
+}
+
+// WithMaxRetries sets the MaxRetries field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRetries field is set to the value of the last call.
+func (b *AccessControlOAuthIntroClientConfigApplyConfiguration) WithMaxRetries(value int) *AccessControlOAuthIntroClientConfigApplyConfiguration {
+	b.MaxRetries = &value
+	return b
+}
+
+// WithURL sets the URL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URL field is set to the value of the last call.
+func (b *AccessControlOAuthIntroClientConfigApplyConfiguration) WithURL(value string) *AccessControlOAuthIntroClientConfigApplyConfiguration {
+	b.URL = &value
+	return b
+}
+
+// WithHeaders puts the entries into the Headers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Headers field,
+// overwriting an existing map entries in Headers field with the same key.
+func (b *AccessControlOAuthIntroClientConfigApplyConfiguration) WithHeaders(entries map[string]string) *AccessControlOAuthIntroClientConfigApplyConfiguration {
+	if b.Headers == nil && len(entries) > 0 {
+		b.Headers = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Headers[k] = v
+	}
+	return b
+}
+
+// WithTokenTypeHint sets the TokenTypeHint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TokenTypeHint field is set to the value of the last call.
+func (b *AccessControlOAuthIntroClientConfigApplyConfiguration) WithTokenTypeHint(value string) *AccessControlOAuthIntroClientConfigApplyConfiguration {
+	b.TokenTypeHint = &value
+	return b
+}
@@ -0,0 +1,71 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AccessControlPolicyAPIKeyApplyConfiguration represents an declarative configuration of the AccessControlPolicyAPIKey type for use
+// with apply.
+type AccessControlPolicyAPIKeyApplyConfiguration struct {
+	KeySource      *TokenSourceApplyConfiguration                   `json:"keySource,omitempty"`
+	Keys           []AccessControlPolicyAPIKeyKeyApplyConfiguration `json:"keys,omitempty"`
+	ForwardHeaders map[string]string                                `json:"forwardHeaders,omitempty"`
+}
+
+// AccessControlPolicyAPIKeyApplyConfiguration constructs an declarative configuration of the AccessControlPolicyAPIKey type for use with
+// apply.
+func AccessControlPolicyAPIKey() *AccessControlPolicyAPIKeyApplyConfiguration {
+	return &AccessControlPolicyAPIKeyApplyConfiguration{}
+}
+
+// WithKeySource sets the KeySource field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the KeySource field is set to the value of the last call.
+func (b *AccessControlPolicyAPIKeyApplyConfiguration) WithKeySource(value *TokenSourceApplyConfiguration) *AccessControlPolicyAPIKeyApplyConfiguration {
+	b.KeySource = value
+	return b
+}
+
+// WithKeys adds the given value to the Keys field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Keys field.
+func (b *AccessControlPolicyAPIKeyApplyConfiguration) WithKeys(values ...*AccessControlPolicyAPIKeyKeyApplyConfiguration) *AccessControlPolicyAPIKeyApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithKeys")
+		}
+		b.Keys = append(b.Keys, *values[i])
+	}
+	return b
+}
+
+// WithForwardHeaders puts the entries into the ForwardHeaders field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ForwardHeaders field,
+// overwriting an existing map entries in ForwardHeaders field with the same key.
+func (b *AccessControlPolicyAPIKeyApplyConfiguration) WithForwardHeaders(entries map[string]string) *AccessControlPolicyAPIKeyApplyConfiguration {
+	if b.ForwardHeaders == nil && len(entries) > 0 {
+		b.ForwardHeaders = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ForwardHeaders[k] = v
+	}
+	return b
+}
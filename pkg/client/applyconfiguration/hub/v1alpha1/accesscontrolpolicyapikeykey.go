@@ -0,0 +1,66 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AccessControlPolicyAPIKeyKeyApplyConfiguration represents an declarative configuration of the AccessControlPolicyAPIKeyKey type for use
+// with apply.
+type AccessControlPolicyAPIKeyKeyApplyConfiguration struct {
+	ID       *string           `json:"id,omitempty"`
+	Value    *string           `json:"value,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// AccessControlPolicyAPIKeyKeyApplyConfiguration constructs an declarative configuration of the AccessControlPolicyAPIKeyKey type for use with
+// apply.
+func AccessControlPolicyAPIKeyKey() *AccessControlPolicyAPIKeyKeyApplyConfiguration {
+	return &AccessControlPolicyAPIKeyKeyApplyConfiguration{}
+}
+
+// WithID sets the ID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ID field is set to the value of the last call.
+func (b *AccessControlPolicyAPIKeyKeyApplyConfiguration) WithID(value string) *AccessControlPolicyAPIKeyKeyApplyConfiguration {
+	b.ID = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *AccessControlPolicyAPIKeyKeyApplyConfiguration) WithValue(value string) *AccessControlPolicyAPIKeyKeyApplyConfiguration {
+	b.Value = &value
+	return b
+}
+
+// WithMetadata puts the entries into the Metadata field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Metadata field,
+// overwriting an existing map entries in Metadata field with the same key.
+func (b *AccessControlPolicyAPIKeyKeyApplyConfiguration) WithMetadata(entries map[string]string) *AccessControlPolicyAPIKeyKeyApplyConfiguration {
+	if b.Metadata == nil && len(entries) > 0 {
+		b.Metadata = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Metadata[k] = v
+	}
+	return b
+}
@@ -0,0 +1,71 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AccessControlPolicyBasicAuthApplyConfiguration represents an declarative configuration of the AccessControlPolicyBasicAuth type for use
+// with apply.
+type AccessControlPolicyBasicAuthApplyConfiguration struct {
+	Users                    []string `json:"users,omitempty"`
+	Realm                    *string  `json:"realm,omitempty"`
+	StripAuthorizationHeader *bool    `json:"stripAuthorizationHeader,omitempty"`
+	ForwardUsernameHeader    *string  `json:"forwardUsernameHeader,omitempty"`
+}
+
+// AccessControlPolicyBasicAuthApplyConfiguration constructs an declarative configuration of the AccessControlPolicyBasicAuth type for use with
+// apply.
+func AccessControlPolicyBasicAuth() *AccessControlPolicyBasicAuthApplyConfiguration {
+	return &AccessControlPolicyBasicAuthApplyConfiguration{}
+}
+
+// WithUsers adds the given value to the Users field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Users field.
+func (b *AccessControlPolicyBasicAuthApplyConfiguration) WithUsers(values ...string) *AccessControlPolicyBasicAuthApplyConfiguration {
+	for i := range values {
+		b.Users = append(b.Users, values[i])
+	}
+	return b
+}
+
+// WithRealm sets the Realm field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Realm field is set to the value of the last call.
+func (b *AccessControlPolicyBasicAuthApplyConfiguration) WithRealm(value string) *AccessControlPolicyBasicAuthApplyConfiguration {
+	b.Realm = &value
+	return b
+}
+
+// WithStripAuthorizationHeader sets the StripAuthorizationHeader field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StripAuthorizationHeader field is set to the value of the last call.
+func (b *AccessControlPolicyBasicAuthApplyConfiguration) WithStripAuthorizationHeader(value bool) *AccessControlPolicyBasicAuthApplyConfiguration {
+	b.StripAuthorizationHeader = &value
+	return b
+}
+
+// WithForwardUsernameHeader sets the ForwardUsernameHeader field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ForwardUsernameHeader field is set to the value of the last call.
+func (b *AccessControlPolicyBasicAuthApplyConfiguration) WithForwardUsernameHeader(value string) *AccessControlPolicyBasicAuthApplyConfiguration {
+	b.ForwardUsernameHeader = &value
+	return b
+}
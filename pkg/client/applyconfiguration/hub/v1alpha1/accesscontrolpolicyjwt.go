@@ -0,0 +1,120 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AccessControlPolicyJWTApplyConfiguration represents an declarative configuration of the AccessControlPolicyJWT type for use
+// with apply.
+type AccessControlPolicyJWTApplyConfiguration struct {
+	SigningSecret              *string           `json:"signingSecret,omitempty"`
+	SigningSecretBase64Encoded *bool             `json:"signingSecretBase64Encoded,omitempty"`
+	PublicKey                  *string           `json:"publicKey,omitempty"`
+	JWKsFile                   *string           `json:"jwksFile,omitempty"`
+	JWKsURL                    *string           `json:"jwksUrl,omitempty"`
+	StripAuthorizationHeader   *bool             `json:"stripAuthorizationHeader,omitempty"`
+	ForwardHeaders             map[string]string `json:"forwardHeaders,omitempty"`
+	TokenQueryKey              *string           `json:"tokenQueryKey,omitempty"`
+	Claims                     *string           `json:"claims,omitempty"`
+}
+
+// AccessControlPolicyJWTApplyConfiguration constructs an declarative configuration of the AccessControlPolicyJWT type for use with
+// apply.
+func AccessControlPolicyJWT() *AccessControlPolicyJWTApplyConfiguration {
+	return &AccessControlPolicyJWTApplyConfiguration{}
+}
+
+// WithSigningSecret sets the SigningSecret field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SigningSecret field is set to the value of the last call.
+func (b *AccessControlPolicyJWTApplyConfiguration) WithSigningSecret(value string) *AccessControlPolicyJWTApplyConfiguration {
+	b.SigningSecret = &value
+	return b
+}
+
+// WithSigningSecretBase64Encoded sets the SigningSecretBase64Encoded field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SigningSecretBase64Encoded field is set to the value of the last call.
+func (b *AccessControlPolicyJWTApplyConfiguration) WithSigningSecretBase64Encoded(value bool) *AccessControlPolicyJWTApplyConfiguration {
+	b.SigningSecretBase64Encoded = &value
+	return b
+}
+
+// WithPublicKey sets the PublicKey field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PublicKey field is set to the value of the last call.
+func (b *AccessControlPolicyJWTApplyConfiguration) WithPublicKey(value string) *AccessControlPolicyJWTApplyConfiguration {
+	b.PublicKey = &value
+	return b
+}
+
+// WithJWKsFile sets the JWKsFile field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JWKsFile field is set to the value of the last call.
+func (b *AccessControlPolicyJWTApplyConfiguration) WithJWKsFile(value string) *AccessControlPolicyJWTApplyConfiguration {
+	b.JWKsFile = &value
+	return b
+}
+
+// WithJWKsURL sets the JWKsURL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JWKsURL field is set to the value of the last call.
+func (b *AccessControlPolicyJWTApplyConfiguration) WithJWKsURL(value string) *AccessControlPolicyJWTApplyConfiguration {
+	b.JWKsURL = &value
+	return b
+}
+
+// WithStripAuthorizationHeader sets the StripAuthorizationHeader field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StripAuthorizationHeader field is set to the value of the last call.
+func (b *AccessControlPolicyJWTApplyConfiguration) WithStripAuthorizationHeader(value bool) *AccessControlPolicyJWTApplyConfiguration {
+	b.StripAuthorizationHeader = &value
+	return b
+}
+
+// WithForwardHeaders puts the entries into the ForwardHeaders field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ForwardHeaders field,
+// overwriting an existing map entries in ForwardHeaders field with the same key.
+func (b *AccessControlPolicyJWTApplyConfiguration) WithForwardHeaders(entries map[string]string) *AccessControlPolicyJWTApplyConfiguration {
+	if b.ForwardHeaders == nil && len(entries) > 0 {
+		b.ForwardHeaders = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ForwardHeaders[k] = v
+	}
+	return b
+}
+
+// WithTokenQueryKey sets the TokenQueryKey field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TokenQueryKey field is set to the value of the last call.
+func (b *AccessControlPolicyJWTApplyConfiguration) WithTokenQueryKey(value string) *AccessControlPolicyJWTApplyConfiguration {
+	b.TokenQueryKey = &value
+	return b
+}
+
+// WithClaims sets the Claims field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Claims field is set to the value of the last call.
+func (b *AccessControlPolicyJWTApplyConfiguration) WithClaims(value string) *AccessControlPolicyJWTApplyConfiguration {
+	b.Claims = &value
+	return b
+}
@@ -0,0 +1,161 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// AccessControlPolicyOIDCApplyConfiguration represents an declarative configuration of the AccessControlPolicyOIDC type for use
+// with apply.
+type AccessControlPolicyOIDCApplyConfiguration struct {
+	Issuer                      *string                        `json:"issuer,omitempty"`
+	ClientID                    *string                        `json:"clientId,omitempty"`
+	Secret                      *v1.SecretReference            `json:"secret,omitempty"`
+	RedirectURL                 *string                        `json:"redirectUrl,omitempty"`
+	LogoutURL                   *string                        `json:"logoutUrl,omitempty"`
+	DisableAuthRedirectionPaths []string                       `json:"disableAuthRedirectionPaths,omitempty"`
+	AuthParams                  map[string]string              `json:"authParams,omitempty"`
+	StateCookie                 *StateCookieApplyConfiguration `json:"stateCookie,omitempty"`
+	Session                     *SessionApplyConfiguration     `json:"session,omitempty"`
+	Scopes                      []string                       `json:"scopes,omitempty"`
+	ForwardHeaders              map[string]string              `json:"forwardHeaders,omitempty"`
+	Claims                      *string                        `json:"claims,omitempty"`
+}
+
+// AccessControlPolicyOIDCApplyConfiguration constructs an declarative configuration of the AccessControlPolicyOIDC type for use with
+// apply.
+func AccessControlPolicyOIDC() *AccessControlPolicyOIDCApplyConfiguration {
+	return &AccessControlPolicyOIDCApplyConfiguration{}
+}
+
+// WithIssuer sets the Issuer field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Issuer field is set to the value of the last call.
+func (b *AccessControlPolicyOIDCApplyConfiguration) WithIssuer(value string) *AccessControlPolicyOIDCApplyConfiguration {
+	b.Issuer = &value
+	return b
+}
+
+// WithClientID sets the ClientID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClientID field is set to the value of the last call.
+func (b *AccessControlPolicyOIDCApplyConfiguration) WithClientID(value string) *AccessControlPolicyOIDCApplyConfiguration {
+	b.ClientID = &value
+	return b
+}
+
+// WithSecret sets the Secret field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Secret field is set to the value of the last call.
+func (b *AccessControlPolicyOIDCApplyConfiguration) WithSecret(value v1.SecretReference) *AccessControlPolicyOIDCApplyConfiguration {
+	b.Secret = &value
+	return b
+}
+
+// WithRedirectURL sets the RedirectURL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RedirectURL field is set to the value of the last call.
+func (b *AccessControlPolicyOIDCApplyConfiguration) WithRedirectURL(value string) *AccessControlPolicyOIDCApplyConfiguration {
+	b.RedirectURL = &value
+	return b
+}
+
+// WithLogoutURL sets the LogoutURL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LogoutURL field is set to the value of the last call.
+func (b *AccessControlPolicyOIDCApplyConfiguration) WithLogoutURL(value string) *AccessControlPolicyOIDCApplyConfiguration {
+	b.LogoutURL = &value
+	return b
+}
+
+// WithDisableAuthRedirectionPaths adds the given value to the DisableAuthRedirectionPaths field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DisableAuthRedirectionPaths field.
+func (b *AccessControlPolicyOIDCApplyConfiguration) WithDisableAuthRedirectionPaths(values ...string) *AccessControlPolicyOIDCApplyConfiguration {
+	for i := range values {
+		b.DisableAuthRedirectionPaths = append(b.DisableAuthRedirectionPaths, values[i])
+	}
+	return b
+}
+
+// WithAuthParams puts the entries into the AuthParams field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the AuthParams field,
+// overwriting an existing map entries in AuthParams field with the same key.
+func (b *AccessControlPolicyOIDCApplyConfiguration) WithAuthParams(entries map[string]string) *AccessControlPolicyOIDCApplyConfiguration {
+	if b.AuthParams == nil && len(entries) > 0 {
+		b.AuthParams = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.AuthParams[k] = v
+	}
+	return b
+}
+
+// WithStateCookie sets the StateCookie field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StateCookie field is set to the value of the last call.
+func (b *AccessControlPolicyOIDCApplyConfiguration) WithStateCookie(value *StateCookieApplyConfiguration) *AccessControlPolicyOIDCApplyConfiguration {
+	b.StateCookie = value
+	return b
+}
+
+// WithSession sets the Session field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Session field is set to the value of the last call.
+func (b *AccessControlPolicyOIDCApplyConfiguration) WithSession(value *SessionApplyConfiguration) *AccessControlPolicyOIDCApplyConfiguration {
+	b.Session = value
+	return b
+}
+
+// WithScopes adds the given value to the Scopes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Scopes field.
+func (b *AccessControlPolicyOIDCApplyConfiguration) WithScopes(values ...string) *AccessControlPolicyOIDCApplyConfiguration {
+	for i := range values {
+		b.Scopes = append(b.Scopes, values[i])
+	}
+	return b
+}
+
+// WithForwardHeaders puts the entries into the ForwardHeaders field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ForwardHeaders field,
+// overwriting an existing map entries in ForwardHeaders field with the same key.
+func (b *AccessControlPolicyOIDCApplyConfiguration) WithForwardHeaders(entries map[string]string) *AccessControlPolicyOIDCApplyConfiguration {
+	if b.ForwardHeaders == nil && len(entries) > 0 {
+		b.ForwardHeaders = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ForwardHeaders[k] = v
+	}
+	return b
+}
+
+// WithClaims sets the Claims field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Claims field is set to the value of the last call.
+func (b *AccessControlPolicyOIDCApplyConfiguration) WithClaims(value string) *AccessControlPolicyOIDCApplyConfiguration {
+	b.Claims = &value
+	return b
+}
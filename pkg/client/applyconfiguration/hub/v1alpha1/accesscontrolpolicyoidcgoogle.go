@@ -0,0 +1,132 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// AccessControlPolicyOIDCGoogleApplyConfiguration represents an declarative configuration of the AccessControlPolicyOIDCGoogle type for use
+// with apply.
+type AccessControlPolicyOIDCGoogleApplyConfiguration struct {
+	ClientID       *string                        `json:"clientId,omitempty"`
+	Secret         *v1.SecretReference            `json:"secret,omitempty"`
+	RedirectURL    *string                        `json:"redirectUrl,omitempty"`
+	LogoutURL      *string                        `json:"logoutUrl,omitempty"`
+	AuthParams     map[string]string              `json:"authParams,omitempty"`
+	StateCookie    *StateCookieApplyConfiguration `json:"stateCookie,omitempty"`
+	Session        *SessionApplyConfiguration     `json:"session,omitempty"`
+	ForwardHeaders map[string]string              `json:"forwardHeaders,omitempty"`
+	Emails         []string                       `json:"emails,omitempty"`
+}
+
+// AccessControlPolicyOIDCGoogleApplyConfiguration constructs an declarative configuration of the AccessControlPolicyOIDCGoogle type for use with
+// apply.
+func AccessControlPolicyOIDCGoogle() *AccessControlPolicyOIDCGoogleApplyConfiguration {
+	return &AccessControlPolicyOIDCGoogleApplyConfiguration{}
+}
+
+// WithClientID sets the ClientID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClientID field is set to the value of the last call.
+func (b *AccessControlPolicyOIDCGoogleApplyConfiguration) WithClientID(value string) *AccessControlPolicyOIDCGoogleApplyConfiguration {
+	b.ClientID = &value
+	return b
+}
+
+// WithSecret sets the Secret field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Secret field is set to the value of the last call.
+func (b *AccessControlPolicyOIDCGoogleApplyConfiguration) WithSecret(value v1.SecretReference) *AccessControlPolicyOIDCGoogleApplyConfiguration {
+	b.Secret = &value
+	return b
+}
+
+// WithRedirectURL sets the RedirectURL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RedirectURL field is set to the value of the last call.
+func (b *AccessControlPolicyOIDCGoogleApplyConfiguration) WithRedirectURL(value string) *AccessControlPolicyOIDCGoogleApplyConfiguration {
+	b.RedirectURL = &value
+	return b
+}
+
+// WithLogoutURL sets the LogoutURL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LogoutURL field is set to the value of the last call.
+func (b *AccessControlPolicyOIDCGoogleApplyConfiguration) WithLogoutURL(value string) *AccessControlPolicyOIDCGoogleApplyConfiguration {
+	b.LogoutURL = &value
+	return b
+}
+
+// WithAuthParams puts the entries into the AuthParams field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the AuthParams field,
+// overwriting an existing map entries in AuthParams field with the same key.
+func (b *AccessControlPolicyOIDCGoogleApplyConfiguration) WithAuthParams(entries map[string]string) *AccessControlPolicyOIDCGoogleApplyConfiguration {
+	if b.AuthParams == nil && len(entries) > 0 {
+		b.AuthParams = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.AuthParams[k] = v
+	}
+	return b
+}
+
+// WithStateCookie sets the StateCookie field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StateCookie field is set to the value of the last call.
+func (b *AccessControlPolicyOIDCGoogleApplyConfiguration) WithStateCookie(value *StateCookieApplyConfiguration) *AccessControlPolicyOIDCGoogleApplyConfiguration {
+	b.StateCookie = value
+	return b
+}
+
+// WithSession sets the Session field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Session field is set to the value of the last call.
+func (b *AccessControlPolicyOIDCGoogleApplyConfiguration) WithSession(value *SessionApplyConfiguration) *AccessControlPolicyOIDCGoogleApplyConfiguration {
+	b.Session = value
+	return b
+}
+
+// WithForwardHeaders puts the entries into the ForwardHeaders field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ForwardHeaders field,
+// overwriting an existing map entries in ForwardHeaders field with the same key.
+func (b *AccessControlPolicyOIDCGoogleApplyConfiguration) WithForwardHeaders(entries map[string]string) *AccessControlPolicyOIDCGoogleApplyConfiguration {
+	if b.ForwardHeaders == nil && len(entries) > 0 {
+		b.ForwardHeaders = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ForwardHeaders[k] = v
+	}
+	return b
+}
+
+// WithEmails adds the given value to the Emails field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Emails field.
+func (b *AccessControlPolicyOIDCGoogleApplyConfiguration) WithEmails(values ...string) *AccessControlPolicyOIDCGoogleApplyConfiguration {
+	for i := range values {
+		b.Emails = append(b.Emails, values[i])
+	}
+	return b
+}
@@ -0,0 +1,87 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AccessControlPolicySpecApplyConfiguration represents an declarative configuration of the AccessControlPolicySpec type for use
+// with apply.
+type AccessControlPolicySpecApplyConfiguration struct {
+	JWT        *AccessControlPolicyJWTApplyConfiguration        `json:"jwt,omitempty"`
+	BasicAuth  *AccessControlPolicyBasicAuthApplyConfiguration  `json:"basicAuth,omitempty"`
+	APIKey     *AccessControlPolicyAPIKeyApplyConfiguration     `json:"apiKey,omitempty"`
+	OIDC       *AccessControlPolicyOIDCApplyConfiguration       `json:"oidc,omitempty"`
+	OIDCGoogle *AccessControlPolicyOIDCGoogleApplyConfiguration `json:"oidcGoogle,omitempty"`
+	OAuthIntro *AccessControlOAuthIntroApplyConfiguration       `json:"oAuthIntro,omitempty"`
+}
+
+// AccessControlPolicySpecApplyConfiguration constructs an declarative configuration of the AccessControlPolicySpec type for use with
+// apply.
+func AccessControlPolicySpec() *AccessControlPolicySpecApplyConfiguration {
+	return &AccessControlPolicySpecApplyConfiguration{}
+}
+
+// WithJWT sets the JWT field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JWT field is set to the value of the last call.
+func (b *AccessControlPolicySpecApplyConfiguration) WithJWT(value *AccessControlPolicyJWTApplyConfiguration) *AccessControlPolicySpecApplyConfiguration {
+	b.JWT = value
+	return b
+}
+
+// WithBasicAuth sets the BasicAuth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BasicAuth field is set to the value of the last call.
+func (b *AccessControlPolicySpecApplyConfiguration) WithBasicAuth(value *AccessControlPolicyBasicAuthApplyConfiguration) *AccessControlPolicySpecApplyConfiguration {
+	b.BasicAuth = value
+	return b
+}
+
+// WithAPIKey sets the APIKey field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIKey field is set to the value of the last call.
+func (b *AccessControlPolicySpecApplyConfiguration) WithAPIKey(value *AccessControlPolicyAPIKeyApplyConfiguration) *AccessControlPolicySpecApplyConfiguration {
+	b.APIKey = value
+	return b
+}
+
+// WithOIDC sets the OIDC field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OIDC field is set to the value of the last call.
+func (b *AccessControlPolicySpecApplyConfiguration) WithOIDC(value *AccessControlPolicyOIDCApplyConfiguration) *AccessControlPolicySpecApplyConfiguration {
+	b.OIDC = value
+	return b
+}
+
+// WithOIDCGoogle sets the OIDCGoogle field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OIDCGoogle field is set to the value of the last call.
+func (b *AccessControlPolicySpecApplyConfiguration) WithOIDCGoogle(value *AccessControlPolicyOIDCGoogleApplyConfiguration) *AccessControlPolicySpecApplyConfiguration {
+	b.OIDCGoogle = value
+	return b
+}
+
+// WithOAuthIntro sets the OAuthIntro field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OAuthIntro field is set to the value of the last call.
+func (b *AccessControlPolicySpecApplyConfiguration) WithOAuthIntro(value *AccessControlOAuthIntroApplyConfiguration) *AccessControlPolicySpecApplyConfiguration {
+	b.OAuthIntro = value
+	return b
+}
@@ -0,0 +1,64 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AccessControlPolicyStatusApplyConfiguration represents an declarative configuration of the AccessControlPolicyStatus type for use
+// with apply.
+type AccessControlPolicyStatusApplyConfiguration struct {
+	Version  *string  `json:"version,omitempty"`
+	SyncedAt *v1.Time `json:"syncedAt,omitempty"`
+	SpecHash *string  `json:"specHash,omitempty"`
+}
+
+// AccessControlPolicyStatusApplyConfiguration constructs an declarative configuration of the AccessControlPolicyStatus type for use with
+// apply.
+func AccessControlPolicyStatus() *AccessControlPolicyStatusApplyConfiguration {
+	return &AccessControlPolicyStatusApplyConfiguration{}
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *AccessControlPolicyStatusApplyConfiguration) WithVersion(value string) *AccessControlPolicyStatusApplyConfiguration {
+	b.Version = &value
+	return b
+}
+
+// WithSyncedAt sets the SyncedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SyncedAt field is set to the value of the last call.
+func (b *AccessControlPolicyStatusApplyConfiguration) WithSyncedAt(value v1.Time) *AccessControlPolicyStatusApplyConfiguration {
+	b.SyncedAt = &value
+	return b
+}
+
+// WithSpecHash sets the SpecHash field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SpecHash field is set to the value of the last call.
+func (b *AccessControlPolicyStatusApplyConfiguration) WithSpecHash(value string) *AccessControlPolicyStatusApplyConfiguration {
+	b.SpecHash = &value
+	return b
+}
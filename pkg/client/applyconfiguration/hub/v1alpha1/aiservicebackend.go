@@ -0,0 +1,149 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AIServiceBackendApplyConfiguration represents an declarative configuration of the AIServiceBackend type for use
+// with apply.
+type AIServiceBackendApplyConfiguration struct {
+	AIService                           *AIServiceReferenceApplyConfiguration `json:"aiService,omitempty"`
+	AIServiceProviderApplyConfiguration `json:",inline"`
+	Weight                              *int                      `json:"weight,omitempty"`
+	Params                              *ParamsApplyConfiguration `json:"params,omitempty"`
+}
+
+// AIServiceBackendApplyConfiguration constructs an declarative configuration of the AIServiceBackend type for use with
+// apply.
+func AIServiceBackend() *AIServiceBackendApplyConfiguration {
+	return &AIServiceBackendApplyConfiguration{}
+}
+
+// WithAIService sets the AIService field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AIService field is set to the value of the last call.
+func (b *AIServiceBackendApplyConfiguration) WithAIService(value *AIServiceReferenceApplyConfiguration) *AIServiceBackendApplyConfiguration {
+	b.AIService = value
+	return b
+}
+
+// WithAnthropic sets the Anthropic field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Anthropic field is set to the value of the last call.
+func (b *AIServiceBackendApplyConfiguration) WithAnthropic(value *AnthropicApplyConfiguration) *AIServiceBackendApplyConfiguration {
+	b.Anthropic = value
+	return b
+}
+
+// WithAzureOpenAI sets the AzureOpenAI field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AzureOpenAI field is set to the value of the last call.
+func (b *AIServiceBackendApplyConfiguration) WithAzureOpenAI(value *AzureOpenAIApplyConfiguration) *AIServiceBackendApplyConfiguration {
+	b.AzureOpenAI = value
+	return b
+}
+
+// WithBedrock sets the Bedrock field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Bedrock field is set to the value of the last call.
+func (b *AIServiceBackendApplyConfiguration) WithBedrock(value *BedrockApplyConfiguration) *AIServiceBackendApplyConfiguration {
+	b.Bedrock = value
+	return b
+}
+
+// WithCohere sets the Cohere field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cohere field is set to the value of the last call.
+func (b *AIServiceBackendApplyConfiguration) WithCohere(value *CohereApplyConfiguration) *AIServiceBackendApplyConfiguration {
+	b.Cohere = value
+	return b
+}
+
+// WithGemini sets the Gemini field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Gemini field is set to the value of the last call.
+func (b *AIServiceBackendApplyConfiguration) WithGemini(value *GeminiApplyConfiguration) *AIServiceBackendApplyConfiguration {
+	b.Gemini = value
+	return b
+}
+
+// WithMistral sets the Mistral field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Mistral field is set to the value of the last call.
+func (b *AIServiceBackendApplyConfiguration) WithMistral(value *MistralApplyConfiguration) *AIServiceBackendApplyConfiguration {
+	b.Mistral = value
+	return b
+}
+
+// WithOllama sets the Ollama field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ollama field is set to the value of the last call.
+func (b *AIServiceBackendApplyConfiguration) WithOllama(value *OllamaApplyConfiguration) *AIServiceBackendApplyConfiguration {
+	b.Ollama = value
+	return b
+}
+
+// WithOpenAI sets the OpenAI field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OpenAI field is set to the value of the last call.
+func (b *AIServiceBackendApplyConfiguration) WithOpenAI(value *OpenAIApplyConfiguration) *AIServiceBackendApplyConfiguration {
+	b.OpenAI = value
+	return b
+}
+
+// WithDeepSeek sets the DeepSeek field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeepSeek field is set to the value of the last call.
+func (b *AIServiceBackendApplyConfiguration) WithDeepSeek(value *DeepSeekApplyConfiguration) *AIServiceBackendApplyConfiguration {
+	b.DeepSeek = value
+	return b
+}
+
+// WithQWen sets the QWen field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the QWen field is set to the value of the last call.
+func (b *AIServiceBackendApplyConfiguration) WithQWen(value *QWenApplyConfiguration) *AIServiceBackendApplyConfiguration {
+	b.QWen = value
+	return b
+}
+
+// WithOpenAICompatible sets the OpenAICompatible field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OpenAICompatible field is set to the value of the last call.
+func (b *AIServiceBackendApplyConfiguration) WithOpenAICompatible(value *OpenAICompatibleApplyConfiguration) *AIServiceBackendApplyConfiguration {
+	b.OpenAICompatible = value
+	return b
+}
+
+// WithWeight sets the Weight field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Weight field is set to the value of the last call.
+func (b *AIServiceBackendApplyConfiguration) WithWeight(value int) *AIServiceBackendApplyConfiguration {
+	b.Weight = &value
+	return b
+}
+
+// WithParams sets the Params field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Params field is set to the value of the last call.
+func (b *AIServiceBackendApplyConfiguration) WithParams(value *ParamsApplyConfiguration) *AIServiceBackendApplyConfiguration {
+	b.Params = value
+	return b
+}
@@ -0,0 +1,57 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+)
+
+// AIServiceFailoverApplyConfiguration represents an declarative configuration of the AIServiceFailover type for use
+// with apply.
+type AIServiceFailoverApplyConfiguration struct {
+	StatusCodes []string         `json:"statusCodes,omitempty"`
+	Timeout     *v1alpha1.Period `json:"timeout,omitempty"`
+}
+
+// AIServiceFailoverApplyConfiguration constructs an declarative configuration of the AIServiceFailover type for use with
+// apply.
+func AIServiceFailover() *AIServiceFailoverApplyConfiguration {
+	return &AIServiceFailoverApplyConfiguration{}
+}
+
+// WithStatusCodes adds the given value to the StatusCodes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the StatusCodes field.
+func (b *AIServiceFailoverApplyConfiguration) WithStatusCodes(values ...string) *AIServiceFailoverApplyConfiguration {
+	for i := range values {
+		b.StatusCodes = append(b.StatusCodes, values[i])
+	}
+	return b
+}
+
+// WithTimeout sets the Timeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeout field is set to the value of the last call.
+func (b *AIServiceFailoverApplyConfiguration) WithTimeout(value v1alpha1.Period) *AIServiceFailoverApplyConfiguration {
+	b.Timeout = &value
+	return b
+}
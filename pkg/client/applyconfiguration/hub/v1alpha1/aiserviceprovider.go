@@ -0,0 +1,132 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AIServiceProviderApplyConfiguration represents an declarative configuration of the AIServiceProvider type for use
+// with apply.
+type AIServiceProviderApplyConfiguration struct {
+	Anthropic        *AnthropicApplyConfiguration        `json:"anthropic,omitempty"`
+	AzureOpenAI      *AzureOpenAIApplyConfiguration      `json:"azureOpenai,omitempty"`
+	Bedrock          *BedrockApplyConfiguration          `json:"bedrock,omitempty"`
+	Cohere           *CohereApplyConfiguration           `json:"cohere,omitempty"`
+	Gemini           *GeminiApplyConfiguration           `json:"gemini,omitempty"`
+	Mistral          *MistralApplyConfiguration          `json:"mistral,omitempty"`
+	Ollama           *OllamaApplyConfiguration           `json:"ollama,omitempty"`
+	OpenAI           *OpenAIApplyConfiguration           `json:"openai,omitempty"`
+	DeepSeek         *DeepSeekApplyConfiguration         `json:"deepSeek,omitempty"`
+	QWen             *QWenApplyConfiguration             `json:"qWen,omitempty"`
+	OpenAICompatible *OpenAICompatibleApplyConfiguration `json:"openaiCompatible,omitempty"`
+}
+
+// AIServiceProviderApplyConfiguration constructs an declarative configuration of the AIServiceProvider type for use with
+// apply.
+func AIServiceProvider() *AIServiceProviderApplyConfiguration {
+	return &AIServiceProviderApplyConfiguration{}
+}
+
+// WithAnthropic sets the Anthropic field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Anthropic field is set to the value of the last call.
+func (b *AIServiceProviderApplyConfiguration) WithAnthropic(value *AnthropicApplyConfiguration) *AIServiceProviderApplyConfiguration {
+	b.Anthropic = value
+	return b
+}
+
+// WithAzureOpenAI sets the AzureOpenAI field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AzureOpenAI field is set to the value of the last call.
+func (b *AIServiceProviderApplyConfiguration) WithAzureOpenAI(value *AzureOpenAIApplyConfiguration) *AIServiceProviderApplyConfiguration {
+	b.AzureOpenAI = value
+	return b
+}
+
+// WithBedrock sets the Bedrock field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Bedrock field is set to the value of the last call.
+func (b *AIServiceProviderApplyConfiguration) WithBedrock(value *BedrockApplyConfiguration) *AIServiceProviderApplyConfiguration {
+	b.Bedrock = value
+	return b
+}
+
+// WithCohere sets the Cohere field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cohere field is set to the value of the last call.
+func (b *AIServiceProviderApplyConfiguration) WithCohere(value *CohereApplyConfiguration) *AIServiceProviderApplyConfiguration {
+	b.Cohere = value
+	return b
+}
+
+// WithGemini sets the Gemini field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Gemini field is set to the value of the last call.
+func (b *AIServiceProviderApplyConfiguration) WithGemini(value *GeminiApplyConfiguration) *AIServiceProviderApplyConfiguration {
+	b.Gemini = value
+	return b
+}
+
+// WithMistral sets the Mistral field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Mistral field is set to the value of the last call.
+func (b *AIServiceProviderApplyConfiguration) WithMistral(value *MistralApplyConfiguration) *AIServiceProviderApplyConfiguration {
+	b.Mistral = value
+	return b
+}
+
+// WithOllama sets the Ollama field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ollama field is set to the value of the last call.
+func (b *AIServiceProviderApplyConfiguration) WithOllama(value *OllamaApplyConfiguration) *AIServiceProviderApplyConfiguration {
+	b.Ollama = value
+	return b
+}
+
+// WithOpenAI sets the OpenAI field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OpenAI field is set to the value of the last call.
+func (b *AIServiceProviderApplyConfiguration) WithOpenAI(value *OpenAIApplyConfiguration) *AIServiceProviderApplyConfiguration {
+	b.OpenAI = value
+	return b
+}
+
+// WithDeepSeek sets the DeepSeek field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeepSeek field is set to the value of the last call.
+func (b *AIServiceProviderApplyConfiguration) WithDeepSeek(value *DeepSeekApplyConfiguration) *AIServiceProviderApplyConfiguration {
+	b.DeepSeek = value
+	return b
+}
+
+// WithQWen sets the QWen field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the QWen field is set to the value of the last call.
+func (b *AIServiceProviderApplyConfiguration) WithQWen(value *QWenApplyConfiguration) *AIServiceProviderApplyConfiguration {
+	b.QWen = value
+	return b
+}
+
+// WithOpenAICompatible sets the OpenAICompatible field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OpenAICompatible field is set to the value of the last call.
+func (b *AIServiceProviderApplyConfiguration) WithOpenAICompatible(value *OpenAICompatibleApplyConfiguration) *AIServiceProviderApplyConfiguration {
+	b.OpenAICompatible = value
+	return b
+}
@@ -0,0 +1,69 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+)
+
+// AIServiceRoutingApplyConfiguration represents an declarative configuration of the AIServiceRouting type for use
+// with apply.
+type AIServiceRoutingApplyConfiguration struct {
+	Strategy *v1alpha1.AIServiceRoutingStrategy   `json:"strategy,omitempty"`
+	Backends []AIServiceBackendApplyConfiguration `json:"backends,omitempty"`
+	Failover *AIServiceFailoverApplyConfiguration `json:"failover,omitempty"`
+}
+
+// AIServiceRoutingApplyConfiguration constructs an declarative configuration of the AIServiceRouting type for use with
+// apply.
+func AIServiceRouting() *AIServiceRoutingApplyConfiguration {
+	return &AIServiceRoutingApplyConfiguration{}
+}
+
+// WithStrategy sets the Strategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Strategy field is set to the value of the last call.
+func (b *AIServiceRoutingApplyConfiguration) WithStrategy(value v1alpha1.AIServiceRoutingStrategy) *AIServiceRoutingApplyConfiguration {
+	b.Strategy = &value
+	return b
+}
+
+// WithBackends adds the given value to the Backends field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Backends field.
+func (b *AIServiceRoutingApplyConfiguration) WithBackends(values ...*AIServiceBackendApplyConfiguration) *AIServiceRoutingApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithBackends")
+		}
+		b.Backends = append(b.Backends, *values[i])
+	}
+	return b
+}
+
+// WithFailover sets the Failover field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Failover field is set to the value of the last call.
+func (b *AIServiceRoutingApplyConfiguration) WithFailover(value *AIServiceFailoverApplyConfiguration) *AIServiceRoutingApplyConfiguration {
+	b.Failover = value
+	return b
+}
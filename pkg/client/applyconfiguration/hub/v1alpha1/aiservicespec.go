@@ -0,0 +1,163 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AIServiceSpecApplyConfiguration represents an declarative configuration of the AIServiceSpec type for use
+// with apply.
+type AIServiceSpecApplyConfiguration struct {
+	AIServiceProviderApplyConfiguration `json:",inline"`
+	Routing                             *AIServiceRoutingApplyConfiguration `json:"routing,omitempty"`
+	TokenBudgets                        []TokenQuotaApplyConfiguration      `json:"tokenBudgets,omitempty"`
+	Models                              *ModelFilterApplyConfiguration      `json:"models,omitempty"`
+	ContentGuard                        *ContentGuardApplyConfiguration     `json:"contentGuard,omitempty"`
+}
+
+// AIServiceSpecApplyConfiguration constructs an declarative configuration of the AIServiceSpec type for use with
+// apply.
+func AIServiceSpec() *AIServiceSpecApplyConfiguration {
+	return &AIServiceSpecApplyConfiguration{}
+}
+
+// WithAnthropic sets the Anthropic field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Anthropic field is set to the value of the last call.
+func (b *AIServiceSpecApplyConfiguration) WithAnthropic(value *AnthropicApplyConfiguration) *AIServiceSpecApplyConfiguration {
+	b.Anthropic = value
+	return b
+}
+
+// WithAzureOpenAI sets the AzureOpenAI field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AzureOpenAI field is set to the value of the last call.
+func (b *AIServiceSpecApplyConfiguration) WithAzureOpenAI(value *AzureOpenAIApplyConfiguration) *AIServiceSpecApplyConfiguration {
+	b.AzureOpenAI = value
+	return b
+}
+
+// WithBedrock sets the Bedrock field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Bedrock field is set to the value of the last call.
+func (b *AIServiceSpecApplyConfiguration) WithBedrock(value *BedrockApplyConfiguration) *AIServiceSpecApplyConfiguration {
+	b.Bedrock = value
+	return b
+}
+
+// WithCohere sets the Cohere field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cohere field is set to the value of the last call.
+func (b *AIServiceSpecApplyConfiguration) WithCohere(value *CohereApplyConfiguration) *AIServiceSpecApplyConfiguration {
+	b.Cohere = value
+	return b
+}
+
+// WithGemini sets the Gemini field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Gemini field is set to the value of the last call.
+func (b *AIServiceSpecApplyConfiguration) WithGemini(value *GeminiApplyConfiguration) *AIServiceSpecApplyConfiguration {
+	b.Gemini = value
+	return b
+}
+
+// WithMistral sets the Mistral field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Mistral field is set to the value of the last call.
+func (b *AIServiceSpecApplyConfiguration) WithMistral(value *MistralApplyConfiguration) *AIServiceSpecApplyConfiguration {
+	b.Mistral = value
+	return b
+}
+
+// WithOllama sets the Ollama field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ollama field is set to the value of the last call.
+func (b *AIServiceSpecApplyConfiguration) WithOllama(value *OllamaApplyConfiguration) *AIServiceSpecApplyConfiguration {
+	b.Ollama = value
+	return b
+}
+
+// WithOpenAI sets the OpenAI field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OpenAI field is set to the value of the last call.
+func (b *AIServiceSpecApplyConfiguration) WithOpenAI(value *OpenAIApplyConfiguration) *AIServiceSpecApplyConfiguration {
+	b.OpenAI = value
+	return b
+}
+
+// WithDeepSeek sets the DeepSeek field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeepSeek field is set to the value of the last call.
+func (b *AIServiceSpecApplyConfiguration) WithDeepSeek(value *DeepSeekApplyConfiguration) *AIServiceSpecApplyConfiguration {
+	b.DeepSeek = value
+	return b
+}
+
+// WithQWen sets the QWen field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the QWen field is set to the value of the last call.
+func (b *AIServiceSpecApplyConfiguration) WithQWen(value *QWenApplyConfiguration) *AIServiceSpecApplyConfiguration {
+	b.QWen = value
+	return b
+}
+
+// WithOpenAICompatible sets the OpenAICompatible field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OpenAICompatible field is set to the value of the last call.
+func (b *AIServiceSpecApplyConfiguration) WithOpenAICompatible(value *OpenAICompatibleApplyConfiguration) *AIServiceSpecApplyConfiguration {
+	b.OpenAICompatible = value
+	return b
+}
+
+// WithRouting sets the Routing field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Routing field is set to the value of the last call.
+func (b *AIServiceSpecApplyConfiguration) WithRouting(value *AIServiceRoutingApplyConfiguration) *AIServiceSpecApplyConfiguration {
+	b.Routing = value
+	return b
+}
+
+// WithTokenBudgets adds the given value to the TokenBudgets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TokenBudgets field.
+func (b *AIServiceSpecApplyConfiguration) WithTokenBudgets(values ...*TokenQuotaApplyConfiguration) *AIServiceSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTokenBudgets")
+		}
+		b.TokenBudgets = append(b.TokenBudgets, *values[i])
+	}
+	return b
+}
+
+// WithModels sets the Models field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Models field is set to the value of the last call.
+func (b *AIServiceSpecApplyConfiguration) WithModels(value *ModelFilterApplyConfiguration) *AIServiceSpecApplyConfiguration {
+	b.Models = value
+	return b
+}
+
+// WithContentGuard sets the ContentGuard field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ContentGuard field is set to the value of the last call.
+func (b *AIServiceSpecApplyConfiguration) WithContentGuard(value *ContentGuardApplyConfiguration) *AIServiceSpecApplyConfiguration {
+	b.ContentGuard = value
+	return b
+}
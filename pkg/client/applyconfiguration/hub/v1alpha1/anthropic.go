@@ -0,0 +1,60 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AnthropicApplyConfiguration represents an declarative configuration of the Anthropic type for use
+// with apply.
+type AnthropicApplyConfiguration struct {
+	Token  *SecretReferenceApplyConfiguration `json:"token,omitempty"`
+	Model  *string                            `json:"model,omitempty"`
+	Params *ParamsApplyConfiguration          `json:"params,omitempty"`
+}
+
+// AnthropicApplyConfiguration constructs an declarative configuration of the Anthropic type for use with
+// apply.
+func Anthropic() *AnthropicApplyConfiguration {
+	return &AnthropicApplyConfiguration{}
+}
+
+// WithToken sets the Token field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Token field is set to the value of the last call.
+func (b *AnthropicApplyConfiguration) WithToken(value *SecretReferenceApplyConfiguration) *AnthropicApplyConfiguration {
+	b.Token = value
+	return b
+}
+
+// WithModel sets the Model field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Model field is set to the value of the last call.
+func (b *AnthropicApplyConfiguration) WithModel(value string) *AnthropicApplyConfiguration {
+	b.Model = &value
+	return b
+}
+
+// WithParams sets the Params field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Params field is set to the value of the last call.
+func (b *AnthropicApplyConfiguration) WithParams(value *ParamsApplyConfiguration) *AnthropicApplyConfiguration {
+	b.Params = value
+	return b
+}
@@ -0,0 +1,103 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// APICatalogItemStatusApplyConfiguration represents an declarative configuration of the APICatalogItemStatus type for use
+// with apply.
+type APICatalogItemStatusApplyConfiguration struct {
+	Version        *string                                  `json:"version,omitempty"`
+	SyncedAt       *v1.Time                                 `json:"syncedAt,omitempty"`
+	Hash           *string                                  `json:"hash,omitempty"`
+	Conditions     []v1.Condition                           `json:"conditions,omitempty"`
+	ResolvedAPIs   []ResolvedAPIReferenceApplyConfiguration `json:"resolvedApis,omitempty"`
+	UnresolvedAPIs []ResolvedAPIReferenceApplyConfiguration `json:"unresolvedApis,omitempty"`
+}
+
+// APICatalogItemStatusApplyConfiguration constructs an declarative configuration of the APICatalogItemStatus type for use with
+// apply.
+func APICatalogItemStatus() *APICatalogItemStatusApplyConfiguration {
+	return &APICatalogItemStatusApplyConfiguration{}
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *APICatalogItemStatusApplyConfiguration) WithVersion(value string) *APICatalogItemStatusApplyConfiguration {
+	b.Version = &value
+	return b
+}
+
+// WithSyncedAt sets the SyncedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SyncedAt field is set to the value of the last call.
+func (b *APICatalogItemStatusApplyConfiguration) WithSyncedAt(value v1.Time) *APICatalogItemStatusApplyConfiguration {
+	b.SyncedAt = &value
+	return b
+}
+
+// WithHash sets the Hash field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Hash field is set to the value of the last call.
+func (b *APICatalogItemStatusApplyConfiguration) WithHash(value string) *APICatalogItemStatusApplyConfiguration {
+	b.Hash = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *APICatalogItemStatusApplyConfiguration) WithConditions(values ...v1.Condition) *APICatalogItemStatusApplyConfiguration {
+	for i := range values {
+		b.Conditions = append(b.Conditions, values[i])
+	}
+	return b
+}
+
+// WithResolvedAPIs adds the given value to the ResolvedAPIs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ResolvedAPIs field.
+func (b *APICatalogItemStatusApplyConfiguration) WithResolvedAPIs(values ...*ResolvedAPIReferenceApplyConfiguration) *APICatalogItemStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithResolvedAPIs")
+		}
+		b.ResolvedAPIs = append(b.ResolvedAPIs, *values[i])
+	}
+	return b
+}
+
+// WithUnresolvedAPIs adds the given value to the UnresolvedAPIs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the UnresolvedAPIs field.
+func (b *APICatalogItemStatusApplyConfiguration) WithUnresolvedAPIs(values ...*ResolvedAPIReferenceApplyConfiguration) *APICatalogItemStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithUnresolvedAPIs")
+		}
+		b.UnresolvedAPIs = append(b.UnresolvedAPIs, *values[i])
+	}
+	return b
+}
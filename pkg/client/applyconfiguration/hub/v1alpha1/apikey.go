@@ -0,0 +1,69 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// APIKeyApplyConfiguration represents an declarative configuration of the APIKey type for use
+// with apply.
+type APIKeyApplyConfiguration struct {
+	SecretName *string `json:"secretName,omitempty"`
+	Value      *string `json:"value,omitempty"`
+	Title      *string `json:"title,omitempty"`
+	Suspended  *bool   `json:"suspended,omitempty"`
+}
+
+// APIKeyApplyConfiguration constructs an declarative configuration of the APIKey type for use with
+// apply.
+func APIKey() *APIKeyApplyConfiguration {
+	return &APIKeyApplyConfiguration{}
+}
+
+// WithSecretName sets the SecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretName field is set to the value of the last call.
+func (b *APIKeyApplyConfiguration) WithSecretName(value string) *APIKeyApplyConfiguration {
+	b.SecretName = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *APIKeyApplyConfiguration) WithValue(value string) *APIKeyApplyConfiguration {
+	b.Value = &value
+	return b
+}
+
+// WithTitle sets the Title field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Title field is set to the value of the last call.
+func (b *APIKeyApplyConfiguration) WithTitle(value string) *APIKeyApplyConfiguration {
+	b.Title = &value
+	return b
+}
+
+// WithSuspended sets the Suspended field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Suspended field is set to the value of the last call.
+func (b *APIKeyApplyConfiguration) WithSuspended(value bool) *APIKeyApplyConfiguration {
+	b.Suspended = &value
+	return b
+}
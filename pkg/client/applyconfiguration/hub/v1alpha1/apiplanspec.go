@@ -0,0 +1,78 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// APIPlanSpecApplyConfiguration represents an declarative configuration of the APIPlanSpec type for use
+// with apply.
+type APIPlanSpecApplyConfiguration struct {
+	Title       *string                       `json:"title,omitempty"`
+	Description *string                       `json:"description,omitempty"`
+	RateLimit   *RateLimitApplyConfiguration  `json:"rateLimit,omitempty"`
+	Quota       *QuotaApplyConfiguration      `json:"quota,omitempty"`
+	TokenQuota  *TokenQuotaApplyConfiguration `json:"tokenQuota,omitempty"`
+}
+
+// APIPlanSpecApplyConfiguration constructs an declarative configuration of the APIPlanSpec type for use with
+// apply.
+func APIPlanSpec() *APIPlanSpecApplyConfiguration {
+	return &APIPlanSpecApplyConfiguration{}
+}
+
+// WithTitle sets the Title field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Title field is set to the value of the last call.
+func (b *APIPlanSpecApplyConfiguration) WithTitle(value string) *APIPlanSpecApplyConfiguration {
+	b.Title = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *APIPlanSpecApplyConfiguration) WithDescription(value string) *APIPlanSpecApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithRateLimit sets the RateLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RateLimit field is set to the value of the last call.
+func (b *APIPlanSpecApplyConfiguration) WithRateLimit(value *RateLimitApplyConfiguration) *APIPlanSpecApplyConfiguration {
+	b.RateLimit = value
+	return b
+}
+
+// WithQuota sets the Quota field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Quota field is set to the value of the last call.
+func (b *APIPlanSpecApplyConfiguration) WithQuota(value *QuotaApplyConfiguration) *APIPlanSpecApplyConfiguration {
+	b.Quota = value
+	return b
+}
+
+// WithTokenQuota sets the TokenQuota field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TokenQuota field is set to the value of the last call.
+func (b *APIPlanSpecApplyConfiguration) WithTokenQuota(value *TokenQuotaApplyConfiguration) *APIPlanSpecApplyConfiguration {
+	b.TokenQuota = value
+	return b
+}
@@ -0,0 +1,51 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// APIPortalAuthSpecApplyConfiguration represents an declarative configuration of the APIPortalAuthSpec type for use
+// with apply.
+type APIPortalAuthSpecApplyConfiguration struct {
+	OIDC *OIDCConfigApplyConfiguration           `json:"oidc,omitempty"`
+	LDAP *PortalAuthLDAPConfigApplyConfiguration `json:"ldap,omitempty"`
+}
+
+// APIPortalAuthSpecApplyConfiguration constructs an declarative configuration of the APIPortalAuthSpec type for use with
+// apply.
+func APIPortalAuthSpec() *APIPortalAuthSpecApplyConfiguration {
+	return &APIPortalAuthSpecApplyConfiguration{}
+}
+
+// WithOIDC sets the OIDC field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OIDC field is set to the value of the last call.
+func (b *APIPortalAuthSpecApplyConfiguration) WithOIDC(value *OIDCConfigApplyConfiguration) *APIPortalAuthSpecApplyConfiguration {
+	b.OIDC = value
+	return b
+}
+
+// WithLDAP sets the LDAP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LDAP field is set to the value of the last call.
+func (b *APIPortalAuthSpecApplyConfiguration) WithLDAP(value *PortalAuthLDAPConfigApplyConfiguration) *APIPortalAuthSpecApplyConfiguration {
+	b.LDAP = value
+	return b
+}
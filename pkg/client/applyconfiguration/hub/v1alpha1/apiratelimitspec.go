@@ -0,0 +1,108 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// APIRateLimitSpecApplyConfiguration represents an declarative configuration of the APIRateLimitSpec type for use
+// with apply.
+type APIRateLimitSpecApplyConfiguration struct {
+	Limit       *int                             `json:"limit,omitempty"`
+	Period      *v1alpha1.Period                 `json:"period,omitempty"`
+	Strategy    *v1alpha1.Strategy               `json:"strategy,omitempty"`
+	Groups      []string                         `json:"groups,omitempty"`
+	Everyone    *bool                            `json:"everyone,omitempty"`
+	APISelector *v1.LabelSelector                `json:"apiSelector,omitempty"`
+	APIs        []APIReferenceApplyConfiguration `json:"apis,omitempty"`
+}
+
+// APIRateLimitSpecApplyConfiguration constructs an declarative configuration of the APIRateLimitSpec type for use with
+// apply.
+func APIRateLimitSpec() *APIRateLimitSpecApplyConfiguration {
+	return &APIRateLimitSpecApplyConfiguration{}
+}
+
+// WithLimit sets the Limit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Limit field is set to the value of the last call.
+func (b *APIRateLimitSpecApplyConfiguration) WithLimit(value int) *APIRateLimitSpecApplyConfiguration {
+	b.Limit = &value
+	return b
+}
+
+// WithPeriod sets the Period field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Period field is set to the value of the last call.
+func (b *APIRateLimitSpecApplyConfiguration) WithPeriod(value v1alpha1.Period) *APIRateLimitSpecApplyConfiguration {
+	b.Period = &value
+	return b
+}
+
+// WithStrategy sets the Strategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Strategy field is set to the value of the last call.
+func (b *APIRateLimitSpecApplyConfiguration) WithStrategy(value v1alpha1.Strategy) *APIRateLimitSpecApplyConfiguration {
+	b.Strategy = &value
+	return b
+}
+
+// WithGroups adds the given value to the Groups field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Groups field.
+func (b *APIRateLimitSpecApplyConfiguration) WithGroups(values ...string) *APIRateLimitSpecApplyConfiguration {
+	for i := range values {
+		b.Groups = append(b.Groups, values[i])
+	}
+	return b
+}
+
+// WithEveryone sets the Everyone field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Everyone field is set to the value of the last call.
+func (b *APIRateLimitSpecApplyConfiguration) WithEveryone(value bool) *APIRateLimitSpecApplyConfiguration {
+	b.Everyone = &value
+	return b
+}
+
+// WithAPISelector sets the APISelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APISelector field is set to the value of the last call.
+func (b *APIRateLimitSpecApplyConfiguration) WithAPISelector(value v1.LabelSelector) *APIRateLimitSpecApplyConfiguration {
+	b.APISelector = &value
+	return b
+}
+
+// WithAPIs adds the given value to the APIs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the APIs field.
+func (b *APIRateLimitSpecApplyConfiguration) WithAPIs(values ...*APIReferenceApplyConfiguration) *APIRateLimitSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAPIs")
+		}
+		b.APIs = append(b.APIs, *values[i])
+	}
+	return b
+}
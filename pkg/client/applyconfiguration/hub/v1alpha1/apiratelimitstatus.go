@@ -0,0 +1,64 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// APIRateLimitStatusApplyConfiguration represents an declarative configuration of the APIRateLimitStatus type for use
+// with apply.
+type APIRateLimitStatusApplyConfiguration struct {
+	Version  *string  `json:"version,omitempty"`
+	SyncedAt *v1.Time `json:"syncedAt,omitempty"`
+	Hash     *string  `json:"hash,omitempty"`
+}
+
+// APIRateLimitStatusApplyConfiguration constructs an declarative configuration of the APIRateLimitStatus type for use with
+// apply.
+func APIRateLimitStatus() *APIRateLimitStatusApplyConfiguration {
+	return &APIRateLimitStatusApplyConfiguration{}
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *APIRateLimitStatusApplyConfiguration) WithVersion(value string) *APIRateLimitStatusApplyConfiguration {
+	b.Version = &value
+	return b
+}
+
+// WithSyncedAt sets the SyncedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SyncedAt field is set to the value of the last call.
+func (b *APIRateLimitStatusApplyConfiguration) WithSyncedAt(value v1.Time) *APIRateLimitStatusApplyConfiguration {
+	b.SyncedAt = &value
+	return b
+}
+
+// WithHash sets the Hash field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Hash field is set to the value of the last call.
+func (b *APIRateLimitStatusApplyConfiguration) WithHash(value string) *APIRateLimitStatusApplyConfiguration {
+	b.Hash = &value
+	return b
+}
@@ -0,0 +1,78 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// APIVersionSpecApplyConfiguration represents an declarative configuration of the APIVersionSpec type for use
+// with apply.
+type APIVersionSpecApplyConfiguration struct {
+	Title       *string                        `json:"title,omitempty"`
+	Description *string                        `json:"description,omitempty"`
+	Release     *string                        `json:"release,omitempty"`
+	OpenAPISpec *OpenAPISpecApplyConfiguration `json:"openApiSpec,omitempty"`
+	Cors        *CorsApplyConfiguration        `json:"cors,omitempty"`
+}
+
+// APIVersionSpecApplyConfiguration constructs an declarative configuration of the APIVersionSpec type for use with
+// apply.
+func APIVersionSpec() *APIVersionSpecApplyConfiguration {
+	return &APIVersionSpecApplyConfiguration{}
+}
+
+// WithTitle sets the Title field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Title field is set to the value of the last call.
+func (b *APIVersionSpecApplyConfiguration) WithTitle(value string) *APIVersionSpecApplyConfiguration {
+	b.Title = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *APIVersionSpecApplyConfiguration) WithDescription(value string) *APIVersionSpecApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithRelease sets the Release field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Release field is set to the value of the last call.
+func (b *APIVersionSpecApplyConfiguration) WithRelease(value string) *APIVersionSpecApplyConfiguration {
+	b.Release = &value
+	return b
+}
+
+// WithOpenAPISpec sets the OpenAPISpec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OpenAPISpec field is set to the value of the last call.
+func (b *APIVersionSpecApplyConfiguration) WithOpenAPISpec(value *OpenAPISpecApplyConfiguration) *APIVersionSpecApplyConfiguration {
+	b.OpenAPISpec = value
+	return b
+}
+
+// WithCors sets the Cors field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cors field is set to the value of the last call.
+func (b *APIVersionSpecApplyConfiguration) WithCors(value *CorsApplyConfiguration) *APIVersionSpecApplyConfiguration {
+	b.Cors = value
+	return b
+}
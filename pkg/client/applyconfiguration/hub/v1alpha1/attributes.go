@@ -0,0 +1,78 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AttributesApplyConfiguration represents an declarative configuration of the Attributes type for use
+// with apply.
+type AttributesApplyConfiguration struct {
+	UserID    *string `json:"userId,omitempty"`
+	Firstname *string `json:"firstname,omitempty"`
+	Lastname  *string `json:"lastname,omitempty"`
+	Email     *string `json:"email,omitempty"`
+	Company   *string `json:"company,omitempty"`
+}
+
+// AttributesApplyConfiguration constructs an declarative configuration of the Attributes type for use with
+// apply.
+func Attributes() *AttributesApplyConfiguration {
+	return &AttributesApplyConfiguration{}
+}
+
+// WithUserID sets the UserID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UserID field is set to the value of the last call.
+func (b *AttributesApplyConfiguration) WithUserID(value string) *AttributesApplyConfiguration {
+	b.UserID = &value
+	return b
+}
+
+// WithFirstname sets the Firstname field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Firstname field is set to the value of the last call.
+func (b *AttributesApplyConfiguration) WithFirstname(value string) *AttributesApplyConfiguration {
+	b.Firstname = &value
+	return b
+}
+
+// WithLastname sets the Lastname field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Lastname field is set to the value of the last call.
+func (b *AttributesApplyConfiguration) WithLastname(value string) *AttributesApplyConfiguration {
+	b.Lastname = &value
+	return b
+}
+
+// WithEmail sets the Email field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Email field is set to the value of the last call.
+func (b *AttributesApplyConfiguration) WithEmail(value string) *AttributesApplyConfiguration {
+	b.Email = &value
+	return b
+}
+
+// WithCompany sets the Company field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Company field is set to the value of the last call.
+func (b *AttributesApplyConfiguration) WithCompany(value string) *AttributesApplyConfiguration {
+	b.Company = &value
+	return b
+}
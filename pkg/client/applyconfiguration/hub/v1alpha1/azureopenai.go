@@ -0,0 +1,78 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AzureOpenAIApplyConfiguration represents an declarative configuration of the AzureOpenAI type for use
+// with apply.
+type AzureOpenAIApplyConfiguration struct {
+	APIKey         *SecretReferenceApplyConfiguration `json:"apiKeySecret,omitempty"`
+	Model          *string                            `json:"model,omitempty"`
+	DeploymentName *string                            `json:"deploymentName,omitempty"`
+	BaseURL        *string                            `json:"baseUrl,omitempty"`
+	Params         *ParamsApplyConfiguration          `json:"params,omitempty"`
+}
+
+// AzureOpenAIApplyConfiguration constructs an declarative configuration of the AzureOpenAI type for use with
+// apply.
+func AzureOpenAI() *AzureOpenAIApplyConfiguration {
+	return &AzureOpenAIApplyConfiguration{}
+}
+
+// WithAPIKey sets the APIKey field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIKey field is set to the value of the last call.
+func (b *AzureOpenAIApplyConfiguration) WithAPIKey(value *SecretReferenceApplyConfiguration) *AzureOpenAIApplyConfiguration {
+	b.APIKey = value
+	return b
+}
+
+// WithModel sets the Model field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Model field is set to the value of the last call.
+func (b *AzureOpenAIApplyConfiguration) WithModel(value string) *AzureOpenAIApplyConfiguration {
+	b.Model = &value
+	return b
+}
+
+// WithDeploymentName sets the DeploymentName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeploymentName field is set to the value of the last call.
+func (b *AzureOpenAIApplyConfiguration) WithDeploymentName(value string) *AzureOpenAIApplyConfiguration {
+	b.DeploymentName = &value
+	return b
+}
+
+// WithBaseURL sets the BaseURL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BaseURL field is set to the value of the last call.
+func (b *AzureOpenAIApplyConfiguration) WithBaseURL(value string) *AzureOpenAIApplyConfiguration {
+	b.BaseURL = &value
+	return b
+}
+
+// WithParams sets the Params field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Params field is set to the value of the last call.
+func (b *AzureOpenAIApplyConfiguration) WithParams(value *ParamsApplyConfiguration) *AzureOpenAIApplyConfiguration {
+	b.Params = value
+	return b
+}
@@ -0,0 +1,69 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// BedrockApplyConfiguration represents an declarative configuration of the Bedrock type for use
+// with apply.
+type BedrockApplyConfiguration struct {
+	Model         *string                   `json:"model,omitempty"`
+	Region        *string                   `json:"region,omitempty"`
+	SystemMessage *bool                     `json:"systemMessage,omitempty"`
+	Params        *ParamsApplyConfiguration `json:"params,omitempty"`
+}
+
+// BedrockApplyConfiguration constructs an declarative configuration of the Bedrock type for use with
+// apply.
+func Bedrock() *BedrockApplyConfiguration {
+	return &BedrockApplyConfiguration{}
+}
+
+// WithModel sets the Model field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Model field is set to the value of the last call.
+func (b *BedrockApplyConfiguration) WithModel(value string) *BedrockApplyConfiguration {
+	b.Model = &value
+	return b
+}
+
+// WithRegion sets the Region field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Region field is set to the value of the last call.
+func (b *BedrockApplyConfiguration) WithRegion(value string) *BedrockApplyConfiguration {
+	b.Region = &value
+	return b
+}
+
+// WithSystemMessage sets the SystemMessage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SystemMessage field is set to the value of the last call.
+func (b *BedrockApplyConfiguration) WithSystemMessage(value bool) *BedrockApplyConfiguration {
+	b.SystemMessage = &value
+	return b
+}
+
+// WithParams sets the Params field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Params field is set to the value of the last call.
+func (b *BedrockApplyConfiguration) WithParams(value *ParamsApplyConfiguration) *BedrockApplyConfiguration {
+	b.Params = value
+	return b
+}
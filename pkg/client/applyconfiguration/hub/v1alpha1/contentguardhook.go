@@ -0,0 +1,64 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+)
+
+// ContentGuardHookApplyConfiguration represents an declarative configuration of the ContentGuardHook type for use
+// with apply.
+type ContentGuardHookApplyConfiguration struct {
+	URL      *string          `json:"url,omitempty"`
+	Timeout  *v1alpha1.Period `json:"timeout,omitempty"`
+	FailOpen *bool            `json:"failOpen,omitempty"`
+}
+
+// ContentGuardHookApplyConfiguration constructs an declarative configuration of the ContentGuardHook type for use with
+// apply.
+func ContentGuardHook() *ContentGuardHookApplyConfiguration {
+	return &ContentGuardHookApplyConfiguration{}
+}
+
+// WithURL sets the URL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URL field is set to the value of the last call.
+func (b *ContentGuardHookApplyConfiguration) WithURL(value string) *ContentGuardHookApplyConfiguration {
+	b.URL = &value
+	return b
+}
+
+// WithTimeout sets the Timeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeout field is set to the value of the last call.
+func (b *ContentGuardHookApplyConfiguration) WithTimeout(value v1alpha1.Period) *ContentGuardHookApplyConfiguration {
+	b.Timeout = &value
+	return b
+}
+
+// WithFailOpen sets the FailOpen field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailOpen field is set to the value of the last call.
+func (b *ContentGuardHookApplyConfiguration) WithFailOpen(value bool) *ContentGuardHookApplyConfiguration {
+	b.FailOpen = &value
+	return b
+}
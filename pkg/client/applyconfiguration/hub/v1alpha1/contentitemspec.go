@@ -0,0 +1,78 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ContentItemSpecApplyConfiguration represents an declarative configuration of the ContentItemSpec type for use
+// with apply.
+type ContentItemSpecApplyConfiguration struct {
+	Title     *string                                 `json:"title,omitempty"`
+	Order     *int32                                  `json:"order,omitempty"`
+	ParentRef *ContentItemParentRefApplyConfiguration `json:"parentRef,omitempty"`
+	Link      *LinkDetailsApplyConfiguration          `json:"link,omitempty"`
+	Content   *string                                 `json:"content,omitempty"`
+}
+
+// ContentItemSpecApplyConfiguration constructs an declarative configuration of the ContentItemSpec type for use with
+// apply.
+func ContentItemSpec() *ContentItemSpecApplyConfiguration {
+	return &ContentItemSpecApplyConfiguration{}
+}
+
+// WithTitle sets the Title field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Title field is set to the value of the last call.
+func (b *ContentItemSpecApplyConfiguration) WithTitle(value string) *ContentItemSpecApplyConfiguration {
+	b.Title = &value
+	return b
+}
+
+// WithOrder sets the Order field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Order field is set to the value of the last call.
+func (b *ContentItemSpecApplyConfiguration) WithOrder(value int32) *ContentItemSpecApplyConfiguration {
+	b.Order = &value
+	return b
+}
+
+// WithParentRef sets the ParentRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ParentRef field is set to the value of the last call.
+func (b *ContentItemSpecApplyConfiguration) WithParentRef(value *ContentItemParentRefApplyConfiguration) *ContentItemSpecApplyConfiguration {
+	b.ParentRef = value
+	return b
+}
+
+// WithLink sets the Link field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Link field is set to the value of the last call.
+func (b *ContentItemSpecApplyConfiguration) WithLink(value *LinkDetailsApplyConfiguration) *ContentItemSpecApplyConfiguration {
+	b.Link = value
+	return b
+}
+
+// WithContent sets the Content field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Content field is set to the value of the last call.
+func (b *ContentItemSpecApplyConfiguration) WithContent(value string) *ContentItemSpecApplyConfiguration {
+	b.Content = &value
+	return b
+}
@@ -0,0 +1,69 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// DeepSeekApplyConfiguration represents an declarative configuration of the DeepSeek type for use
+// with apply.
+type DeepSeekApplyConfiguration struct {
+	Token   *SecretReferenceApplyConfiguration `json:"token,omitempty"`
+	Model   *string                            `json:"model,omitempty"`
+	BaseURL *string                            `json:"baseUrl,omitempty"`
+	Params  *ParamsApplyConfiguration          `json:"params,omitempty"`
+}
+
+// DeepSeekApplyConfiguration constructs an declarative configuration of the DeepSeek type for use with
+// apply.
+func DeepSeek() *DeepSeekApplyConfiguration {
+	return &DeepSeekApplyConfiguration{}
+}
+
+// WithToken sets the Token field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Token field is set to the value of the last call.
+func (b *DeepSeekApplyConfiguration) WithToken(value *SecretReferenceApplyConfiguration) *DeepSeekApplyConfiguration {
+	b.Token = value
+	return b
+}
+
+// WithModel sets the Model field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Model field is set to the value of the last call.
+func (b *DeepSeekApplyConfiguration) WithModel(value string) *DeepSeekApplyConfiguration {
+	b.Model = &value
+	return b
+}
+
+// WithBaseURL sets the BaseURL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BaseURL field is set to the value of the last call.
+func (b *DeepSeekApplyConfiguration) WithBaseURL(value string) *DeepSeekApplyConfiguration {
+	b.BaseURL = &value
+	return b
+}
+
+// WithParams sets the Params field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Params field is set to the value of the last call.
+func (b *DeepSeekApplyConfiguration) WithParams(value *ParamsApplyConfiguration) *DeepSeekApplyConfiguration {
+	b.Params = value
+	return b
+}
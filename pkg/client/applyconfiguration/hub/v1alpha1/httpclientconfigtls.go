@@ -0,0 +1,51 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// HTTPClientConfigTLSApplyConfiguration represents an declarative configuration of the HTTPClientConfigTLS type for use
+// with apply.
+type HTTPClientConfigTLSApplyConfiguration struct {
+	CA                 *string `json:"ca,omitempty"`
+	InsecureSkipVerify *bool   `json:"insecureSkipVerify,omitempty"`
+}
+
+// HTTPClientConfigTLSApplyConfiguration constructs an declarative configuration of the HTTPClientConfigTLS type for use with
+// apply.
+func HTTPClientConfigTLS() *HTTPClientConfigTLSApplyConfiguration {
+	return &HTTPClientConfigTLSApplyConfiguration{}
+}
+
+// WithCA sets the CA field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CA field is set to the value of the last call.
+func (b *HTTPClientConfigTLSApplyConfiguration) WithCA(value string) *HTTPClientConfigTLSApplyConfiguration {
+	b.CA = &value
+	return b
+}
+
+// WithInsecureSkipVerify sets the InsecureSkipVerify field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InsecureSkipVerify field is set to the value of the last call.
+func (b *HTTPClientConfigTLSApplyConfiguration) WithInsecureSkipVerify(value bool) *HTTPClientConfigTLSApplyConfiguration {
+	b.InsecureSkipVerify = &value
+	return b
+}
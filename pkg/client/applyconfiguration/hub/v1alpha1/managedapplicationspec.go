@@ -0,0 +1,74 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ManagedApplicationSpecApplyConfiguration represents an declarative configuration of the ManagedApplicationSpec type for use
+// with apply.
+type ManagedApplicationSpecApplyConfiguration struct {
+	AppID   *string                    `json:"appId,omitempty"`
+	Owner   *string                    `json:"owner,omitempty"`
+	Notes   *string                    `json:"notes,omitempty"`
+	APIKeys []APIKeyApplyConfiguration `json:"apiKeys,omitempty"`
+}
+
+// ManagedApplicationSpecApplyConfiguration constructs an declarative configuration of the ManagedApplicationSpec type for use with
+// apply.
+func ManagedApplicationSpec() *ManagedApplicationSpecApplyConfiguration {
+	return &ManagedApplicationSpecApplyConfiguration{}
+}
+
+// WithAppID sets the AppID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AppID field is set to the value of the last call.
+func (b *ManagedApplicationSpecApplyConfiguration) WithAppID(value string) *ManagedApplicationSpecApplyConfiguration {
+	b.AppID = &value
+	return b
+}
+
+// WithOwner sets the Owner field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Owner field is set to the value of the last call.
+func (b *ManagedApplicationSpecApplyConfiguration) WithOwner(value string) *ManagedApplicationSpecApplyConfiguration {
+	b.Owner = &value
+	return b
+}
+
+// WithNotes sets the Notes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Notes field is set to the value of the last call.
+func (b *ManagedApplicationSpecApplyConfiguration) WithNotes(value string) *ManagedApplicationSpecApplyConfiguration {
+	b.Notes = &value
+	return b
+}
+
+// WithAPIKeys adds the given value to the APIKeys field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the APIKeys field.
+func (b *ManagedApplicationSpecApplyConfiguration) WithAPIKeys(values ...*APIKeyApplyConfiguration) *ManagedApplicationSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAPIKeys")
+		}
+		b.APIKeys = append(b.APIKeys, *values[i])
+	}
+	return b
+}
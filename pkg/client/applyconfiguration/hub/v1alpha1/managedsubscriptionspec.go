@@ -0,0 +1,147 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ManagedSubscriptionSpecApplyConfiguration represents an declarative configuration of the ManagedSubscriptionSpec type for use
+// with apply.
+type ManagedSubscriptionSpecApplyConfiguration struct {
+	Applications               []ApplicationReferenceApplyConfiguration        `json:"applications,omitempty"`
+	ManagedApplications        []ManagedApplicationReferenceApplyConfiguration `json:"managedApplications,omitempty"`
+	ManagedApplicationSelector *v1.LabelSelector                               `json:"managedApplicationSelector,omitempty"`
+	APIBundles                 []APIBundleReferenceApplyConfiguration          `json:"apiBundles,omitempty"`
+	APISelector                *v1.LabelSelector                               `json:"apiSelector,omitempty"`
+	APIs                       []APIReferenceApplyConfiguration                `json:"apis,omitempty"`
+	OperationFilter            *OperationFilterApplyConfiguration              `json:"operationFilter,omitempty"`
+	APIPlan                    *APIPlanReferenceApplyConfiguration             `json:"apiPlan,omitempty"`
+	Weight                     *int                                            `json:"weight,omitempty"`
+	Claims                     *string                                         `json:"claims,omitempty"`
+}
+
+// ManagedSubscriptionSpecApplyConfiguration constructs an declarative configuration of the ManagedSubscriptionSpec type for use with
+// apply.
+func ManagedSubscriptionSpec() *ManagedSubscriptionSpecApplyConfiguration {
+	return &ManagedSubscriptionSpecApplyConfiguration{}
+}
+
+// WithApplications adds the given value to the Applications field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Applications field.
+func (b *ManagedSubscriptionSpecApplyConfiguration) WithApplications(values ...*ApplicationReferenceApplyConfiguration) *ManagedSubscriptionSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithApplications")
+		}
+		b.Applications = append(b.Applications, *values[i])
+	}
+	return b
+}
+
+// WithManagedApplications adds the given value to the ManagedApplications field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ManagedApplications field.
+func (b *ManagedSubscriptionSpecApplyConfiguration) WithManagedApplications(values ...*ManagedApplicationReferenceApplyConfiguration) *ManagedSubscriptionSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithManagedApplications")
+		}
+		b.ManagedApplications = append(b.ManagedApplications, *values[i])
+	}
+	return b
+}
+
+// WithManagedApplicationSelector sets the ManagedApplicationSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ManagedApplicationSelector field is set to the value of the last call.
+func (b *ManagedSubscriptionSpecApplyConfiguration) WithManagedApplicationSelector(value v1.LabelSelector) *ManagedSubscriptionSpecApplyConfiguration {
+	b.ManagedApplicationSelector = &value
+	return b
+}
+
+// WithAPIBundles adds the given value to the APIBundles field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the APIBundles field.
+func (b *ManagedSubscriptionSpecApplyConfiguration) WithAPIBundles(values ...*APIBundleReferenceApplyConfiguration) *ManagedSubscriptionSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAPIBundles")
+		}
+		b.APIBundles = append(b.APIBundles, *values[i])
+	}
+	return b
+}
+
+// WithAPISelector sets the APISelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APISelector field is set to the value of the last call.
+func (b *ManagedSubscriptionSpecApplyConfiguration) WithAPISelector(value v1.LabelSelector) *ManagedSubscriptionSpecApplyConfiguration {
+	b.APISelector = &value
+	return b
+}
+
+// WithAPIs adds the given value to the APIs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the APIs field.
+func (b *ManagedSubscriptionSpecApplyConfiguration) WithAPIs(values ...*APIReferenceApplyConfiguration) *ManagedSubscriptionSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAPIs")
+		}
+		b.APIs = append(b.APIs, *values[i])
+	}
+	return b
+}
+
+// WithOperationFilter sets the OperationFilter field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OperationFilter field is set to the value of the last call.
+func (b *ManagedSubscriptionSpecApplyConfiguration) WithOperationFilter(value *OperationFilterApplyConfiguration) *ManagedSubscriptionSpecApplyConfiguration {
+	b.OperationFilter = value
+	return b
+}
+
+// WithAPIPlan sets the APIPlan field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIPlan field is set to the value of the last call.
+func (b *ManagedSubscriptionSpecApplyConfiguration) WithAPIPlan(value *APIPlanReferenceApplyConfiguration) *ManagedSubscriptionSpecApplyConfiguration {
+	b.APIPlan = value
+	return b
+}
+
+// WithWeight sets the Weight field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Weight field is set to the value of the last call.
+func (b *ManagedSubscriptionSpecApplyConfiguration) WithWeight(value int) *ManagedSubscriptionSpecApplyConfiguration {
+	b.Weight = &value
+	return b
+}
+
+// WithClaims sets the Claims field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Claims field is set to the value of the last call.
+func (b *ManagedSubscriptionSpecApplyConfiguration) WithClaims(value string) *ManagedSubscriptionSpecApplyConfiguration {
+	b.Claims = &value
+	return b
+}
@@ -0,0 +1,131 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ManagedSubscriptionStatusApplyConfiguration represents an declarative configuration of the ManagedSubscriptionStatus type for use
+// with apply.
+type ManagedSubscriptionStatusApplyConfiguration struct {
+	Version                       *string                                                 `json:"version,omitempty"`
+	SyncedAt                      *v1.Time                                                `json:"syncedAt,omitempty"`
+	Hash                          *string                                                 `json:"hash,omitempty"`
+	Conditions                    []v1.Condition                                          `json:"conditions,omitempty"`
+	ResolvedAPIs                  []ResolvedAPIReferenceApplyConfiguration                `json:"resolvedApis,omitempty"`
+	UnresolvedAPIs                []ResolvedAPIReferenceApplyConfiguration                `json:"unresolvedApis,omitempty"`
+	ResolvedManagedApplications   []ResolvedManagedApplicationReferenceApplyConfiguration `json:"resolvedManagedApplications,omitempty"`
+	UnresolvedManagedApplications []ResolvedManagedApplicationReferenceApplyConfiguration `json:"unresolvedManagedApplications,omitempty"`
+}
+
+// ManagedSubscriptionStatusApplyConfiguration constructs an declarative configuration of the ManagedSubscriptionStatus type for use with
+// apply.
+func ManagedSubscriptionStatus() *ManagedSubscriptionStatusApplyConfiguration {
+	return &ManagedSubscriptionStatusApplyConfiguration{}
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *ManagedSubscriptionStatusApplyConfiguration) WithVersion(value string) *ManagedSubscriptionStatusApplyConfiguration {
+	b.Version = &value
+	return b
+}
+
+// WithSyncedAt sets the SyncedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SyncedAt field is set to the value of the last call.
+func (b *ManagedSubscriptionStatusApplyConfiguration) WithSyncedAt(value v1.Time) *ManagedSubscriptionStatusApplyConfiguration {
+	b.SyncedAt = &value
+	return b
+}
+
+// WithHash sets the Hash field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Hash field is set to the value of the last call.
+func (b *ManagedSubscriptionStatusApplyConfiguration) WithHash(value string) *ManagedSubscriptionStatusApplyConfiguration {
+	b.Hash = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *ManagedSubscriptionStatusApplyConfiguration) WithConditions(values ...v1.Condition) *ManagedSubscriptionStatusApplyConfiguration {
+	for i := range values {
+		b.Conditions = append(b.Conditions, values[i])
+	}
+	return b
+}
+
+// WithResolvedAPIs adds the given value to the ResolvedAPIs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ResolvedAPIs field.
+func (b *ManagedSubscriptionStatusApplyConfiguration) WithResolvedAPIs(values ...*ResolvedAPIReferenceApplyConfiguration) *ManagedSubscriptionStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithResolvedAPIs")
+		}
+		b.ResolvedAPIs = append(b.ResolvedAPIs, *values[i])
+	}
+	return b
+}
+
+// WithUnresolvedAPIs adds the given value to the UnresolvedAPIs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the UnresolvedAPIs field.
+func (b *ManagedSubscriptionStatusApplyConfiguration) WithUnresolvedAPIs(values ...*ResolvedAPIReferenceApplyConfiguration) *ManagedSubscriptionStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithUnresolvedAPIs")
+		}
+		b.UnresolvedAPIs = append(b.UnresolvedAPIs, *values[i])
+	}
+	return b
+}
+
+// WithResolvedManagedApplications adds the given value to the ResolvedManagedApplications field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ResolvedManagedApplications field.
+func (b *ManagedSubscriptionStatusApplyConfiguration) WithResolvedManagedApplications(values ...*ResolvedManagedApplicationReferenceApplyConfiguration) *ManagedSubscriptionStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithResolvedManagedApplications")
+		}
+		b.ResolvedManagedApplications = append(b.ResolvedManagedApplications, *values[i])
+	}
+	return b
+}
+
+// WithUnresolvedManagedApplications adds the given value to the UnresolvedManagedApplications field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the UnresolvedManagedApplications field.
+func (b *ManagedSubscriptionStatusApplyConfiguration) WithUnresolvedManagedApplications(values ...*ResolvedManagedApplicationReferenceApplyConfiguration) *ManagedSubscriptionStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithUnresolvedManagedApplications")
+		}
+		b.UnresolvedManagedApplications = append(b.UnresolvedManagedApplications, *values[i])
+	}
+	return b
+}
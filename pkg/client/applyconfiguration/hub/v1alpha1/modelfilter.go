@@ -0,0 +1,55 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ModelFilterApplyConfiguration represents an declarative configuration of the ModelFilter type for use
+// with apply.
+type ModelFilterApplyConfiguration struct {
+	Allow []string `json:"allow,omitempty"`
+	Deny  []string `json:"deny,omitempty"`
+}
+
+// ModelFilterApplyConfiguration constructs an declarative configuration of the ModelFilter type for use with
+// apply.
+func ModelFilter() *ModelFilterApplyConfiguration {
+	return &ModelFilterApplyConfiguration{}
+}
+
+// WithAllow adds the given value to the Allow field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Allow field.
+func (b *ModelFilterApplyConfiguration) WithAllow(values ...string) *ModelFilterApplyConfiguration {
+	for i := range values {
+		b.Allow = append(b.Allow, values[i])
+	}
+	return b
+}
+
+// WithDeny adds the given value to the Deny field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Deny field.
+func (b *ModelFilterApplyConfiguration) WithDeny(values ...string) *ModelFilterApplyConfiguration {
+	for i := range values {
+		b.Deny = append(b.Deny, values[i])
+	}
+	return b
+}
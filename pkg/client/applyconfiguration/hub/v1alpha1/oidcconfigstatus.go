@@ -0,0 +1,143 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// OIDCConfigStatusApplyConfiguration represents an declarative configuration of the OIDCConfigStatus type for use
+// with apply.
+type OIDCConfigStatusApplyConfiguration struct {
+	Generic          *bool    `json:"generic,omitempty"`
+	Issuer           *string  `json:"issuer,omitempty"`
+	ClientID         *string  `json:"clientId,omitempty"`
+	SecretName       *string  `json:"secretName,omitempty"`
+	Scopes           *string  `json:"scopes,omitempty"`
+	UserIDClaim      *string  `json:"userIdClaim,omitempty"`
+	FirstnameClaim   *string  `json:"firstnameClaim,omitempty"`
+	LastnameClaim    *string  `json:"lastnameClaim,omitempty"`
+	EmailClaim       *string  `json:"emailClaim,omitempty"`
+	GroupsClaim      *string  `json:"groupsClaim,omitempty"`
+	CompanyClaim     *string  `json:"companyClaim,omitempty"`
+	SyncedAttributes []string `json:"syncedAttributes,omitempty"`
+}
+
+// OIDCConfigStatusApplyConfiguration constructs an declarative configuration of the OIDCConfigStatus type for use with
+// apply.
+func OIDCConfigStatus() *OIDCConfigStatusApplyConfiguration {
+	return &OIDCConfigStatusApplyConfiguration{}
+}
+
+// WithGeneric sets the Generic field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Generic field is set to the value of the last call.
+func (b *OIDCConfigStatusApplyConfiguration) WithGeneric(value bool) *OIDCConfigStatusApplyConfiguration {
+	b.Generic = &value
+	return b
+}
+
+// WithIssuer sets the Issuer field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Issuer field is set to the value of the last call.
+func (b *OIDCConfigStatusApplyConfiguration) WithIssuer(value string) *OIDCConfigStatusApplyConfiguration {
+	b.Issuer = &value
+	return b
+}
+
+// WithClientID sets the ClientID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClientID field is set to the value of the last call.
+func (b *OIDCConfigStatusApplyConfiguration) WithClientID(value string) *OIDCConfigStatusApplyConfiguration {
+	b.ClientID = &value
+	return b
+}
+
+// WithSecretName sets the SecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretName field is set to the value of the last call.
+func (b *OIDCConfigStatusApplyConfiguration) WithSecretName(value string) *OIDCConfigStatusApplyConfiguration {
+	b.SecretName = &value
+	return b
+}
+
+// WithScopes sets the Scopes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Scopes field is set to the value of the last call.
+func (b *OIDCConfigStatusApplyConfiguration) WithScopes(value string) *OIDCConfigStatusApplyConfiguration {
+	b.Scopes = &value
+	return b
+}
+
+// WithUserIDClaim sets the UserIDClaim field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UserIDClaim field is set to the value of the last call.
+func (b *OIDCConfigStatusApplyConfiguration) WithUserIDClaim(value string) *OIDCConfigStatusApplyConfiguration {
+	b.UserIDClaim = &value
+	return b
+}
+
+// WithFirstnameClaim sets the FirstnameClaim field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FirstnameClaim field is set to the value of the last call.
+func (b *OIDCConfigStatusApplyConfiguration) WithFirstnameClaim(value string) *OIDCConfigStatusApplyConfiguration {
+	b.FirstnameClaim = &value
+	return b
+}
+
+// WithLastnameClaim sets the LastnameClaim field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastnameClaim field is set to the value of the last call.
+func (b *OIDCConfigStatusApplyConfiguration) WithLastnameClaim(value string) *OIDCConfigStatusApplyConfiguration {
+	b.LastnameClaim = &value
+	return b
+}
+
+// WithEmailClaim sets the EmailClaim field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EmailClaim field is set to the value of the last call.
+func (b *OIDCConfigStatusApplyConfiguration) WithEmailClaim(value string) *OIDCConfigStatusApplyConfiguration {
+	b.EmailClaim = &value
+	return b
+}
+
+// WithGroupsClaim sets the GroupsClaim field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GroupsClaim field is set to the value of the last call.
+func (b *OIDCConfigStatusApplyConfiguration) WithGroupsClaim(value string) *OIDCConfigStatusApplyConfiguration {
+	b.GroupsClaim = &value
+	return b
+}
+
+// WithCompanyClaim sets the CompanyClaim field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompanyClaim field is set to the value of the last call.
+func (b *OIDCConfigStatusApplyConfiguration) WithCompanyClaim(value string) *OIDCConfigStatusApplyConfiguration {
+	b.CompanyClaim = &value
+	return b
+}
+
+// WithSyncedAttributes adds the given value to the SyncedAttributes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SyncedAttributes field.
+func (b *OIDCConfigStatusApplyConfiguration) WithSyncedAttributes(values ...string) *OIDCConfigStatusApplyConfiguration {
+	for i := range values {
+		b.SyncedAttributes = append(b.SyncedAttributes, values[i])
+	}
+	return b
+}
@@ -0,0 +1,111 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// OpenAICompatibleApplyConfiguration represents an declarative configuration of the OpenAICompatible type for use
+// with apply.
+type OpenAICompatibleApplyConfiguration struct {
+	Provider   *string                            `json:"provider,omitempty"`
+	Token      *SecretReferenceApplyConfiguration `json:"token,omitempty"`
+	Model      *string                            `json:"model,omitempty"`
+	BaseURL    *string                            `json:"baseUrl,omitempty"`
+	AuthHeader *string                            `json:"authHeader,omitempty"`
+	AuthScheme *string                            `json:"authScheme,omitempty"`
+	Headers    map[string]string                  `json:"headers,omitempty"`
+	Params     *ParamsApplyConfiguration          `json:"params,omitempty"`
+}
+
+// OpenAICompatibleApplyConfiguration constructs an declarative configuration of the OpenAICompatible type for use with
+// apply.
+func OpenAICompatible() *OpenAICompatibleApplyConfiguration {
+	return &OpenAICompatibleApplyConfiguration{}
+}
+
+// WithProvider sets the Provider field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Provider field is set to the value of the last call.
+func (b *OpenAICompatibleApplyConfiguration) WithProvider(value string) *OpenAICompatibleApplyConfiguration {
+	b.Provider = &value
+	return b
+}
+
+// WithToken sets the Token field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Token field is set to the value of the last call.
+func (b *OpenAICompatibleApplyConfiguration) WithToken(value *SecretReferenceApplyConfiguration) *OpenAICompatibleApplyConfiguration {
+	b.Token = value
+	return b
+}
+
+// WithModel sets the Model field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Model field is set to the value of the last call.
+func (b *OpenAICompatibleApplyConfiguration) WithModel(value string) *OpenAICompatibleApplyConfiguration {
+	b.Model = &value
+	return b
+}
+
+// WithBaseURL sets the BaseURL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BaseURL field is set to the value of the last call.
+func (b *OpenAICompatibleApplyConfiguration) WithBaseURL(value string) *OpenAICompatibleApplyConfiguration {
+	b.BaseURL = &value
+	return b
+}
+
+// WithAuthHeader sets the AuthHeader field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AuthHeader field is set to the value of the last call.
+func (b *OpenAICompatibleApplyConfiguration) WithAuthHeader(value string) *OpenAICompatibleApplyConfiguration {
+	b.AuthHeader = &value
+	return b
+}
+
+// WithAuthScheme sets the AuthScheme field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AuthScheme field is set to the value of the last call.
+func (b *OpenAICompatibleApplyConfiguration) WithAuthScheme(value string) *OpenAICompatibleApplyConfiguration {
+	b.AuthScheme = &value
+	return b
+}
+
+// WithHeaders puts the entries into the Headers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Headers field,
+// overwriting an existing map entries in Headers field with the same key.
+func (b *OpenAICompatibleApplyConfiguration) WithHeaders(entries map[string]string) *OpenAICompatibleApplyConfiguration {
+	if b.Headers == nil && len(entries) > 0 {
+		b.Headers = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Headers[k] = v
+	}
+	return b
+}
+
+// WithParams sets the Params field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Params field is set to the value of the last call.
+func (b *OpenAICompatibleApplyConfiguration) WithParams(value *ParamsApplyConfiguration) *OpenAICompatibleApplyConfiguration {
+	b.Params = value
+	return b
+}
@@ -0,0 +1,56 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// OperationSetApplyConfiguration represents an declarative configuration of the OperationSet type for use
+// with apply.
+type OperationSetApplyConfiguration struct {
+	Name     *string                              `json:"name,omitempty"`
+	Matchers []OperationMatcherApplyConfiguration `json:"matchers,omitempty"`
+}
+
+// OperationSetApplyConfiguration constructs an declarative configuration of the OperationSet type for use with
+// apply.
+func OperationSet() *OperationSetApplyConfiguration {
+	return &OperationSetApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *OperationSetApplyConfiguration) WithName(value string) *OperationSetApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithMatchers adds the given value to the Matchers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Matchers field.
+func (b *OperationSetApplyConfiguration) WithMatchers(values ...*OperationMatcherApplyConfiguration) *OperationSetApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMatchers")
+		}
+		b.Matchers = append(b.Matchers, *values[i])
+	}
+	return b
+}
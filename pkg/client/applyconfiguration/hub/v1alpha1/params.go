@@ -0,0 +1,78 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ParamsApplyConfiguration represents an declarative configuration of the Params type for use
+// with apply.
+type ParamsApplyConfiguration struct {
+	Temperature      *float32 `json:"temperature,omitempty"`
+	TopP             *float32 `json:"topP,omitempty"`
+	MaxTokens        *int     `json:"maxTokens,omitempty"`
+	FrequencyPenalty *float32 `json:"frequencyPenalty,omitempty"`
+	PresencePenalty  *float32 `json:"presencePenalty,omitempty"`
+}
+
+// ParamsApplyConfiguration constructs an declarative configuration of the Params type for use with
+// apply.
+func Params() *ParamsApplyConfiguration {
+	return &ParamsApplyConfiguration{}
+}
+
+// WithTemperature sets the Temperature field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Temperature field is set to the value of the last call.
+func (b *ParamsApplyConfiguration) WithTemperature(value float32) *ParamsApplyConfiguration {
+	b.Temperature = &value
+	return b
+}
+
+// WithTopP sets the TopP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TopP field is set to the value of the last call.
+func (b *ParamsApplyConfiguration) WithTopP(value float32) *ParamsApplyConfiguration {
+	b.TopP = &value
+	return b
+}
+
+// WithMaxTokens sets the MaxTokens field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxTokens field is set to the value of the last call.
+func (b *ParamsApplyConfiguration) WithMaxTokens(value int) *ParamsApplyConfiguration {
+	b.MaxTokens = &value
+	return b
+}
+
+// WithFrequencyPenalty sets the FrequencyPenalty field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FrequencyPenalty field is set to the value of the last call.
+func (b *ParamsApplyConfiguration) WithFrequencyPenalty(value float32) *ParamsApplyConfiguration {
+	b.FrequencyPenalty = &value
+	return b
+}
+
+// WithPresencePenalty sets the PresencePenalty field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PresencePenalty field is set to the value of the last call.
+func (b *ParamsApplyConfiguration) WithPresencePenalty(value float32) *ParamsApplyConfiguration {
+	b.PresencePenalty = &value
+	return b
+}
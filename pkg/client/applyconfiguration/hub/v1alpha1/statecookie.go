@@ -0,0 +1,69 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// StateCookieApplyConfiguration represents an declarative configuration of the StateCookie type for use
+// with apply.
+type StateCookieApplyConfiguration struct {
+	SameSite *string `json:"sameSite,omitempty"`
+	Secure   *bool   `json:"secure,omitempty"`
+	Domain   *string `json:"domain,omitempty"`
+	Path     *string `json:"path,omitempty"`
+}
+
+// StateCookieApplyConfiguration constructs an declarative configuration of the StateCookie type for use with
+// apply.
+func StateCookie() *StateCookieApplyConfiguration {
+	return &StateCookieApplyConfiguration{}
+}
+
+// WithSameSite sets the SameSite field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SameSite field is set to the value of the last call.
+func (b *StateCookieApplyConfiguration) WithSameSite(value string) *StateCookieApplyConfiguration {
+	b.SameSite = &value
+	return b
+}
+
+// WithSecure sets the Secure field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Secure field is set to the value of the last call.
+func (b *StateCookieApplyConfiguration) WithSecure(value bool) *StateCookieApplyConfiguration {
+	b.Secure = &value
+	return b
+}
+
+// WithDomain sets the Domain field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Domain field is set to the value of the last call.
+func (b *StateCookieApplyConfiguration) WithDomain(value string) *StateCookieApplyConfiguration {
+	b.Domain = &value
+	return b
+}
+
+// WithPath sets the Path field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Path field is set to the value of the last call.
+func (b *StateCookieApplyConfiguration) WithPath(value string) *StateCookieApplyConfiguration {
+	b.Path = &value
+	return b
+}
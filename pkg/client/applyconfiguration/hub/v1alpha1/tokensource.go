@@ -0,0 +1,69 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// TokenSourceApplyConfiguration represents an declarative configuration of the TokenSource type for use
+// with apply.
+type TokenSourceApplyConfiguration struct {
+	Header           *string `json:"header,omitempty"`
+	HeaderAuthScheme *string `json:"headerAuthScheme,omitempty"`
+	Query            *string `json:"query,omitempty"`
+	Cookie           *string `json:"cookie,omitempty"`
+}
+
+// TokenSourceApplyConfiguration constructs an declarative configuration of the TokenSource type for use with
+// apply.
+func TokenSource() *TokenSourceApplyConfiguration {
+	return &TokenSourceApplyConfiguration{}
+}
+
+// WithHeader sets the Header field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Header field is set to the value of the last call.
+func (b *TokenSourceApplyConfiguration) WithHeader(value string) *TokenSourceApplyConfiguration {
+	b.Header = &value
+	return b
+}
+
+// WithHeaderAuthScheme sets the HeaderAuthScheme field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HeaderAuthScheme field is set to the value of the last call.
+func (b *TokenSourceApplyConfiguration) WithHeaderAuthScheme(value string) *TokenSourceApplyConfiguration {
+	b.HeaderAuthScheme = &value
+	return b
+}
+
+// WithQuery sets the Query field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Query field is set to the value of the last call.
+func (b *TokenSourceApplyConfiguration) WithQuery(value string) *TokenSourceApplyConfiguration {
+	b.Query = &value
+	return b
+}
+
+// WithCookie sets the Cookie field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cookie field is set to the value of the last call.
+func (b *TokenSourceApplyConfiguration) WithCookie(value string) *TokenSourceApplyConfiguration {
+	b.Cookie = &value
+	return b
+}
@@ -0,0 +1,69 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// APIAuthSpecApplyConfiguration represents an declarative configuration of the APIAuthSpec type for use
+// with apply.
+type APIAuthSpecApplyConfiguration struct {
+	IsDefault *bool                                   `json:"isDefault,omitempty"`
+	APIKey    *APIKeyAuthSpecApplyConfiguration       `json:"apiKey,omitempty"`
+	JWT       *JWTAuthSpecApplyConfiguration          `json:"jwt,omitempty"`
+	LDAP      *LDAPConnectionConfigApplyConfiguration `json:"ldap,omitempty"`
+}
+
+// APIAuthSpecApplyConfiguration constructs an declarative configuration of the APIAuthSpec type for use with
+// apply.
+func APIAuthSpec() *APIAuthSpecApplyConfiguration {
+	return &APIAuthSpecApplyConfiguration{}
+}
+
+// WithIsDefault sets the IsDefault field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IsDefault field is set to the value of the last call.
+func (b *APIAuthSpecApplyConfiguration) WithIsDefault(value bool) *APIAuthSpecApplyConfiguration {
+	b.IsDefault = &value
+	return b
+}
+
+// WithAPIKey sets the APIKey field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIKey field is set to the value of the last call.
+func (b *APIAuthSpecApplyConfiguration) WithAPIKey(value *APIKeyAuthSpecApplyConfiguration) *APIAuthSpecApplyConfiguration {
+	b.APIKey = value
+	return b
+}
+
+// WithJWT sets the JWT field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JWT field is set to the value of the last call.
+func (b *APIAuthSpecApplyConfiguration) WithJWT(value *JWTAuthSpecApplyConfiguration) *APIAuthSpecApplyConfiguration {
+	b.JWT = value
+	return b
+}
+
+// WithLDAP sets the LDAP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LDAP field is set to the value of the last call.
+func (b *APIAuthSpecApplyConfiguration) WithLDAP(value *LDAPConnectionConfigApplyConfiguration) *APIAuthSpecApplyConfiguration {
+	b.LDAP = value
+	return b
+}
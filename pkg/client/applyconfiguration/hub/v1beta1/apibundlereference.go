@@ -0,0 +1,42 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// APIBundleReferenceApplyConfiguration represents an declarative configuration of the APIBundleReference type for use
+// with apply.
+type APIBundleReferenceApplyConfiguration struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// APIBundleReferenceApplyConfiguration constructs an declarative configuration of the APIBundleReference type for use with
+// apply.
+func APIBundleReference() *APIBundleReferenceApplyConfiguration {
+	return &APIBundleReferenceApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *APIBundleReferenceApplyConfiguration) WithName(value string) *APIBundleReferenceApplyConfiguration {
+	b.Name = &value
+	return b
+}
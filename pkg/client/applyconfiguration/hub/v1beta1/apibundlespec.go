@@ -0,0 +1,69 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// APIBundleSpecApplyConfiguration represents an declarative configuration of the APIBundleSpec type for use
+// with apply.
+type APIBundleSpecApplyConfiguration struct {
+	Title       *string                          `json:"title,omitempty"`
+	APISelector *v1.LabelSelector                `json:"apiSelector,omitempty"`
+	APIs        []APIReferenceApplyConfiguration `json:"apis,omitempty"`
+}
+
+// APIBundleSpecApplyConfiguration constructs an declarative configuration of the APIBundleSpec type for use with
+// apply.
+func APIBundleSpec() *APIBundleSpecApplyConfiguration {
+	return &APIBundleSpecApplyConfiguration{}
+}
+
+// WithTitle sets the Title field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Title field is set to the value of the last call.
+func (b *APIBundleSpecApplyConfiguration) WithTitle(value string) *APIBundleSpecApplyConfiguration {
+	b.Title = &value
+	return b
+}
+
+// WithAPISelector sets the APISelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APISelector field is set to the value of the last call.
+func (b *APIBundleSpecApplyConfiguration) WithAPISelector(value v1.LabelSelector) *APIBundleSpecApplyConfiguration {
+	b.APISelector = &value
+	return b
+}
+
+// WithAPIs adds the given value to the APIs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the APIs field.
+func (b *APIBundleSpecApplyConfiguration) WithAPIs(values ...*APIReferenceApplyConfiguration) *APIBundleSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAPIs")
+		}
+		b.APIs = append(b.APIs, *values[i])
+	}
+	return b
+}
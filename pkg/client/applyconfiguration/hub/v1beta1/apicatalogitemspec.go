@@ -0,0 +1,112 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// APICatalogItemSpecApplyConfiguration represents an declarative configuration of the APICatalogItemSpec type for use
+// with apply.
+type APICatalogItemSpecApplyConfiguration struct {
+	Groups          []string                               `json:"groups,omitempty"`
+	Everyone        *bool                                  `json:"everyone,omitempty"`
+	APIBundles      []APIBundleReferenceApplyConfiguration `json:"apiBundles,omitempty"`
+	APISelector     *v1.LabelSelector                      `json:"apiSelector,omitempty"`
+	APIs            []APIReferenceApplyConfiguration       `json:"apis,omitempty"`
+	OperationFilter *OperationFilterApplyConfiguration     `json:"operationFilter,omitempty"`
+	APIPlan         *APIPlanReferenceApplyConfiguration    `json:"apiPlan,omitempty"`
+}
+
+// APICatalogItemSpecApplyConfiguration constructs an declarative configuration of the APICatalogItemSpec type for use with
+// apply.
+func APICatalogItemSpec() *APICatalogItemSpecApplyConfiguration {
+	return &APICatalogItemSpecApplyConfiguration{}
+}
+
+// WithGroups adds the given value to the Groups field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Groups field.
+func (b *APICatalogItemSpecApplyConfiguration) WithGroups(values ...string) *APICatalogItemSpecApplyConfiguration {
+	for i := range values {
+		b.Groups = append(b.Groups, values[i])
+	}
+	return b
+}
+
+// WithEveryone sets the Everyone field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Everyone field is set to the value of the last call.
+func (b *APICatalogItemSpecApplyConfiguration) WithEveryone(value bool) *APICatalogItemSpecApplyConfiguration {
+	b.Everyone = &value
+	return b
+}
+
+// WithAPIBundles adds the given value to the APIBundles field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the APIBundles field.
+func (b *APICatalogItemSpecApplyConfiguration) WithAPIBundles(values ...*APIBundleReferenceApplyConfiguration) *APICatalogItemSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAPIBundles")
+		}
+		b.APIBundles = append(b.APIBundles, *values[i])
+	}
+	return b
+}
+
+// WithAPISelector sets the APISelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APISelector field is set to the value of the last call.
+func (b *APICatalogItemSpecApplyConfiguration) WithAPISelector(value v1.LabelSelector) *APICatalogItemSpecApplyConfiguration {
+	b.APISelector = &value
+	return b
+}
+
+// WithAPIs adds the given value to the APIs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the APIs field.
+func (b *APICatalogItemSpecApplyConfiguration) WithAPIs(values ...*APIReferenceApplyConfiguration) *APICatalogItemSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAPIs")
+		}
+		b.APIs = append(b.APIs, *values[i])
+	}
+	return b
+}
+
+// WithOperationFilter sets the OperationFilter field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OperationFilter field is set to the value of the last call.
+func (b *APICatalogItemSpecApplyConfiguration) WithOperationFilter(value *OperationFilterApplyConfiguration) *APICatalogItemSpecApplyConfiguration {
+	b.OperationFilter = value
+	return b
+}
+
+// WithAPIPlan sets the APIPlan field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIPlan field is set to the value of the last call.
+func (b *APICatalogItemSpecApplyConfiguration) WithAPIPlan(value *APIPlanReferenceApplyConfiguration) *APICatalogItemSpecApplyConfiguration {
+	b.APIPlan = value
+	return b
+}
@@ -0,0 +1,80 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// APIPortalSpecApplyConfiguration represents an declarative configuration of the APIPortalSpec type for use
+// with apply.
+type APIPortalSpecApplyConfiguration struct {
+	Title       *string                                   `json:"title,omitempty"`
+	Description *string                                   `json:"description,omitempty"`
+	TrustedURLs []string                                  `json:"trustedUrls,omitempty"`
+	UI          *UISpecApplyConfiguration                 `json:"ui,omitempty"`
+	Auth        *APIPortalAuthReferenceApplyConfiguration `json:"auth,omitempty"`
+}
+
+// APIPortalSpecApplyConfiguration constructs an declarative configuration of the APIPortalSpec type for use with
+// apply.
+func APIPortalSpec() *APIPortalSpecApplyConfiguration {
+	return &APIPortalSpecApplyConfiguration{}
+}
+
+// WithTitle sets the Title field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Title field is set to the value of the last call.
+func (b *APIPortalSpecApplyConfiguration) WithTitle(value string) *APIPortalSpecApplyConfiguration {
+	b.Title = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *APIPortalSpecApplyConfiguration) WithDescription(value string) *APIPortalSpecApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithTrustedURLs adds the given value to the TrustedURLs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TrustedURLs field.
+func (b *APIPortalSpecApplyConfiguration) WithTrustedURLs(values ...string) *APIPortalSpecApplyConfiguration {
+	for i := range values {
+		b.TrustedURLs = append(b.TrustedURLs, values[i])
+	}
+	return b
+}
+
+// WithUI sets the UI field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UI field is set to the value of the last call.
+func (b *APIPortalSpecApplyConfiguration) WithUI(value *UISpecApplyConfiguration) *APIPortalSpecApplyConfiguration {
+	b.UI = value
+	return b
+}
+
+// WithAuth sets the Auth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Auth field is set to the value of the last call.
+func (b *APIPortalSpecApplyConfiguration) WithAuth(value *APIPortalAuthReferenceApplyConfiguration) *APIPortalSpecApplyConfiguration {
+	b.Auth = value
+	return b
+}
@@ -0,0 +1,83 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// APISpecApplyConfiguration represents an declarative configuration of the APISpec type for use
+// with apply.
+type APISpecApplyConfiguration struct {
+	Title       *string                           `json:"title,omitempty"`
+	Description *string                           `json:"description,omitempty"`
+	OpenAPISpec *OpenAPISpecApplyConfiguration    `json:"openApiSpec,omitempty"`
+	Versions    []APIVersionRefApplyConfiguration `json:"versions,omitempty"`
+	Cors        *CorsApplyConfiguration           `json:"cors,omitempty"`
+}
+
+// APISpecApplyConfiguration constructs an declarative configuration of the APISpec type for use with
+// apply.
+func APISpec() *APISpecApplyConfiguration {
+	return &APISpecApplyConfiguration{}
+}
+
+// WithTitle sets the Title field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Title field is set to the value of the last call.
+func (b *APISpecApplyConfiguration) WithTitle(value string) *APISpecApplyConfiguration {
+	b.Title = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *APISpecApplyConfiguration) WithDescription(value string) *APISpecApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithOpenAPISpec sets the OpenAPISpec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OpenAPISpec field is set to the value of the last call.
+func (b *APISpecApplyConfiguration) WithOpenAPISpec(value *OpenAPISpecApplyConfiguration) *APISpecApplyConfiguration {
+	b.OpenAPISpec = value
+	return b
+}
+
+// WithVersions adds the given value to the Versions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Versions field.
+func (b *APISpecApplyConfiguration) WithVersions(values ...*APIVersionRefApplyConfiguration) *APISpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithVersions")
+		}
+		b.Versions = append(b.Versions, *values[i])
+	}
+	return b
+}
+
+// WithCors sets the Cors field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cors field is set to the value of the last call.
+func (b *APISpecApplyConfiguration) WithCors(value *CorsApplyConfiguration) *APISpecApplyConfiguration {
+	b.Cors = value
+	return b
+}
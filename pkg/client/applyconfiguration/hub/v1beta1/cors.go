@@ -0,0 +1,115 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// CorsApplyConfiguration represents an declarative configuration of the Cors type for use
+// with apply.
+type CorsApplyConfiguration struct {
+	AllowOriginsList     []string `json:"allowOriginsList,omitempty"`
+	AllowOriginListRegex []string `json:"allowOriginListRegex,omitempty"`
+	AllowMethodsList     []string `json:"allowMethodsList,omitempty"`
+	AllowHeadersList     []string `json:"allowHeadersList,omitempty"`
+	ExposeHeadersList    []string `json:"exposeHeadersList,omitempty"`
+	MaxAge               *int64   `json:"maxAge,omitempty"`
+	AddVaryHeader        *bool    `json:"addVaryHeader,omitempty"`
+	AllowCredentials     *bool    `json:"allowCredentials,omitempty"`
+}
+
+// CorsApplyConfiguration constructs an declarative configuration of the Cors type for use with
+// apply.
+func Cors() *CorsApplyConfiguration {
+	return &CorsApplyConfiguration{}
+}
+
+// WithAllowOriginsList adds the given value to the AllowOriginsList field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AllowOriginsList field.
+func (b *CorsApplyConfiguration) WithAllowOriginsList(values ...string) *CorsApplyConfiguration {
+	for i := range values {
+		b.AllowOriginsList = append(b.AllowOriginsList, values[i])
+	}
+	return b
+}
+
+// WithAllowOriginListRegex adds the given value to the AllowOriginListRegex field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AllowOriginListRegex field.
+func (b *CorsApplyConfiguration) WithAllowOriginListRegex(values ...string) *CorsApplyConfiguration {
+	for i := range values {
+		b.AllowOriginListRegex = append(b.AllowOriginListRegex, values[i])
+	}
+	return b
+}
+
+// WithAllowMethodsList adds the given value to the AllowMethodsList field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AllowMethodsList field.
+func (b *CorsApplyConfiguration) WithAllowMethodsList(values ...string) *CorsApplyConfiguration {
+	for i := range values {
+		b.AllowMethodsList = append(b.AllowMethodsList, values[i])
+	}
+	return b
+}
+
+// WithAllowHeadersList adds the given value to the AllowHeadersList field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AllowHeadersList field.
+func (b *CorsApplyConfiguration) WithAllowHeadersList(values ...string) *CorsApplyConfiguration {
+	for i := range values {
+		b.AllowHeadersList = append(b.AllowHeadersList, values[i])
+	}
+	return b
+}
+
+// WithExposeHeadersList adds the given value to the ExposeHeadersList field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ExposeHeadersList field.
+func (b *CorsApplyConfiguration) WithExposeHeadersList(values ...string) *CorsApplyConfiguration {
+	for i := range values {
+		b.ExposeHeadersList = append(b.ExposeHeadersList, values[i])
+	}
+	return b
+}
+
+// WithMaxAge sets the MaxAge field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxAge field is set to the value of the last call.
+func (b *CorsApplyConfiguration) WithMaxAge(value int64) *CorsApplyConfiguration {
+	b.MaxAge = &value
+	return b
+}
+
+// WithAddVaryHeader sets the AddVaryHeader field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AddVaryHeader field is set to the value of the last call.
+func (b *CorsApplyConfiguration) WithAddVaryHeader(value bool) *CorsApplyConfiguration {
+	b.AddVaryHeader = &value
+	return b
+}
+
+// WithAllowCredentials sets the AllowCredentials field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AllowCredentials field is set to the value of the last call.
+func (b *CorsApplyConfiguration) WithAllowCredentials(value bool) *CorsApplyConfiguration {
+	b.AllowCredentials = &value
+	return b
+}
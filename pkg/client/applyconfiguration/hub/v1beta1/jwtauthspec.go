@@ -0,0 +1,134 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// JWTAuthSpecApplyConfiguration represents an declarative configuration of the JWTAuthSpec type for use
+// with apply.
+type JWTAuthSpecApplyConfiguration struct {
+	StripAuthorizationHeader *bool                               `json:"stripAuthorizationHeader,omitempty"`
+	TokenQueryKey            *string                             `json:"tokenQueryKey,omitempty"`
+	AppIDClaim               *string                             `json:"appIdClaim,omitempty"`
+	TokenNameClaim           *string                             `json:"tokenNameClaim,omitempty"`
+	ForwardHeaders           map[string]string                   `json:"forwardHeaders,omitempty"`
+	SigningSecretName        *string                             `json:"signingSecretName,omitempty"`
+	PublicKey                *string                             `json:"publicKey,omitempty"`
+	JWKSFile                 *string                             `json:"jwksFile,omitempty"`
+	TrustedIssuers           []TrustedIssuerApplyConfiguration   `json:"trustedIssuers,omitempty"`
+	ClientConfig             *HTTPClientConfigApplyConfiguration `json:"clientConfig,omitempty"`
+}
+
+// JWTAuthSpecApplyConfiguration constructs an declarative configuration of the JWTAuthSpec type for use with
+// apply.
+func JWTAuthSpec() *JWTAuthSpecApplyConfiguration {
+	return &JWTAuthSpecApplyConfiguration{}
+}
+
+// WithStripAuthorizationHeader sets the StripAuthorizationHeader field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StripAuthorizationHeader field is set to the value of the last call.
+func (b *JWTAuthSpecApplyConfiguration) WithStripAuthorizationHeader(value bool) *JWTAuthSpecApplyConfiguration {
+	b.StripAuthorizationHeader = &value
+	return b
+}
+
+// WithTokenQueryKey sets the TokenQueryKey field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TokenQueryKey field is set to the value of the last call.
+func (b *JWTAuthSpecApplyConfiguration) WithTokenQueryKey(value string) *JWTAuthSpecApplyConfiguration {
+	b.TokenQueryKey = &value
+	return b
+}
+
+// WithAppIDClaim sets the AppIDClaim field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AppIDClaim field is set to the value of the last call.
+func (b *JWTAuthSpecApplyConfiguration) WithAppIDClaim(value string) *JWTAuthSpecApplyConfiguration {
+	b.AppIDClaim = &value
+	return b
+}
+
+// WithTokenNameClaim sets the TokenNameClaim field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TokenNameClaim field is set to the value of the last call.
+func (b *JWTAuthSpecApplyConfiguration) WithTokenNameClaim(value string) *JWTAuthSpecApplyConfiguration {
+	b.TokenNameClaim = &value
+	return b
+}
+
+// WithForwardHeaders puts the entries into the ForwardHeaders field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ForwardHeaders field,
+// overwriting an existing map entries in ForwardHeaders field with the same key.
+func (b *JWTAuthSpecApplyConfiguration) WithForwardHeaders(entries map[string]string) *JWTAuthSpecApplyConfiguration {
+	if b.ForwardHeaders == nil && len(entries) > 0 {
+		b.ForwardHeaders = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ForwardHeaders[k] = v
+	}
+	return b
+}
+
+// WithSigningSecretName sets the SigningSecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SigningSecretName field is set to the value of the last call.
+func (b *JWTAuthSpecApplyConfiguration) WithSigningSecretName(value string) *JWTAuthSpecApplyConfiguration {
+	b.SigningSecretName = &value
+	return b
+}
+
+// WithPublicKey sets the PublicKey field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PublicKey field is set to the value of the last call.
+func (b *JWTAuthSpecApplyConfiguration) WithPublicKey(value string) *JWTAuthSpecApplyConfiguration {
+	b.PublicKey = &value
+	return b
+}
+
+// WithJWKSFile sets the JWKSFile field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JWKSFile field is set to the value of the last call.
+func (b *JWTAuthSpecApplyConfiguration) WithJWKSFile(value string) *JWTAuthSpecApplyConfiguration {
+	b.JWKSFile = &value
+	return b
+}
+
+// WithTrustedIssuers adds the given value to the TrustedIssuers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TrustedIssuers field.
+func (b *JWTAuthSpecApplyConfiguration) WithTrustedIssuers(values ...*TrustedIssuerApplyConfiguration) *JWTAuthSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTrustedIssuers")
+		}
+		b.TrustedIssuers = append(b.TrustedIssuers, *values[i])
+	}
+	return b
+}
+
+// WithClientConfig sets the ClientConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClientConfig field is set to the value of the last call.
+func (b *JWTAuthSpecApplyConfiguration) WithClientConfig(value *HTTPClientConfigApplyConfiguration) *JWTAuthSpecApplyConfiguration {
+	b.ClientConfig = value
+	return b
+}
@@ -0,0 +1,89 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ManagedApplicationStatusApplyConfiguration represents an declarative configuration of the ManagedApplicationStatus type for use
+// with apply.
+type ManagedApplicationStatusApplyConfiguration struct {
+	Version        *string                           `json:"version,omitempty"`
+	APIKeyVersions []APIKeyVersionApplyConfiguration `json:"apiKeyVersions,omitempty"`
+	SyncedAt       *v1.Time                          `json:"syncedAt,omitempty"`
+	Hash           *string                           `json:"hash,omitempty"`
+	Conditions     []v1.Condition                    `json:"conditions,omitempty"`
+}
+
+// ManagedApplicationStatusApplyConfiguration constructs an declarative configuration of the ManagedApplicationStatus type for use with
+// apply.
+func ManagedApplicationStatus() *ManagedApplicationStatusApplyConfiguration {
+	return &ManagedApplicationStatusApplyConfiguration{}
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *ManagedApplicationStatusApplyConfiguration) WithVersion(value string) *ManagedApplicationStatusApplyConfiguration {
+	b.Version = &value
+	return b
+}
+
+// WithAPIKeyVersions adds the given value to the APIKeyVersions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the APIKeyVersions field.
+func (b *ManagedApplicationStatusApplyConfiguration) WithAPIKeyVersions(values ...*APIKeyVersionApplyConfiguration) *ManagedApplicationStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAPIKeyVersions")
+		}
+		b.APIKeyVersions = append(b.APIKeyVersions, *values[i])
+	}
+	return b
+}
+
+// WithSyncedAt sets the SyncedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SyncedAt field is set to the value of the last call.
+func (b *ManagedApplicationStatusApplyConfiguration) WithSyncedAt(value v1.Time) *ManagedApplicationStatusApplyConfiguration {
+	b.SyncedAt = &value
+	return b
+}
+
+// WithHash sets the Hash field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Hash field is set to the value of the last call.
+func (b *ManagedApplicationStatusApplyConfiguration) WithHash(value string) *ManagedApplicationStatusApplyConfiguration {
+	b.Hash = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *ManagedApplicationStatusApplyConfiguration) WithConditions(values ...v1.Condition) *ManagedApplicationStatusApplyConfiguration {
+	for i := range values {
+		b.Conditions = append(b.Conditions, values[i])
+	}
+	return b
+}
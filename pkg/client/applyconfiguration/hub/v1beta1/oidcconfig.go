@@ -0,0 +1,89 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// OIDCConfigApplyConfiguration represents an declarative configuration of the OIDCConfig type for use
+// with apply.
+type OIDCConfigApplyConfiguration struct {
+	IssuerURL        *string                             `json:"issuerUrl,omitempty"`
+	SecretName       *string                             `json:"secretName,omitempty"`
+	Scopes           *[]string                           `json:"scopes,omitempty"`
+	Claims           *ClaimsApplyConfiguration           `json:"claims,omitempty"`
+	SyncedAttributes []string                            `json:"syncedAttributes,omitempty"`
+	ClientConfig     *HTTPClientConfigApplyConfiguration `json:"clientConfig,omitempty"`
+}
+
+// OIDCConfigApplyConfiguration constructs an declarative configuration of the OIDCConfig type for use with
+// apply.
+func OIDCConfig() *OIDCConfigApplyConfiguration {
+	return &OIDCConfigApplyConfiguration{}
+}
+
+// WithIssuerURL sets the IssuerURL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IssuerURL field is set to the value of the last call.
+func (b *OIDCConfigApplyConfiguration) WithIssuerURL(value string) *OIDCConfigApplyConfiguration {
+	b.IssuerURL = &value
+	return b
+}
+
+// WithSecretName sets the SecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretName field is set to the value of the last call.
+func (b *OIDCConfigApplyConfiguration) WithSecretName(value string) *OIDCConfigApplyConfiguration {
+	b.SecretName = &value
+	return b
+}
+
+// WithScopes sets the Scopes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Scopes field is set to the value of the last call.
+func (b *OIDCConfigApplyConfiguration) WithScopes(value []string) *OIDCConfigApplyConfiguration {
+	b.Scopes = &value
+	return b
+}
+
+// WithClaims sets the Claims field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Claims field is set to the value of the last call.
+func (b *OIDCConfigApplyConfiguration) WithClaims(value *ClaimsApplyConfiguration) *OIDCConfigApplyConfiguration {
+	b.Claims = value
+	return b
+}
+
+// WithSyncedAttributes adds the given value to the SyncedAttributes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SyncedAttributes field.
+func (b *OIDCConfigApplyConfiguration) WithSyncedAttributes(values ...string) *OIDCConfigApplyConfiguration {
+	for i := range values {
+		b.SyncedAttributes = append(b.SyncedAttributes, values[i])
+	}
+	return b
+}
+
+// WithClientConfig sets the ClientConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClientConfig field is set to the value of the last call.
+func (b *OIDCConfigApplyConfiguration) WithClientConfig(value *HTTPClientConfigApplyConfiguration) *OIDCConfigApplyConfiguration {
+	b.ClientConfig = value
+	return b
+}
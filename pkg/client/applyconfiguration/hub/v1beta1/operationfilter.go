@@ -0,0 +1,44 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// OperationFilterApplyConfiguration represents an declarative configuration of the OperationFilter type for use
+// with apply.
+type OperationFilterApplyConfiguration struct {
+	Include []string `json:"include,omitempty"`
+}
+
+// OperationFilterApplyConfiguration constructs an declarative configuration of the OperationFilter type for use with
+// apply.
+func OperationFilter() *OperationFilterApplyConfiguration {
+	return &OperationFilterApplyConfiguration{}
+}
+
+// WithInclude adds the given value to the Include field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Include field.
+func (b *OperationFilterApplyConfiguration) WithInclude(values ...string) *OperationFilterApplyConfiguration {
+	for i := range values {
+		b.Include = append(b.Include, values[i])
+	}
+	return b
+}
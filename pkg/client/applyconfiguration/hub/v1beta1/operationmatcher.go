@@ -0,0 +1,69 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// OperationMatcherApplyConfiguration represents an declarative configuration of the OperationMatcher type for use
+// with apply.
+type OperationMatcherApplyConfiguration struct {
+	Path       *string   `json:"path,omitempty"`
+	PathPrefix *string   `json:"pathPrefix,omitempty"`
+	PathRegex  *string   `json:"pathRegex,omitempty"`
+	Methods    *[]string `json:"methods,omitempty"`
+}
+
+// OperationMatcherApplyConfiguration constructs an declarative configuration of the OperationMatcher type for use with
+// apply.
+func OperationMatcher() *OperationMatcherApplyConfiguration {
+	return &OperationMatcherApplyConfiguration{}
+}
+
+// WithPath sets the Path field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Path field is set to the value of the last call.
+func (b *OperationMatcherApplyConfiguration) WithPath(value string) *OperationMatcherApplyConfiguration {
+	b.Path = &value
+	return b
+}
+
+// WithPathPrefix sets the PathPrefix field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PathPrefix field is set to the value of the last call.
+func (b *OperationMatcherApplyConfiguration) WithPathPrefix(value string) *OperationMatcherApplyConfiguration {
+	b.PathPrefix = &value
+	return b
+}
+
+// WithPathRegex sets the PathRegex field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PathRegex field is set to the value of the last call.
+func (b *OperationMatcherApplyConfiguration) WithPathRegex(value string) *OperationMatcherApplyConfiguration {
+	b.PathRegex = &value
+	return b
+}
+
+// WithMethods sets the Methods field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Methods field is set to the value of the last call.
+func (b *OperationMatcherApplyConfiguration) WithMethods(value []string) *OperationMatcherApplyConfiguration {
+	b.Methods = &value
+	return b
+}
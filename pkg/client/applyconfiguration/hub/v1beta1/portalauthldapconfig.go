@@ -0,0 +1,135 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// PortalAuthLDAPConfigApplyConfiguration represents an declarative configuration of the PortalAuthLDAPConfig type for use
+// with apply.
+type PortalAuthLDAPConfigApplyConfiguration struct {
+	LDAPConnectionConfigApplyConfiguration `json:",inline"`
+	Groups                                 *LDAPGroupsApplyConfiguration `json:"groups,omitempty"`
+	Attributes                             *AttributesApplyConfiguration `json:"attributes,omitempty"`
+	SyncedAttributes                       []string                      `json:"syncedAttributes,omitempty"`
+}
+
+// PortalAuthLDAPConfigApplyConfiguration constructs an declarative configuration of the PortalAuthLDAPConfig type for use with
+// apply.
+func PortalAuthLDAPConfig() *PortalAuthLDAPConfigApplyConfiguration {
+	return &PortalAuthLDAPConfigApplyConfiguration{}
+}
+
+// WithURL sets the URL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URL field is set to the value of the last call.
+func (b *PortalAuthLDAPConfigApplyConfiguration) WithURL(value string) *PortalAuthLDAPConfigApplyConfiguration {
+	b.URL = &value
+	return b
+}
+
+// WithStartTLS sets the StartTLS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartTLS field is set to the value of the last call.
+func (b *PortalAuthLDAPConfigApplyConfiguration) WithStartTLS(value bool) *PortalAuthLDAPConfigApplyConfiguration {
+	b.StartTLS = &value
+	return b
+}
+
+// WithInsecureSkipVerify sets the InsecureSkipVerify field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InsecureSkipVerify field is set to the value of the last call.
+func (b *PortalAuthLDAPConfigApplyConfiguration) WithInsecureSkipVerify(value bool) *PortalAuthLDAPConfigApplyConfiguration {
+	b.InsecureSkipVerify = &value
+	return b
+}
+
+// WithCertificateAuthority sets the CertificateAuthority field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CertificateAuthority field is set to the value of the last call.
+func (b *PortalAuthLDAPConfigApplyConfiguration) WithCertificateAuthority(value string) *PortalAuthLDAPConfigApplyConfiguration {
+	b.CertificateAuthority = &value
+	return b
+}
+
+// WithBindDN sets the BindDN field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BindDN field is set to the value of the last call.
+func (b *PortalAuthLDAPConfigApplyConfiguration) WithBindDN(value string) *PortalAuthLDAPConfigApplyConfiguration {
+	b.BindDN = &value
+	return b
+}
+
+// WithBindPasswordSecretName sets the BindPasswordSecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BindPasswordSecretName field is set to the value of the last call.
+func (b *PortalAuthLDAPConfigApplyConfiguration) WithBindPasswordSecretName(value string) *PortalAuthLDAPConfigApplyConfiguration {
+	b.BindPasswordSecretName = &value
+	return b
+}
+
+// WithBaseDN sets the BaseDN field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BaseDN field is set to the value of the last call.
+func (b *PortalAuthLDAPConfigApplyConfiguration) WithBaseDN(value string) *PortalAuthLDAPConfigApplyConfiguration {
+	b.BaseDN = &value
+	return b
+}
+
+// WithAttribute sets the Attribute field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Attribute field is set to the value of the last call.
+func (b *PortalAuthLDAPConfigApplyConfiguration) WithAttribute(value string) *PortalAuthLDAPConfigApplyConfiguration {
+	b.Attribute = &value
+	return b
+}
+
+// WithSearchFilter sets the SearchFilter field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SearchFilter field is set to the value of the last call.
+func (b *PortalAuthLDAPConfigApplyConfiguration) WithSearchFilter(value string) *PortalAuthLDAPConfigApplyConfiguration {
+	b.SearchFilter = &value
+	return b
+}
+
+// WithGroups sets the Groups field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Groups field is set to the value of the last call.
+func (b *PortalAuthLDAPConfigApplyConfiguration) WithGroups(value *LDAPGroupsApplyConfiguration) *PortalAuthLDAPConfigApplyConfiguration {
+	b.Groups = value
+	return b
+}
+
+// WithAttributes sets the Attributes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Attributes field is set to the value of the last call.
+func (b *PortalAuthLDAPConfigApplyConfiguration) WithAttributes(value *AttributesApplyConfiguration) *PortalAuthLDAPConfigApplyConfiguration {
+	b.Attributes = value
+	return b
+}
+
+// WithSyncedAttributes adds the given value to the SyncedAttributes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SyncedAttributes field.
+func (b *PortalAuthLDAPConfigApplyConfiguration) WithSyncedAttributes(values ...string) *PortalAuthLDAPConfigApplyConfiguration {
+	for i := range values {
+		b.SyncedAttributes = append(b.SyncedAttributes, values[i])
+	}
+	return b
+}
@@ -0,0 +1,64 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/traefik/hub-crds/pkg/apis/hub/v1beta1"
+)
+
+// RateLimitApplyConfiguration represents an declarative configuration of the RateLimit type for use
+// with apply.
+type RateLimitApplyConfiguration struct {
+	Limit  *int            `json:"limit,omitempty"`
+	Period *v1beta1.Period `json:"period,omitempty"`
+	Bucket *v1beta1.Bucket `json:"bucket,omitempty"`
+}
+
+// RateLimitApplyConfiguration constructs an declarative configuration of the RateLimit type for use with
+// apply.
+func RateLimit() *RateLimitApplyConfiguration {
+	return &RateLimitApplyConfiguration{}
+}
+
+// WithLimit sets the Limit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Limit field is set to the value of the last call.
+func (b *RateLimitApplyConfiguration) WithLimit(value int) *RateLimitApplyConfiguration {
+	b.Limit = &value
+	return b
+}
+
+// WithPeriod sets the Period field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Period field is set to the value of the last call.
+func (b *RateLimitApplyConfiguration) WithPeriod(value v1beta1.Period) *RateLimitApplyConfiguration {
+	b.Period = &value
+	return b
+}
+
+// WithBucket sets the Bucket field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Bucket field is set to the value of the last call.
+func (b *RateLimitApplyConfiguration) WithBucket(value v1beta1.Bucket) *RateLimitApplyConfiguration {
+	b.Bucket = &value
+	return b
+}
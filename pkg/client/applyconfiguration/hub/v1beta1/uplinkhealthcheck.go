@@ -0,0 +1,151 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/traefik/hub-crds/pkg/apis/hub/v1beta1"
+)
+
+// UplinkHealthCheckApplyConfiguration represents an declarative configuration of the UplinkHealthCheck type for use
+// with apply.
+type UplinkHealthCheckApplyConfiguration struct {
+	Path              *string           `json:"path,omitempty"`
+	Scheme            *string           `json:"scheme,omitempty"`
+	Mode              *string           `json:"mode,omitempty"`
+	Method            *string           `json:"method,omitempty"`
+	Status            *int              `json:"status,omitempty"`
+	Port              *int              `json:"port,omitempty"`
+	Interval          *v1beta1.Period   `json:"interval,omitempty"`
+	UnhealthyInterval *v1beta1.Period   `json:"unhealthyInterval,omitempty"`
+	Timeout           *v1beta1.Period   `json:"timeout,omitempty"`
+	Hostname          *string           `json:"hostname,omitempty"`
+	FollowRedirects   *bool             `json:"followRedirects,omitempty"`
+	Headers           map[string]string `json:"headers,omitempty"`
+}
+
+// UplinkHealthCheckApplyConfiguration constructs an declarative configuration of the UplinkHealthCheck type for use with
+// apply.
+func UplinkHealthCheck() *UplinkHealthCheckApplyConfiguration {
+	return &UplinkHealthCheckApplyConfiguration{}
+}
+
+// WithPath sets the Path field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Path field is set to the value of the last call.
+func (b *UplinkHealthCheckApplyConfiguration) WithPath(value string) *UplinkHealthCheckApplyConfiguration {
+	b.Path = &value
+	return b
+}
+
+// WithScheme sets the Scheme field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Scheme field is set to the value of the last call.
+func (b *UplinkHealthCheckApplyConfiguration) WithScheme(value string) *UplinkHealthCheckApplyConfiguration {
+	b.Scheme = &value
+	return b
+}
+
+// WithMode sets the Mode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Mode field is set to the value of the last call.
+func (b *UplinkHealthCheckApplyConfiguration) WithMode(value string) *UplinkHealthCheckApplyConfiguration {
+	b.Mode = &value
+	return b
+}
+
+// WithMethod sets the Method field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Method field is set to the value of the last call.
+func (b *UplinkHealthCheckApplyConfiguration) WithMethod(value string) *UplinkHealthCheckApplyConfiguration {
+	b.Method = &value
+	return b
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *UplinkHealthCheckApplyConfiguration) WithStatus(value int) *UplinkHealthCheckApplyConfiguration {
+	b.Status = &value
+	return b
+}
+
+// WithPort sets the Port field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Port field is set to the value of the last call.
+func (b *UplinkHealthCheckApplyConfiguration) WithPort(value int) *UplinkHealthCheckApplyConfiguration {
+	b.Port = &value
+	return b
+}
+
+// WithInterval sets the Interval field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Interval field is set to the value of the last call.
+func (b *UplinkHealthCheckApplyConfiguration) WithInterval(value v1beta1.Period) *UplinkHealthCheckApplyConfiguration {
+	b.Interval = &value
+	return b
+}
+
+// WithUnhealthyInterval sets the UnhealthyInterval field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UnhealthyInterval field is set to the value of the last call.
+func (b *UplinkHealthCheckApplyConfiguration) WithUnhealthyInterval(value v1beta1.Period) *UplinkHealthCheckApplyConfiguration {
+	b.UnhealthyInterval = &value
+	return b
+}
+
+// WithTimeout sets the Timeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeout field is set to the value of the last call.
+func (b *UplinkHealthCheckApplyConfiguration) WithTimeout(value v1beta1.Period) *UplinkHealthCheckApplyConfiguration {
+	b.Timeout = &value
+	return b
+}
+
+// WithHostname sets the Hostname field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Hostname field is set to the value of the last call.
+func (b *UplinkHealthCheckApplyConfiguration) WithHostname(value string) *UplinkHealthCheckApplyConfiguration {
+	b.Hostname = &value
+	return b
+}
+
+// WithFollowRedirects sets the FollowRedirects field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FollowRedirects field is set to the value of the last call.
+func (b *UplinkHealthCheckApplyConfiguration) WithFollowRedirects(value bool) *UplinkHealthCheckApplyConfiguration {
+	b.FollowRedirects = &value
+	return b
+}
+
+// WithHeaders puts the entries into the Headers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Headers field,
+// overwriting an existing map entries in Headers field with the same key.
+func (b *UplinkHealthCheckApplyConfiguration) WithHeaders(entries map[string]string) *UplinkHealthCheckApplyConfiguration {
+	if b.Headers == nil && len(entries) > 0 {
+		b.Headers = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Headers[k] = v
+	}
+	return b
+}
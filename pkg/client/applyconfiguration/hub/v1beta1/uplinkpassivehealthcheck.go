@@ -0,0 +1,55 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/traefik/hub-crds/pkg/apis/hub/v1beta1"
+)
+
+// UplinkPassiveHealthCheckApplyConfiguration represents an declarative configuration of the UplinkPassiveHealthCheck type for use
+// with apply.
+type UplinkPassiveHealthCheckApplyConfiguration struct {
+	FailureWindow     *v1beta1.Period `json:"failureWindow,omitempty"`
+	MaxFailedAttempts *int            `json:"maxFailedAttempts,omitempty"`
+}
+
+// UplinkPassiveHealthCheckApplyConfiguration constructs an declarative configuration of the UplinkPassiveHealthCheck type for use with
+// apply.
+func UplinkPassiveHealthCheck() *UplinkPassiveHealthCheckApplyConfiguration {
+	return &UplinkPassiveHealthCheckApplyConfiguration{}
+}
+
+// WithFailureWindow sets the FailureWindow field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailureWindow field is set to the value of the last call.
+func (b *UplinkPassiveHealthCheckApplyConfiguration) WithFailureWindow(value v1beta1.Period) *UplinkPassiveHealthCheckApplyConfiguration {
+	b.FailureWindow = &value
+	return b
+}
+
+// WithMaxFailedAttempts sets the MaxFailedAttempts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxFailedAttempts field is set to the value of the last call.
+func (b *UplinkPassiveHealthCheckApplyConfiguration) WithMaxFailedAttempts(value int) *UplinkPassiveHealthCheckApplyConfiguration {
+	b.MaxFailedAttempts = &value
+	return b
+}
@@ -0,0 +1,80 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// UplinkSpecApplyConfiguration represents an declarative configuration of the UplinkSpec type for use
+// with apply.
+type UplinkSpecApplyConfiguration struct {
+	ExposeName         *string                                     `json:"exposeName,omitempty"`
+	EntryPoints        []string                                    `json:"entryPoints,omitempty"`
+	Weight             *int                                        `json:"weight,omitempty"`
+	HealthCheck        *UplinkHealthCheckApplyConfiguration        `json:"healthCheck,omitempty"`
+	PassiveHealthCheck *UplinkPassiveHealthCheckApplyConfiguration `json:"passiveHealthCheck,omitempty"`
+}
+
+// UplinkSpecApplyConfiguration constructs an declarative configuration of the UplinkSpec type for use with
+// apply.
+func UplinkSpec() *UplinkSpecApplyConfiguration {
+	return &UplinkSpecApplyConfiguration{}
+}
+
+// WithExposeName sets the ExposeName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExposeName field is set to the value of the last call.
+func (b *UplinkSpecApplyConfiguration) WithExposeName(value string) *UplinkSpecApplyConfiguration {
+	b.ExposeName = &value
+	return b
+}
+
+// WithEntryPoints adds the given value to the EntryPoints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the EntryPoints field.
+func (b *UplinkSpecApplyConfiguration) WithEntryPoints(values ...string) *UplinkSpecApplyConfiguration {
+	for i := range values {
+		b.EntryPoints = append(b.EntryPoints, values[i])
+	}
+	return b
+}
+
+// WithWeight sets the Weight field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Weight field is set to the value of the last call.
+func (b *UplinkSpecApplyConfiguration) WithWeight(value int) *UplinkSpecApplyConfiguration {
+	b.Weight = &value
+	return b
+}
+
+// WithHealthCheck sets the HealthCheck field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HealthCheck field is set to the value of the last call.
+func (b *UplinkSpecApplyConfiguration) WithHealthCheck(value *UplinkHealthCheckApplyConfiguration) *UplinkSpecApplyConfiguration {
+	b.HealthCheck = value
+	return b
+}
+
+// WithPassiveHealthCheck sets the PassiveHealthCheck field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PassiveHealthCheck field is set to the value of the last call.
+func (b *UplinkSpecApplyConfiguration) WithPassiveHealthCheck(value *UplinkPassiveHealthCheckApplyConfiguration) *UplinkSpecApplyConfiguration {
+	b.PassiveHealthCheck = value
+	return b
+}
@@ -0,0 +1,420 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package applyconfiguration
+
+import (
+	v1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	v1beta1 "github.com/traefik/hub-crds/pkg/apis/hub/v1beta1"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/client/applyconfiguration/hub/v1alpha1"
+	hubv1beta1 "github.com/traefik/hub-crds/pkg/client/applyconfiguration/hub/v1beta1"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ForKind returns an apply configuration type for the given GroupVersionKind, or nil if no
+// apply configuration type exists for the given GroupVersionKind.
+func ForKind(kind schema.GroupVersionKind) interface{} {
+	switch kind {
+	// Group=hub.traefik.io, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithKind("AccessControlOAuthIntro"):
+		return &hubv1alpha1.AccessControlOAuthIntroApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AccessControlOAuthIntroClientConfig"):
+		return &hubv1alpha1.AccessControlOAuthIntroClientConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AccessControlPolicy"):
+		return &hubv1alpha1.AccessControlPolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AccessControlPolicyAPIKey"):
+		return &hubv1alpha1.AccessControlPolicyAPIKeyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AccessControlPolicyAPIKeyKey"):
+		return &hubv1alpha1.AccessControlPolicyAPIKeyKeyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AccessControlPolicyBasicAuth"):
+		return &hubv1alpha1.AccessControlPolicyBasicAuthApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AccessControlPolicyJWT"):
+		return &hubv1alpha1.AccessControlPolicyJWTApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AccessControlPolicyOIDC"):
+		return &hubv1alpha1.AccessControlPolicyOIDCApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AccessControlPolicyOIDCGoogle"):
+		return &hubv1alpha1.AccessControlPolicyOIDCGoogleApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AccessControlPolicySpec"):
+		return &hubv1alpha1.AccessControlPolicySpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AccessControlPolicyStatus"):
+		return &hubv1alpha1.AccessControlPolicyStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AIService"):
+		return &hubv1alpha1.AIServiceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AIServiceBackend"):
+		return &hubv1alpha1.AIServiceBackendApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AIServiceFailover"):
+		return &hubv1alpha1.AIServiceFailoverApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AIServiceProvider"):
+		return &hubv1alpha1.AIServiceProviderApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AIServiceReference"):
+		return &hubv1alpha1.AIServiceReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AIServiceRouting"):
+		return &hubv1alpha1.AIServiceRoutingApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AIServiceSpec"):
+		return &hubv1alpha1.AIServiceSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AIServiceStatus"):
+		return &hubv1alpha1.AIServiceStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Anthropic"):
+		return &hubv1alpha1.AnthropicApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("API"):
+		return &hubv1alpha1.APIApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIAuth"):
+		return &hubv1alpha1.APIAuthApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIAuthSpec"):
+		return &hubv1alpha1.APIAuthSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIAuthStatus"):
+		return &hubv1alpha1.APIAuthStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIBundle"):
+		return &hubv1alpha1.APIBundleApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIBundleReference"):
+		return &hubv1alpha1.APIBundleReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIBundleSpec"):
+		return &hubv1alpha1.APIBundleSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIBundleStatus"):
+		return &hubv1alpha1.APIBundleStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APICatalogItem"):
+		return &hubv1alpha1.APICatalogItemApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APICatalogItemSpec"):
+		return &hubv1alpha1.APICatalogItemSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APICatalogItemStatus"):
+		return &hubv1alpha1.APICatalogItemStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIKey"):
+		return &hubv1alpha1.APIKeyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIKeyAuthSpec"):
+		return &hubv1alpha1.APIKeyAuthSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIKeySource"):
+		return &hubv1alpha1.APIKeySourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIPlan"):
+		return &hubv1alpha1.APIPlanApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIPlanReference"):
+		return &hubv1alpha1.APIPlanReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIPlanSpec"):
+		return &hubv1alpha1.APIPlanSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIPlanStatus"):
+		return &hubv1alpha1.APIPlanStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIPortal"):
+		return &hubv1alpha1.APIPortalApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIPortalAuth"):
+		return &hubv1alpha1.APIPortalAuthApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIPortalAuthReference"):
+		return &hubv1alpha1.APIPortalAuthReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIPortalAuthSpec"):
+		return &hubv1alpha1.APIPortalAuthSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIPortalAuthStatus"):
+		return &hubv1alpha1.APIPortalAuthStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIPortalSpec"):
+		return &hubv1alpha1.APIPortalSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIPortalStatus"):
+		return &hubv1alpha1.APIPortalStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIRateLimit"):
+		return &hubv1alpha1.APIRateLimitApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIRateLimitSpec"):
+		return &hubv1alpha1.APIRateLimitSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIRateLimitStatus"):
+		return &hubv1alpha1.APIRateLimitStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIReference"):
+		return &hubv1alpha1.APIReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APISpec"):
+		return &hubv1alpha1.APISpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIStatus"):
+		return &hubv1alpha1.APIStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIVersion"):
+		return &hubv1alpha1.APIVersionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIVersionRef"):
+		return &hubv1alpha1.APIVersionRefApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIVersionSpec"):
+		return &hubv1alpha1.APIVersionSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("APIVersionStatus"):
+		return &hubv1alpha1.APIVersionStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ApplicationReference"):
+		return &hubv1alpha1.ApplicationReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Attributes"):
+		return &hubv1alpha1.AttributesApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AzureOpenAI"):
+		return &hubv1alpha1.AzureOpenAIApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Bedrock"):
+		return &hubv1alpha1.BedrockApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Claims"):
+		return &hubv1alpha1.ClaimsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Cohere"):
+		return &hubv1alpha1.CohereApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ContentGuard"):
+		return &hubv1alpha1.ContentGuardApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ContentGuardHook"):
+		return &hubv1alpha1.ContentGuardHookApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ContentItem"):
+		return &hubv1alpha1.ContentItemApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ContentItemParentRef"):
+		return &hubv1alpha1.ContentItemParentRefApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ContentItemSpec"):
+		return &hubv1alpha1.ContentItemSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ContentItemStatus"):
+		return &hubv1alpha1.ContentItemStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Cors"):
+		return &hubv1alpha1.CorsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DeepSeek"):
+		return &hubv1alpha1.DeepSeekApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Gemini"):
+		return &hubv1alpha1.GeminiApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("HTTPClientConfig"):
+		return &hubv1alpha1.HTTPClientConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("HTTPClientConfigTLS"):
+		return &hubv1alpha1.HTTPClientConfigTLSApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("JWTAuthSpec"):
+		return &hubv1alpha1.JWTAuthSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("LDAPConnectionConfig"):
+		return &hubv1alpha1.LDAPConnectionConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("LDAPGroups"):
+		return &hubv1alpha1.LDAPGroupsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("LinkDetails"):
+		return &hubv1alpha1.LinkDetailsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ManagedApplication"):
+		return &hubv1alpha1.ManagedApplicationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ManagedApplicationReference"):
+		return &hubv1alpha1.ManagedApplicationReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ManagedApplicationSpec"):
+		return &hubv1alpha1.ManagedApplicationSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ManagedApplicationStatus"):
+		return &hubv1alpha1.ManagedApplicationStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ManagedSubscription"):
+		return &hubv1alpha1.ManagedSubscriptionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ManagedSubscriptionSpec"):
+		return &hubv1alpha1.ManagedSubscriptionSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ManagedSubscriptionStatus"):
+		return &hubv1alpha1.ManagedSubscriptionStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Mistral"):
+		return &hubv1alpha1.MistralApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ModelFilter"):
+		return &hubv1alpha1.ModelFilterApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OIDCConfig"):
+		return &hubv1alpha1.OIDCConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OIDCConfigStatus"):
+		return &hubv1alpha1.OIDCConfigStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Ollama"):
+		return &hubv1alpha1.OllamaApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenAI"):
+		return &hubv1alpha1.OpenAIApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenAICompatible"):
+		return &hubv1alpha1.OpenAICompatibleApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenAPISpec"):
+		return &hubv1alpha1.OpenAPISpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OperationFilter"):
+		return &hubv1alpha1.OperationFilterApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OperationMatcher"):
+		return &hubv1alpha1.OperationMatcherApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OperationSet"):
+		return &hubv1alpha1.OperationSetApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Override"):
+		return &hubv1alpha1.OverrideApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Params"):
+		return &hubv1alpha1.ParamsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PortalAuthLDAPConfig"):
+		return &hubv1alpha1.PortalAuthLDAPConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Quota"):
+		return &hubv1alpha1.QuotaApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("QWen"):
+		return &hubv1alpha1.QWenApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RateLimit"):
+		return &hubv1alpha1.RateLimitApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ResolvedAPIReference"):
+		return &hubv1alpha1.ResolvedAPIReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ResolvedManagedApplicationReference"):
+		return &hubv1alpha1.ResolvedManagedApplicationReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SecretReference"):
+		return &hubv1alpha1.SecretReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Server"):
+		return &hubv1alpha1.ServerApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Session"):
+		return &hubv1alpha1.SessionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("StateCookie"):
+		return &hubv1alpha1.StateCookieApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("TokenQuota"):
+		return &hubv1alpha1.TokenQuotaApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("TokenSource"):
+		return &hubv1alpha1.TokenSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("TrustedIssuer"):
+		return &hubv1alpha1.TrustedIssuerApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("UISpec"):
+		return &hubv1alpha1.UISpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Uplink"):
+		return &hubv1alpha1.UplinkApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("UplinkHealthCheck"):
+		return &hubv1alpha1.UplinkHealthCheckApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("UplinkPassiveHealthCheck"):
+		return &hubv1alpha1.UplinkPassiveHealthCheckApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("UplinkSpec"):
+		return &hubv1alpha1.UplinkSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("UplinkStatus"):
+		return &hubv1alpha1.UplinkStatusApplyConfiguration{}
+
+		// Group=hub.traefik.io, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithKind("API"):
+		return &hubv1beta1.APIApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIAuth"):
+		return &hubv1beta1.APIAuthApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIAuthSpec"):
+		return &hubv1beta1.APIAuthSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIAuthStatus"):
+		return &hubv1beta1.APIAuthStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIBundle"):
+		return &hubv1beta1.APIBundleApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIBundleReference"):
+		return &hubv1beta1.APIBundleReferenceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIBundleSpec"):
+		return &hubv1beta1.APIBundleSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIBundleStatus"):
+		return &hubv1beta1.APIBundleStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APICatalogItem"):
+		return &hubv1beta1.APICatalogItemApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APICatalogItemSpec"):
+		return &hubv1beta1.APICatalogItemSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APICatalogItemStatus"):
+		return &hubv1beta1.APICatalogItemStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIKey"):
+		return &hubv1beta1.APIKeyApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIKeyAuthSpec"):
+		return &hubv1beta1.APIKeyAuthSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIKeySource"):
+		return &hubv1beta1.APIKeySourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIKeyVersion"):
+		return &hubv1beta1.APIKeyVersionApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIPlan"):
+		return &hubv1beta1.APIPlanApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIPlanReference"):
+		return &hubv1beta1.APIPlanReferenceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIPlanSpec"):
+		return &hubv1beta1.APIPlanSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIPlanStatus"):
+		return &hubv1beta1.APIPlanStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIPortal"):
+		return &hubv1beta1.APIPortalApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIPortalAuth"):
+		return &hubv1beta1.APIPortalAuthApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIPortalAuthReference"):
+		return &hubv1beta1.APIPortalAuthReferenceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIPortalAuthSpec"):
+		return &hubv1beta1.APIPortalAuthSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIPortalAuthStatus"):
+		return &hubv1beta1.APIPortalAuthStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIPortalSpec"):
+		return &hubv1beta1.APIPortalSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIPortalStatus"):
+		return &hubv1beta1.APIPortalStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIReference"):
+		return &hubv1beta1.APIReferenceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APISpec"):
+		return &hubv1beta1.APISpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIStatus"):
+		return &hubv1beta1.APIStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIVersion"):
+		return &hubv1beta1.APIVersionApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIVersionRef"):
+		return &hubv1beta1.APIVersionRefApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIVersionSpec"):
+		return &hubv1beta1.APIVersionSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("APIVersionStatus"):
+		return &hubv1beta1.APIVersionStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Attributes"):
+		return &hubv1beta1.AttributesApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Claims"):
+		return &hubv1beta1.ClaimsApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ContentItem"):
+		return &hubv1beta1.ContentItemApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ContentItemParentRef"):
+		return &hubv1beta1.ContentItemParentRefApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ContentItemSpec"):
+		return &hubv1beta1.ContentItemSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ContentItemStatus"):
+		return &hubv1beta1.ContentItemStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Cors"):
+		return &hubv1beta1.CorsApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HTTPClientConfig"):
+		return &hubv1beta1.HTTPClientConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HTTPClientConfigTLS"):
+		return &hubv1beta1.HTTPClientConfigTLSApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("JWTAuthSpec"):
+		return &hubv1beta1.JWTAuthSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("LDAPConnectionConfig"):
+		return &hubv1beta1.LDAPConnectionConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("LDAPGroups"):
+		return &hubv1beta1.LDAPGroupsApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("LinkDetails"):
+		return &hubv1beta1.LinkDetailsApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ManagedApplication"):
+		return &hubv1beta1.ManagedApplicationApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ManagedApplicationReference"):
+		return &hubv1beta1.ManagedApplicationReferenceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ManagedApplicationSpec"):
+		return &hubv1beta1.ManagedApplicationSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ManagedApplicationStatus"):
+		return &hubv1beta1.ManagedApplicationStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ManagedSubscription"):
+		return &hubv1beta1.ManagedSubscriptionApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ManagedSubscriptionSpec"):
+		return &hubv1beta1.ManagedSubscriptionSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ManagedSubscriptionStatus"):
+		return &hubv1beta1.ManagedSubscriptionStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("OIDCConfig"):
+		return &hubv1beta1.OIDCConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("OIDCConfigStatus"):
+		return &hubv1beta1.OIDCConfigStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("OpenAPISpec"):
+		return &hubv1beta1.OpenAPISpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("OperationFilter"):
+		return &hubv1beta1.OperationFilterApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("OperationMatcher"):
+		return &hubv1beta1.OperationMatcherApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("OperationSet"):
+		return &hubv1beta1.OperationSetApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Override"):
+		return &hubv1beta1.OverrideApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PortalAuthLDAPConfig"):
+		return &hubv1beta1.PortalAuthLDAPConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Quota"):
+		return &hubv1beta1.QuotaApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RateLimit"):
+		return &hubv1beta1.RateLimitApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ResolvedAPIReference"):
+		return &hubv1beta1.ResolvedAPIReferenceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ResolvedManagedApplicationReference"):
+		return &hubv1beta1.ResolvedManagedApplicationReferenceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Server"):
+		return &hubv1beta1.ServerApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TokenQuota"):
+		return &hubv1beta1.TokenQuotaApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TrustedIssuer"):
+		return &hubv1beta1.TrustedIssuerApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("UISpec"):
+		return &hubv1beta1.UISpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Uplink"):
+		return &hubv1beta1.UplinkApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("UplinkHealthCheck"):
+		return &hubv1beta1.UplinkHealthCheckApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("UplinkPassiveHealthCheck"):
+		return &hubv1beta1.UplinkPassiveHealthCheckApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("UplinkSpec"):
+		return &hubv1beta1.UplinkSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("UplinkStatus"):
+		return &hubv1beta1.UplinkStatusApplyConfiguration{}
+
+	}
+	return nil
+}
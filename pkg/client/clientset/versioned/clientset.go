@@ -26,6 +26,7 @@ import (
 	"net/http"
 
 	hubv1alpha1 "github.com/traefik/hub-crds/pkg/client/clientset/versioned/typed/hub/v1alpha1"
+	hubv1beta1 "github.com/traefik/hub-crds/pkg/client/clientset/versioned/typed/hub/v1beta1"
 	discovery "k8s.io/client-go/discovery"
 	rest "k8s.io/client-go/rest"
 	flowcontrol "k8s.io/client-go/util/flowcontrol"
@@ -34,12 +35,14 @@ import (
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
 	HubV1alpha1() hubv1alpha1.HubV1alpha1Interface
+	HubV1beta1() hubv1beta1.HubV1beta1Interface
 }
 
 // Clientset contains the clients for groups.
 type Clientset struct {
 	*discovery.DiscoveryClient
 	hubV1alpha1 *hubv1alpha1.HubV1alpha1Client
+	hubV1beta1  *hubv1beta1.HubV1beta1Client
 }
 
 // HubV1alpha1 retrieves the HubV1alpha1Client
@@ -47,6 +50,11 @@ func (c *Clientset) HubV1alpha1() hubv1alpha1.HubV1alpha1Interface {
 	return c.hubV1alpha1
 }
 
+// HubV1beta1 retrieves the HubV1beta1Client
+func (c *Clientset) HubV1beta1() hubv1beta1.HubV1beta1Interface {
+	return c.hubV1beta1
+}
+
 // Discovery retrieves the DiscoveryClient
 func (c *Clientset) Discovery() discovery.DiscoveryInterface {
 	if c == nil {
@@ -95,6 +103,10 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 	if err != nil {
 		return nil, err
 	}
+	cs.hubV1beta1, err = hubv1beta1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 
 	cs.DiscoveryClient, err = discovery.NewDiscoveryClientForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
@@ -117,6 +129,7 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
 	cs.hubV1alpha1 = hubv1alpha1.New(c)
+	cs.hubV1beta1 = hubv1beta1.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
 	return &cs
@@ -25,6 +25,8 @@ import (
 	clientset "github.com/traefik/hub-crds/pkg/client/clientset/versioned"
 	hubv1alpha1 "github.com/traefik/hub-crds/pkg/client/clientset/versioned/typed/hub/v1alpha1"
 	fakehubv1alpha1 "github.com/traefik/hub-crds/pkg/client/clientset/versioned/typed/hub/v1alpha1/fake"
+	hubv1beta1 "github.com/traefik/hub-crds/pkg/client/clientset/versioned/typed/hub/v1beta1"
+	fakehubv1beta1 "github.com/traefik/hub-crds/pkg/client/clientset/versioned/typed/hub/v1beta1/fake"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/discovery"
@@ -86,3 +88,8 @@ var (
 func (c *Clientset) HubV1alpha1() hubv1alpha1.HubV1alpha1Interface {
 	return &fakehubv1alpha1.FakeHubV1alpha1{Fake: &c.Fake}
 }
+
+// HubV1beta1 retrieves the HubV1beta1Client
+func (c *Clientset) HubV1beta1() hubv1beta1.HubV1beta1Interface {
+	return &fakehubv1beta1.FakeHubV1beta1{Fake: &c.Fake}
+}
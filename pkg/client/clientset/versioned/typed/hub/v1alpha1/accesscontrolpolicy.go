@@ -23,9 +23,12 @@ package v1alpha1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
 	v1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/client/applyconfiguration/hub/v1alpha1"
 	scheme "github.com/traefik/hub-crds/pkg/client/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -50,6 +53,8 @@ type AccessControlPolicyInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.AccessControlPolicyList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.AccessControlPolicy, err error)
+	Apply(ctx context.Context, accessControlPolicy *hubv1alpha1.AccessControlPolicyApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.AccessControlPolicy, err error)
+	ApplyStatus(ctx context.Context, accessControlPolicy *hubv1alpha1.AccessControlPolicyApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.AccessControlPolicy, err error)
 	AccessControlPolicyExpansion
 }
 
@@ -185,3 +190,57 @@ func (c *accessControlPolicies) Patch(ctx context.Context, name string, pt types
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied accessControlPolicy.
+func (c *accessControlPolicies) Apply(ctx context.Context, accessControlPolicy *hubv1alpha1.AccessControlPolicyApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.AccessControlPolicy, err error) {
+	if accessControlPolicy == nil {
+		return nil, fmt.Errorf("accessControlPolicy provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(accessControlPolicy)
+	if err != nil {
+		return nil, err
+	}
+	name := accessControlPolicy.Name
+	if name == nil {
+		return nil, fmt.Errorf("accessControlPolicy.Name must be provided to Apply")
+	}
+	result = &v1alpha1.AccessControlPolicy{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Resource("accesscontrolpolicies").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *accessControlPolicies) ApplyStatus(ctx context.Context, accessControlPolicy *hubv1alpha1.AccessControlPolicyApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.AccessControlPolicy, err error) {
+	if accessControlPolicy == nil {
+		return nil, fmt.Errorf("accessControlPolicy provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(accessControlPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	name := accessControlPolicy.Name
+	if name == nil {
+		return nil, fmt.Errorf("accessControlPolicy.Name must be provided to Apply")
+	}
+
+	result = &v1alpha1.AccessControlPolicy{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Resource("accesscontrolpolicies").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
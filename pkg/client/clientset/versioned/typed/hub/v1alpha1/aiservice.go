@@ -23,9 +23,12 @@ package v1alpha1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
 	v1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/client/applyconfiguration/hub/v1alpha1"
 	scheme "github.com/traefik/hub-crds/pkg/client/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -43,12 +46,15 @@ type AIServicesGetter interface {
 type AIServiceInterface interface {
 	Create(ctx context.Context, aIService *v1alpha1.AIService, opts v1.CreateOptions) (*v1alpha1.AIService, error)
 	Update(ctx context.Context, aIService *v1alpha1.AIService, opts v1.UpdateOptions) (*v1alpha1.AIService, error)
+	UpdateStatus(ctx context.Context, aIService *v1alpha1.AIService, opts v1.UpdateOptions) (*v1alpha1.AIService, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.AIService, error)
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.AIServiceList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.AIService, err error)
+	Apply(ctx context.Context, aIService *hubv1alpha1.AIServiceApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.AIService, err error)
+	ApplyStatus(ctx context.Context, aIService *hubv1alpha1.AIServiceApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.AIService, err error)
 	AIServiceExpansion
 }
 
@@ -138,6 +144,22 @@ func (c *aIServices) Update(ctx context.Context, aIService *v1alpha1.AIService,
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *aIServices) UpdateStatus(ctx context.Context, aIService *v1alpha1.AIService, opts v1.UpdateOptions) (result *v1alpha1.AIService, err error) {
+	result = &v1alpha1.AIService{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("aiservices").
+		Name(aIService.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(aIService).
+		Do(ctx).
+		Into(result)
+	return
+}
+
 // Delete takes name of the aIService and deletes it. Returns an error if one occurs.
 func (c *aIServices) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -179,3 +201,59 @@ func (c *aIServices) Patch(ctx context.Context, name string, pt types.PatchType,
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied aIService.
+func (c *aIServices) Apply(ctx context.Context, aIService *hubv1alpha1.AIServiceApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.AIService, err error) {
+	if aIService == nil {
+		return nil, fmt.Errorf("aIService provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(aIService)
+	if err != nil {
+		return nil, err
+	}
+	name := aIService.Name
+	if name == nil {
+		return nil, fmt.Errorf("aIService.Name must be provided to Apply")
+	}
+	result = &v1alpha1.AIService{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("aiservices").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *aIServices) ApplyStatus(ctx context.Context, aIService *hubv1alpha1.AIServiceApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.AIService, err error) {
+	if aIService == nil {
+		return nil, fmt.Errorf("aIService provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(aIService)
+	if err != nil {
+		return nil, err
+	}
+
+	name := aIService.Name
+	if name == nil {
+		return nil, fmt.Errorf("aIService.Name must be provided to Apply")
+	}
+
+	result = &v1alpha1.AIService{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("aiservices").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
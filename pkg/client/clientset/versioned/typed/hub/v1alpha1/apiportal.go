@@ -23,9 +23,12 @@ package v1alpha1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
 	v1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/client/applyconfiguration/hub/v1alpha1"
 	scheme "github.com/traefik/hub-crds/pkg/client/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -50,6 +53,8 @@ type APIPortalInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.APIPortalList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.APIPortal, err error)
+	Apply(ctx context.Context, aPIPortal *hubv1alpha1.APIPortalApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.APIPortal, err error)
+	ApplyStatus(ctx context.Context, aPIPortal *hubv1alpha1.APIPortalApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.APIPortal, err error)
 	APIPortalExpansion
 }
 
@@ -196,3 +201,59 @@ func (c *aPIPortals) Patch(ctx context.Context, name string, pt types.PatchType,
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied aPIPortal.
+func (c *aPIPortals) Apply(ctx context.Context, aPIPortal *hubv1alpha1.APIPortalApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.APIPortal, err error) {
+	if aPIPortal == nil {
+		return nil, fmt.Errorf("aPIPortal provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(aPIPortal)
+	if err != nil {
+		return nil, err
+	}
+	name := aPIPortal.Name
+	if name == nil {
+		return nil, fmt.Errorf("aPIPortal.Name must be provided to Apply")
+	}
+	result = &v1alpha1.APIPortal{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("apiportals").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *aPIPortals) ApplyStatus(ctx context.Context, aPIPortal *hubv1alpha1.APIPortalApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.APIPortal, err error) {
+	if aPIPortal == nil {
+		return nil, fmt.Errorf("aPIPortal provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(aPIPortal)
+	if err != nil {
+		return nil, err
+	}
+
+	name := aPIPortal.Name
+	if name == nil {
+		return nil, fmt.Errorf("aPIPortal.Name must be provided to Apply")
+	}
+
+	result = &v1alpha1.APIPortal{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("apiportals").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -23,8 +23,11 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/client/applyconfiguration/hub/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	types "k8s.io/apimachinery/pkg/types"
@@ -133,3 +136,46 @@ func (c *FakeAccessControlPolicies) Patch(ctx context.Context, name string, pt t
 	}
 	return obj.(*v1alpha1.AccessControlPolicy), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied accessControlPolicy.
+func (c *FakeAccessControlPolicies) Apply(ctx context.Context, accessControlPolicy *hubv1alpha1.AccessControlPolicyApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.AccessControlPolicy, err error) {
+	if accessControlPolicy == nil {
+		return nil, fmt.Errorf("accessControlPolicy provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(accessControlPolicy)
+	if err != nil {
+		return nil, err
+	}
+	name := accessControlPolicy.Name
+	if name == nil {
+		return nil, fmt.Errorf("accessControlPolicy.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(accesscontrolpoliciesResource, *name, types.ApplyPatchType, data), &v1alpha1.AccessControlPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.AccessControlPolicy), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeAccessControlPolicies) ApplyStatus(ctx context.Context, accessControlPolicy *hubv1alpha1.AccessControlPolicyApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.AccessControlPolicy, err error) {
+	if accessControlPolicy == nil {
+		return nil, fmt.Errorf("accessControlPolicy provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(accessControlPolicy)
+	if err != nil {
+		return nil, err
+	}
+	name := accessControlPolicy.Name
+	if name == nil {
+		return nil, fmt.Errorf("accessControlPolicy.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(accesscontrolpoliciesResource, *name, types.ApplyPatchType, data, "status"), &v1alpha1.AccessControlPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.AccessControlPolicy), err
+}
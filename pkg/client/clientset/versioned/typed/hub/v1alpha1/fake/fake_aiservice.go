@@ -23,8 +23,11 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/client/applyconfiguration/hub/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	types "k8s.io/apimachinery/pkg/types"
@@ -104,6 +107,18 @@ func (c *FakeAIServices) Update(ctx context.Context, aIService *v1alpha1.AIServi
 	return obj.(*v1alpha1.AIService), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeAIServices) UpdateStatus(ctx context.Context, aIService *v1alpha1.AIService, opts v1.UpdateOptions) (*v1alpha1.AIService, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(aiservicesResource, "status", c.ns, aIService), &v1alpha1.AIService{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.AIService), err
+}
+
 // Delete takes name of the aIService and deletes it. Returns an error if one occurs.
 func (c *FakeAIServices) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
@@ -130,3 +145,48 @@ func (c *FakeAIServices) Patch(ctx context.Context, name string, pt types.PatchT
 	}
 	return obj.(*v1alpha1.AIService), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied aIService.
+func (c *FakeAIServices) Apply(ctx context.Context, aIService *hubv1alpha1.AIServiceApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.AIService, err error) {
+	if aIService == nil {
+		return nil, fmt.Errorf("aIService provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(aIService)
+	if err != nil {
+		return nil, err
+	}
+	name := aIService.Name
+	if name == nil {
+		return nil, fmt.Errorf("aIService.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(aiservicesResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha1.AIService{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.AIService), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeAIServices) ApplyStatus(ctx context.Context, aIService *hubv1alpha1.AIServiceApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.AIService, err error) {
+	if aIService == nil {
+		return nil, fmt.Errorf("aIService provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(aIService)
+	if err != nil {
+		return nil, err
+	}
+	name := aIService.Name
+	if name == nil {
+		return nil, fmt.Errorf("aIService.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(aiservicesResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1alpha1.AIService{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.AIService), err
+}
@@ -23,8 +23,11 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/client/applyconfiguration/hub/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	types "k8s.io/apimachinery/pkg/types"
@@ -142,3 +145,48 @@ func (c *FakeAPICatalogItems) Patch(ctx context.Context, name string, pt types.P
 	}
 	return obj.(*v1alpha1.APICatalogItem), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied aPICatalogItem.
+func (c *FakeAPICatalogItems) Apply(ctx context.Context, aPICatalogItem *hubv1alpha1.APICatalogItemApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.APICatalogItem, err error) {
+	if aPICatalogItem == nil {
+		return nil, fmt.Errorf("aPICatalogItem provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(aPICatalogItem)
+	if err != nil {
+		return nil, err
+	}
+	name := aPICatalogItem.Name
+	if name == nil {
+		return nil, fmt.Errorf("aPICatalogItem.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(apicatalogitemsResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha1.APICatalogItem{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.APICatalogItem), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeAPICatalogItems) ApplyStatus(ctx context.Context, aPICatalogItem *hubv1alpha1.APICatalogItemApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.APICatalogItem, err error) {
+	if aPICatalogItem == nil {
+		return nil, fmt.Errorf("aPICatalogItem provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(aPICatalogItem)
+	if err != nil {
+		return nil, err
+	}
+	name := aPICatalogItem.Name
+	if name == nil {
+		return nil, fmt.Errorf("aPICatalogItem.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(apicatalogitemsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1alpha1.APICatalogItem{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.APICatalogItem), err
+}
@@ -23,8 +23,11 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/client/applyconfiguration/hub/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	types "k8s.io/apimachinery/pkg/types"
@@ -142,3 +145,48 @@ func (c *FakeAPIVersions) Patch(ctx context.Context, name string, pt types.Patch
 	}
 	return obj.(*v1alpha1.APIVersion), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied aPIVersion.
+func (c *FakeAPIVersions) Apply(ctx context.Context, aPIVersion *hubv1alpha1.APIVersionApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.APIVersion, err error) {
+	if aPIVersion == nil {
+		return nil, fmt.Errorf("aPIVersion provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(aPIVersion)
+	if err != nil {
+		return nil, err
+	}
+	name := aPIVersion.Name
+	if name == nil {
+		return nil, fmt.Errorf("aPIVersion.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(apiversionsResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha1.APIVersion{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.APIVersion), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeAPIVersions) ApplyStatus(ctx context.Context, aPIVersion *hubv1alpha1.APIVersionApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.APIVersion, err error) {
+	if aPIVersion == nil {
+		return nil, fmt.Errorf("aPIVersion provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(aPIVersion)
+	if err != nil {
+		return nil, err
+	}
+	name := aPIVersion.Name
+	if name == nil {
+		return nil, fmt.Errorf("aPIVersion.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(apiversionsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1alpha1.APIVersion{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.APIVersion), err
+}
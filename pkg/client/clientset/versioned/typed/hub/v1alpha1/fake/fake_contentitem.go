@@ -23,8 +23,11 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/client/applyconfiguration/hub/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	types "k8s.io/apimachinery/pkg/types"
@@ -142,3 +145,48 @@ func (c *FakeContentItems) Patch(ctx context.Context, name string, pt types.Patc
 	}
 	return obj.(*v1alpha1.ContentItem), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied contentItem.
+func (c *FakeContentItems) Apply(ctx context.Context, contentItem *hubv1alpha1.ContentItemApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.ContentItem, err error) {
+	if contentItem == nil {
+		return nil, fmt.Errorf("contentItem provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(contentItem)
+	if err != nil {
+		return nil, err
+	}
+	name := contentItem.Name
+	if name == nil {
+		return nil, fmt.Errorf("contentItem.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(contentitemsResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha1.ContentItem{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ContentItem), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeContentItems) ApplyStatus(ctx context.Context, contentItem *hubv1alpha1.ContentItemApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.ContentItem, err error) {
+	if contentItem == nil {
+		return nil, fmt.Errorf("contentItem provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(contentItem)
+	if err != nil {
+		return nil, err
+	}
+	name := contentItem.Name
+	if name == nil {
+		return nil, fmt.Errorf("contentItem.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(contentitemsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1alpha1.ContentItem{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ContentItem), err
+}
@@ -23,8 +23,11 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/client/applyconfiguration/hub/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	types "k8s.io/apimachinery/pkg/types"
@@ -142,3 +145,48 @@ func (c *FakeManagedSubscriptions) Patch(ctx context.Context, name string, pt ty
 	}
 	return obj.(*v1alpha1.ManagedSubscription), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied managedSubscription.
+func (c *FakeManagedSubscriptions) Apply(ctx context.Context, managedSubscription *hubv1alpha1.ManagedSubscriptionApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.ManagedSubscription, err error) {
+	if managedSubscription == nil {
+		return nil, fmt.Errorf("managedSubscription provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(managedSubscription)
+	if err != nil {
+		return nil, err
+	}
+	name := managedSubscription.Name
+	if name == nil {
+		return nil, fmt.Errorf("managedSubscription.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(managedsubscriptionsResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha1.ManagedSubscription{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ManagedSubscription), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeManagedSubscriptions) ApplyStatus(ctx context.Context, managedSubscription *hubv1alpha1.ManagedSubscriptionApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.ManagedSubscription, err error) {
+	if managedSubscription == nil {
+		return nil, fmt.Errorf("managedSubscription provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(managedSubscription)
+	if err != nil {
+		return nil, err
+	}
+	name := managedSubscription.Name
+	if name == nil {
+		return nil, fmt.Errorf("managedSubscription.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(managedsubscriptionsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1alpha1.ManagedSubscription{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ManagedSubscription), err
+}
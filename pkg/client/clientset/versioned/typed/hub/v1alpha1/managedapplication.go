@@ -23,9 +23,12 @@ package v1alpha1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
 	v1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/client/applyconfiguration/hub/v1alpha1"
 	scheme "github.com/traefik/hub-crds/pkg/client/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -50,6 +53,8 @@ type ManagedApplicationInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.ManagedApplicationList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ManagedApplication, err error)
+	Apply(ctx context.Context, managedApplication *hubv1alpha1.ManagedApplicationApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.ManagedApplication, err error)
+	ApplyStatus(ctx context.Context, managedApplication *hubv1alpha1.ManagedApplicationApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.ManagedApplication, err error)
 	ManagedApplicationExpansion
 }
 
@@ -196,3 +201,59 @@ func (c *managedApplications) Patch(ctx context.Context, name string, pt types.P
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied managedApplication.
+func (c *managedApplications) Apply(ctx context.Context, managedApplication *hubv1alpha1.ManagedApplicationApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.ManagedApplication, err error) {
+	if managedApplication == nil {
+		return nil, fmt.Errorf("managedApplication provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(managedApplication)
+	if err != nil {
+		return nil, err
+	}
+	name := managedApplication.Name
+	if name == nil {
+		return nil, fmt.Errorf("managedApplication.Name must be provided to Apply")
+	}
+	result = &v1alpha1.ManagedApplication{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("managedapplications").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *managedApplications) ApplyStatus(ctx context.Context, managedApplication *hubv1alpha1.ManagedApplicationApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.ManagedApplication, err error) {
+	if managedApplication == nil {
+		return nil, fmt.Errorf("managedApplication provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(managedApplication)
+	if err != nil {
+		return nil, err
+	}
+
+	name := managedApplication.Name
+	if name == nil {
+		return nil, fmt.Errorf("managedApplication.Name must be provided to Apply")
+	}
+
+	result = &v1alpha1.ManagedApplication{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("managedapplications").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
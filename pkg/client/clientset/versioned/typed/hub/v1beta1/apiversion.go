@@ -0,0 +1,259 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+	"time"
+
+	v1beta1 "github.com/traefik/hub-crds/pkg/apis/hub/v1beta1"
+	hubv1beta1 "github.com/traefik/hub-crds/pkg/client/applyconfiguration/hub/v1beta1"
+	scheme "github.com/traefik/hub-crds/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// APIVersionsGetter has a method to return a APIVersionInterface.
+// A group's client should implement this interface.
+type APIVersionsGetter interface {
+	APIVersions(namespace string) APIVersionInterface
+}
+
+// APIVersionInterface has methods to work with APIVersion resources.
+type APIVersionInterface interface {
+	Create(ctx context.Context, aPIVersion *v1beta1.APIVersion, opts v1.CreateOptions) (*v1beta1.APIVersion, error)
+	Update(ctx context.Context, aPIVersion *v1beta1.APIVersion, opts v1.UpdateOptions) (*v1beta1.APIVersion, error)
+	UpdateStatus(ctx context.Context, aPIVersion *v1beta1.APIVersion, opts v1.UpdateOptions) (*v1beta1.APIVersion, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.APIVersion, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.APIVersionList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.APIVersion, err error)
+	Apply(ctx context.Context, aPIVersion *hubv1beta1.APIVersionApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.APIVersion, err error)
+	ApplyStatus(ctx context.Context, aPIVersion *hubv1beta1.APIVersionApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.APIVersion, err error)
+	APIVersionExpansion
+}
+
+// aPIVersions implements APIVersionInterface
+type aPIVersions struct {
+	client rest.Interface
+	ns     string
+}
+
+// newAPIVersions returns a APIVersions
+func newAPIVersions(c *HubV1beta1Client, namespace string) *aPIVersions {
+	return &aPIVersions{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the aPIVersion, and returns the corresponding aPIVersion object, and an error if there is any.
+func (c *aPIVersions) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.APIVersion, err error) {
+	result = &v1beta1.APIVersion{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("apiversions").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of APIVersions that match those selectors.
+func (c *aPIVersions) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.APIVersionList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.APIVersionList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("apiversions").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested aPIVersions.
+func (c *aPIVersions) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("apiversions").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a aPIVersion and creates it.  Returns the server's representation of the aPIVersion, and an error, if there is any.
+func (c *aPIVersions) Create(ctx context.Context, aPIVersion *v1beta1.APIVersion, opts v1.CreateOptions) (result *v1beta1.APIVersion, err error) {
+	result = &v1beta1.APIVersion{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("apiversions").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(aPIVersion).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a aPIVersion and updates it. Returns the server's representation of the aPIVersion, and an error, if there is any.
+func (c *aPIVersions) Update(ctx context.Context, aPIVersion *v1beta1.APIVersion, opts v1.UpdateOptions) (result *v1beta1.APIVersion, err error) {
+	result = &v1beta1.APIVersion{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("apiversions").
+		Name(aPIVersion.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(aPIVersion).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *aPIVersions) UpdateStatus(ctx context.Context, aPIVersion *v1beta1.APIVersion, opts v1.UpdateOptions) (result *v1beta1.APIVersion, err error) {
+	result = &v1beta1.APIVersion{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("apiversions").
+		Name(aPIVersion.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(aPIVersion).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the aPIVersion and deletes it. Returns an error if one occurs.
+func (c *aPIVersions) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("apiversions").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *aPIVersions) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("apiversions").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched aPIVersion.
+func (c *aPIVersions) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.APIVersion, err error) {
+	result = &v1beta1.APIVersion{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("apiversions").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied aPIVersion.
+func (c *aPIVersions) Apply(ctx context.Context, aPIVersion *hubv1beta1.APIVersionApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.APIVersion, err error) {
+	if aPIVersion == nil {
+		return nil, fmt.Errorf("aPIVersion provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(aPIVersion)
+	if err != nil {
+		return nil, err
+	}
+	name := aPIVersion.Name
+	if name == nil {
+		return nil, fmt.Errorf("aPIVersion.Name must be provided to Apply")
+	}
+	result = &v1beta1.APIVersion{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("apiversions").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *aPIVersions) ApplyStatus(ctx context.Context, aPIVersion *hubv1beta1.APIVersionApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.APIVersion, err error) {
+	if aPIVersion == nil {
+		return nil, fmt.Errorf("aPIVersion provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(aPIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	name := aPIVersion.Name
+	if name == nil {
+		return nil, fmt.Errorf("aPIVersion.Name must be provided to Apply")
+	}
+
+	result = &v1beta1.APIVersion{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("apiversions").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
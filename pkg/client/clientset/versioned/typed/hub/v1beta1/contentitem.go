@@ -0,0 +1,259 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+	"time"
+
+	v1beta1 "github.com/traefik/hub-crds/pkg/apis/hub/v1beta1"
+	hubv1beta1 "github.com/traefik/hub-crds/pkg/client/applyconfiguration/hub/v1beta1"
+	scheme "github.com/traefik/hub-crds/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ContentItemsGetter has a method to return a ContentItemInterface.
+// A group's client should implement this interface.
+type ContentItemsGetter interface {
+	ContentItems(namespace string) ContentItemInterface
+}
+
+// ContentItemInterface has methods to work with ContentItem resources.
+type ContentItemInterface interface {
+	Create(ctx context.Context, contentItem *v1beta1.ContentItem, opts v1.CreateOptions) (*v1beta1.ContentItem, error)
+	Update(ctx context.Context, contentItem *v1beta1.ContentItem, opts v1.UpdateOptions) (*v1beta1.ContentItem, error)
+	UpdateStatus(ctx context.Context, contentItem *v1beta1.ContentItem, opts v1.UpdateOptions) (*v1beta1.ContentItem, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.ContentItem, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.ContentItemList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.ContentItem, err error)
+	Apply(ctx context.Context, contentItem *hubv1beta1.ContentItemApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.ContentItem, err error)
+	ApplyStatus(ctx context.Context, contentItem *hubv1beta1.ContentItemApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.ContentItem, err error)
+	ContentItemExpansion
+}
+
+// contentItems implements ContentItemInterface
+type contentItems struct {
+	client rest.Interface
+	ns     string
+}
+
+// newContentItems returns a ContentItems
+func newContentItems(c *HubV1beta1Client, namespace string) *contentItems {
+	return &contentItems{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the contentItem, and returns the corresponding contentItem object, and an error if there is any.
+func (c *contentItems) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.ContentItem, err error) {
+	result = &v1beta1.ContentItem{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("contentitems").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ContentItems that match those selectors.
+func (c *contentItems) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.ContentItemList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.ContentItemList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("contentitems").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested contentItems.
+func (c *contentItems) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("contentitems").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a contentItem and creates it.  Returns the server's representation of the contentItem, and an error, if there is any.
+func (c *contentItems) Create(ctx context.Context, contentItem *v1beta1.ContentItem, opts v1.CreateOptions) (result *v1beta1.ContentItem, err error) {
+	result = &v1beta1.ContentItem{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("contentitems").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(contentItem).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a contentItem and updates it. Returns the server's representation of the contentItem, and an error, if there is any.
+func (c *contentItems) Update(ctx context.Context, contentItem *v1beta1.ContentItem, opts v1.UpdateOptions) (result *v1beta1.ContentItem, err error) {
+	result = &v1beta1.ContentItem{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("contentitems").
+		Name(contentItem.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(contentItem).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *contentItems) UpdateStatus(ctx context.Context, contentItem *v1beta1.ContentItem, opts v1.UpdateOptions) (result *v1beta1.ContentItem, err error) {
+	result = &v1beta1.ContentItem{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("contentitems").
+		Name(contentItem.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(contentItem).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the contentItem and deletes it. Returns an error if one occurs.
+func (c *contentItems) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("contentitems").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *contentItems) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("contentitems").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched contentItem.
+func (c *contentItems) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.ContentItem, err error) {
+	result = &v1beta1.ContentItem{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("contentitems").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied contentItem.
+func (c *contentItems) Apply(ctx context.Context, contentItem *hubv1beta1.ContentItemApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.ContentItem, err error) {
+	if contentItem == nil {
+		return nil, fmt.Errorf("contentItem provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(contentItem)
+	if err != nil {
+		return nil, err
+	}
+	name := contentItem.Name
+	if name == nil {
+		return nil, fmt.Errorf("contentItem.Name must be provided to Apply")
+	}
+	result = &v1beta1.ContentItem{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("contentitems").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *contentItems) ApplyStatus(ctx context.Context, contentItem *hubv1beta1.ContentItemApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.ContentItem, err error) {
+	if contentItem == nil {
+		return nil, fmt.Errorf("contentItem provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(contentItem)
+	if err != nil {
+		return nil, err
+	}
+
+	name := contentItem.Name
+	if name == nil {
+		return nil, fmt.Errorf("contentItem.Name must be provided to Apply")
+	}
+
+	result = &v1beta1.ContentItem{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("contentitems").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
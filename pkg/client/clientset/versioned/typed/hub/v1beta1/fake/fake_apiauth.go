@@ -0,0 +1,192 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1beta1 "github.com/traefik/hub-crds/pkg/apis/hub/v1beta1"
+	hubv1beta1 "github.com/traefik/hub-crds/pkg/client/applyconfiguration/hub/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeAPIAuths implements APIAuthInterface
+type FakeAPIAuths struct {
+	Fake *FakeHubV1beta1
+	ns   string
+}
+
+var apiauthsResource = v1beta1.SchemeGroupVersion.WithResource("apiauths")
+
+var apiauthsKind = v1beta1.SchemeGroupVersion.WithKind("APIAuth")
+
+// Get takes name of the aPIAuth, and returns the corresponding aPIAuth object, and an error if there is any.
+func (c *FakeAPIAuths) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.APIAuth, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(apiauthsResource, c.ns, name), &v1beta1.APIAuth{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.APIAuth), err
+}
+
+// List takes label and field selectors, and returns the list of APIAuths that match those selectors.
+func (c *FakeAPIAuths) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.APIAuthList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(apiauthsResource, apiauthsKind, c.ns, opts), &v1beta1.APIAuthList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.APIAuthList{ListMeta: obj.(*v1beta1.APIAuthList).ListMeta}
+	for _, item := range obj.(*v1beta1.APIAuthList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested aPIAuths.
+func (c *FakeAPIAuths) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(apiauthsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a aPIAuth and creates it.  Returns the server's representation of the aPIAuth, and an error, if there is any.
+func (c *FakeAPIAuths) Create(ctx context.Context, aPIAuth *v1beta1.APIAuth, opts v1.CreateOptions) (result *v1beta1.APIAuth, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(apiauthsResource, c.ns, aPIAuth), &v1beta1.APIAuth{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.APIAuth), err
+}
+
+// Update takes the representation of a aPIAuth and updates it. Returns the server's representation of the aPIAuth, and an error, if there is any.
+func (c *FakeAPIAuths) Update(ctx context.Context, aPIAuth *v1beta1.APIAuth, opts v1.UpdateOptions) (result *v1beta1.APIAuth, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(apiauthsResource, c.ns, aPIAuth), &v1beta1.APIAuth{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.APIAuth), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeAPIAuths) UpdateStatus(ctx context.Context, aPIAuth *v1beta1.APIAuth, opts v1.UpdateOptions) (*v1beta1.APIAuth, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(apiauthsResource, "status", c.ns, aPIAuth), &v1beta1.APIAuth{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.APIAuth), err
+}
+
+// Delete takes name of the aPIAuth and deletes it. Returns an error if one occurs.
+func (c *FakeAPIAuths) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(apiauthsResource, c.ns, name, opts), &v1beta1.APIAuth{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeAPIAuths) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(apiauthsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.APIAuthList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched aPIAuth.
+func (c *FakeAPIAuths) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.APIAuth, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(apiauthsResource, c.ns, name, pt, data, subresources...), &v1beta1.APIAuth{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.APIAuth), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied aPIAuth.
+func (c *FakeAPIAuths) Apply(ctx context.Context, aPIAuth *hubv1beta1.APIAuthApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.APIAuth, err error) {
+	if aPIAuth == nil {
+		return nil, fmt.Errorf("aPIAuth provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(aPIAuth)
+	if err != nil {
+		return nil, err
+	}
+	name := aPIAuth.Name
+	if name == nil {
+		return nil, fmt.Errorf("aPIAuth.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(apiauthsResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.APIAuth{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.APIAuth), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeAPIAuths) ApplyStatus(ctx context.Context, aPIAuth *hubv1beta1.APIAuthApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.APIAuth, err error) {
+	if aPIAuth == nil {
+		return nil, fmt.Errorf("aPIAuth provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(aPIAuth)
+	if err != nil {
+		return nil, err
+	}
+	name := aPIAuth.Name
+	if name == nil {
+		return nil, fmt.Errorf("aPIAuth.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(apiauthsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1beta1.APIAuth{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.APIAuth), err
+}
@@ -0,0 +1,192 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1beta1 "github.com/traefik/hub-crds/pkg/apis/hub/v1beta1"
+	hubv1beta1 "github.com/traefik/hub-crds/pkg/client/applyconfiguration/hub/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeAPIBundles implements APIBundleInterface
+type FakeAPIBundles struct {
+	Fake *FakeHubV1beta1
+	ns   string
+}
+
+var apibundlesResource = v1beta1.SchemeGroupVersion.WithResource("apibundles")
+
+var apibundlesKind = v1beta1.SchemeGroupVersion.WithKind("APIBundle")
+
+// Get takes name of the aPIBundle, and returns the corresponding aPIBundle object, and an error if there is any.
+func (c *FakeAPIBundles) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.APIBundle, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(apibundlesResource, c.ns, name), &v1beta1.APIBundle{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.APIBundle), err
+}
+
+// List takes label and field selectors, and returns the list of APIBundles that match those selectors.
+func (c *FakeAPIBundles) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.APIBundleList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(apibundlesResource, apibundlesKind, c.ns, opts), &v1beta1.APIBundleList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.APIBundleList{ListMeta: obj.(*v1beta1.APIBundleList).ListMeta}
+	for _, item := range obj.(*v1beta1.APIBundleList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested aPIBundles.
+func (c *FakeAPIBundles) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(apibundlesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a aPIBundle and creates it.  Returns the server's representation of the aPIBundle, and an error, if there is any.
+func (c *FakeAPIBundles) Create(ctx context.Context, aPIBundle *v1beta1.APIBundle, opts v1.CreateOptions) (result *v1beta1.APIBundle, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(apibundlesResource, c.ns, aPIBundle), &v1beta1.APIBundle{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.APIBundle), err
+}
+
+// Update takes the representation of a aPIBundle and updates it. Returns the server's representation of the aPIBundle, and an error, if there is any.
+func (c *FakeAPIBundles) Update(ctx context.Context, aPIBundle *v1beta1.APIBundle, opts v1.UpdateOptions) (result *v1beta1.APIBundle, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(apibundlesResource, c.ns, aPIBundle), &v1beta1.APIBundle{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.APIBundle), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeAPIBundles) UpdateStatus(ctx context.Context, aPIBundle *v1beta1.APIBundle, opts v1.UpdateOptions) (*v1beta1.APIBundle, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(apibundlesResource, "status", c.ns, aPIBundle), &v1beta1.APIBundle{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.APIBundle), err
+}
+
+// Delete takes name of the aPIBundle and deletes it. Returns an error if one occurs.
+func (c *FakeAPIBundles) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(apibundlesResource, c.ns, name, opts), &v1beta1.APIBundle{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeAPIBundles) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(apibundlesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.APIBundleList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched aPIBundle.
+func (c *FakeAPIBundles) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.APIBundle, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(apibundlesResource, c.ns, name, pt, data, subresources...), &v1beta1.APIBundle{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.APIBundle), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied aPIBundle.
+func (c *FakeAPIBundles) Apply(ctx context.Context, aPIBundle *hubv1beta1.APIBundleApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.APIBundle, err error) {
+	if aPIBundle == nil {
+		return nil, fmt.Errorf("aPIBundle provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(aPIBundle)
+	if err != nil {
+		return nil, err
+	}
+	name := aPIBundle.Name
+	if name == nil {
+		return nil, fmt.Errorf("aPIBundle.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(apibundlesResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.APIBundle{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.APIBundle), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeAPIBundles) ApplyStatus(ctx context.Context, aPIBundle *hubv1beta1.APIBundleApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.APIBundle, err error) {
+	if aPIBundle == nil {
+		return nil, fmt.Errorf("aPIBundle provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(aPIBundle)
+	if err != nil {
+		return nil, err
+	}
+	name := aPIBundle.Name
+	if name == nil {
+		return nil, fmt.Errorf("aPIBundle.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(apibundlesResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1beta1.APIBundle{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.APIBundle), err
+}
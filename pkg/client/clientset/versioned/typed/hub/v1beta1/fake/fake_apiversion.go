@@ -0,0 +1,192 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1beta1 "github.com/traefik/hub-crds/pkg/apis/hub/v1beta1"
+	hubv1beta1 "github.com/traefik/hub-crds/pkg/client/applyconfiguration/hub/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeAPIVersions implements APIVersionInterface
+type FakeAPIVersions struct {
+	Fake *FakeHubV1beta1
+	ns   string
+}
+
+var apiversionsResource = v1beta1.SchemeGroupVersion.WithResource("apiversions")
+
+var apiversionsKind = v1beta1.SchemeGroupVersion.WithKind("APIVersion")
+
+// Get takes name of the aPIVersion, and returns the corresponding aPIVersion object, and an error if there is any.
+func (c *FakeAPIVersions) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.APIVersion, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(apiversionsResource, c.ns, name), &v1beta1.APIVersion{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.APIVersion), err
+}
+
+// List takes label and field selectors, and returns the list of APIVersions that match those selectors.
+func (c *FakeAPIVersions) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.APIVersionList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(apiversionsResource, apiversionsKind, c.ns, opts), &v1beta1.APIVersionList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.APIVersionList{ListMeta: obj.(*v1beta1.APIVersionList).ListMeta}
+	for _, item := range obj.(*v1beta1.APIVersionList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested aPIVersions.
+func (c *FakeAPIVersions) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(apiversionsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a aPIVersion and creates it.  Returns the server's representation of the aPIVersion, and an error, if there is any.
+func (c *FakeAPIVersions) Create(ctx context.Context, aPIVersion *v1beta1.APIVersion, opts v1.CreateOptions) (result *v1beta1.APIVersion, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(apiversionsResource, c.ns, aPIVersion), &v1beta1.APIVersion{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.APIVersion), err
+}
+
+// Update takes the representation of a aPIVersion and updates it. Returns the server's representation of the aPIVersion, and an error, if there is any.
+func (c *FakeAPIVersions) Update(ctx context.Context, aPIVersion *v1beta1.APIVersion, opts v1.UpdateOptions) (result *v1beta1.APIVersion, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(apiversionsResource, c.ns, aPIVersion), &v1beta1.APIVersion{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.APIVersion), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeAPIVersions) UpdateStatus(ctx context.Context, aPIVersion *v1beta1.APIVersion, opts v1.UpdateOptions) (*v1beta1.APIVersion, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(apiversionsResource, "status", c.ns, aPIVersion), &v1beta1.APIVersion{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.APIVersion), err
+}
+
+// Delete takes name of the aPIVersion and deletes it. Returns an error if one occurs.
+func (c *FakeAPIVersions) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(apiversionsResource, c.ns, name, opts), &v1beta1.APIVersion{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeAPIVersions) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(apiversionsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.APIVersionList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched aPIVersion.
+func (c *FakeAPIVersions) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.APIVersion, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(apiversionsResource, c.ns, name, pt, data, subresources...), &v1beta1.APIVersion{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.APIVersion), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied aPIVersion.
+func (c *FakeAPIVersions) Apply(ctx context.Context, aPIVersion *hubv1beta1.APIVersionApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.APIVersion, err error) {
+	if aPIVersion == nil {
+		return nil, fmt.Errorf("aPIVersion provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(aPIVersion)
+	if err != nil {
+		return nil, err
+	}
+	name := aPIVersion.Name
+	if name == nil {
+		return nil, fmt.Errorf("aPIVersion.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(apiversionsResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.APIVersion{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.APIVersion), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeAPIVersions) ApplyStatus(ctx context.Context, aPIVersion *hubv1beta1.APIVersionApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.APIVersion, err error) {
+	if aPIVersion == nil {
+		return nil, fmt.Errorf("aPIVersion provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(aPIVersion)
+	if err != nil {
+		return nil, err
+	}
+	name := aPIVersion.Name
+	if name == nil {
+		return nil, fmt.Errorf("aPIVersion.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(apiversionsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1beta1.APIVersion{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.APIVersion), err
+}
@@ -0,0 +1,192 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1beta1 "github.com/traefik/hub-crds/pkg/apis/hub/v1beta1"
+	hubv1beta1 "github.com/traefik/hub-crds/pkg/client/applyconfiguration/hub/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeContentItems implements ContentItemInterface
+type FakeContentItems struct {
+	Fake *FakeHubV1beta1
+	ns   string
+}
+
+var contentitemsResource = v1beta1.SchemeGroupVersion.WithResource("contentitems")
+
+var contentitemsKind = v1beta1.SchemeGroupVersion.WithKind("ContentItem")
+
+// Get takes name of the contentItem, and returns the corresponding contentItem object, and an error if there is any.
+func (c *FakeContentItems) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.ContentItem, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(contentitemsResource, c.ns, name), &v1beta1.ContentItem{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ContentItem), err
+}
+
+// List takes label and field selectors, and returns the list of ContentItems that match those selectors.
+func (c *FakeContentItems) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.ContentItemList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(contentitemsResource, contentitemsKind, c.ns, opts), &v1beta1.ContentItemList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.ContentItemList{ListMeta: obj.(*v1beta1.ContentItemList).ListMeta}
+	for _, item := range obj.(*v1beta1.ContentItemList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested contentItems.
+func (c *FakeContentItems) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(contentitemsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a contentItem and creates it.  Returns the server's representation of the contentItem, and an error, if there is any.
+func (c *FakeContentItems) Create(ctx context.Context, contentItem *v1beta1.ContentItem, opts v1.CreateOptions) (result *v1beta1.ContentItem, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(contentitemsResource, c.ns, contentItem), &v1beta1.ContentItem{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ContentItem), err
+}
+
+// Update takes the representation of a contentItem and updates it. Returns the server's representation of the contentItem, and an error, if there is any.
+func (c *FakeContentItems) Update(ctx context.Context, contentItem *v1beta1.ContentItem, opts v1.UpdateOptions) (result *v1beta1.ContentItem, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(contentitemsResource, c.ns, contentItem), &v1beta1.ContentItem{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ContentItem), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeContentItems) UpdateStatus(ctx context.Context, contentItem *v1beta1.ContentItem, opts v1.UpdateOptions) (*v1beta1.ContentItem, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(contentitemsResource, "status", c.ns, contentItem), &v1beta1.ContentItem{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ContentItem), err
+}
+
+// Delete takes name of the contentItem and deletes it. Returns an error if one occurs.
+func (c *FakeContentItems) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(contentitemsResource, c.ns, name, opts), &v1beta1.ContentItem{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeContentItems) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(contentitemsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.ContentItemList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched contentItem.
+func (c *FakeContentItems) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.ContentItem, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(contentitemsResource, c.ns, name, pt, data, subresources...), &v1beta1.ContentItem{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ContentItem), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied contentItem.
+func (c *FakeContentItems) Apply(ctx context.Context, contentItem *hubv1beta1.ContentItemApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.ContentItem, err error) {
+	if contentItem == nil {
+		return nil, fmt.Errorf("contentItem provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(contentItem)
+	if err != nil {
+		return nil, err
+	}
+	name := contentItem.Name
+	if name == nil {
+		return nil, fmt.Errorf("contentItem.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(contentitemsResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.ContentItem{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ContentItem), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeContentItems) ApplyStatus(ctx context.Context, contentItem *hubv1beta1.ContentItemApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.ContentItem, err error) {
+	if contentItem == nil {
+		return nil, fmt.Errorf("contentItem provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(contentItem)
+	if err != nil {
+		return nil, err
+	}
+	name := contentItem.Name
+	if name == nil {
+		return nil, fmt.Errorf("contentItem.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(contentitemsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1beta1.ContentItem{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ContentItem), err
+}
@@ -0,0 +1,87 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta1 "github.com/traefik/hub-crds/pkg/client/clientset/versioned/typed/hub/v1beta1"
+	rest "k8s.io/client-go/rest"
+	testing "k8s.io/client-go/testing"
+)
+
+type FakeHubV1beta1 struct {
+	*testing.Fake
+}
+
+func (c *FakeHubV1beta1) APIs(namespace string) v1beta1.APIInterface {
+	return &FakeAPIs{c, namespace}
+}
+
+func (c *FakeHubV1beta1) APIAuths(namespace string) v1beta1.APIAuthInterface {
+	return &FakeAPIAuths{c, namespace}
+}
+
+func (c *FakeHubV1beta1) APIBundles(namespace string) v1beta1.APIBundleInterface {
+	return &FakeAPIBundles{c, namespace}
+}
+
+func (c *FakeHubV1beta1) APICatalogItems(namespace string) v1beta1.APICatalogItemInterface {
+	return &FakeAPICatalogItems{c, namespace}
+}
+
+func (c *FakeHubV1beta1) APIPlans(namespace string) v1beta1.APIPlanInterface {
+	return &FakeAPIPlans{c, namespace}
+}
+
+func (c *FakeHubV1beta1) APIPortals(namespace string) v1beta1.APIPortalInterface {
+	return &FakeAPIPortals{c, namespace}
+}
+
+func (c *FakeHubV1beta1) APIPortalAuths(namespace string) v1beta1.APIPortalAuthInterface {
+	return &FakeAPIPortalAuths{c, namespace}
+}
+
+func (c *FakeHubV1beta1) APIVersions(namespace string) v1beta1.APIVersionInterface {
+	return &FakeAPIVersions{c, namespace}
+}
+
+func (c *FakeHubV1beta1) ContentItems(namespace string) v1beta1.ContentItemInterface {
+	return &FakeContentItems{c, namespace}
+}
+
+func (c *FakeHubV1beta1) ManagedApplications(namespace string) v1beta1.ManagedApplicationInterface {
+	return &FakeManagedApplications{c, namespace}
+}
+
+func (c *FakeHubV1beta1) ManagedSubscriptions(namespace string) v1beta1.ManagedSubscriptionInterface {
+	return &FakeManagedSubscriptions{c, namespace}
+}
+
+func (c *FakeHubV1beta1) Uplinks(namespace string) v1beta1.UplinkInterface {
+	return &FakeUplinks{c, namespace}
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *FakeHubV1beta1) RESTClient() rest.Interface {
+	var ret *rest.RESTClient
+	return ret
+}
@@ -0,0 +1,192 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1beta1 "github.com/traefik/hub-crds/pkg/apis/hub/v1beta1"
+	hubv1beta1 "github.com/traefik/hub-crds/pkg/client/applyconfiguration/hub/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeManagedApplications implements ManagedApplicationInterface
+type FakeManagedApplications struct {
+	Fake *FakeHubV1beta1
+	ns   string
+}
+
+var managedapplicationsResource = v1beta1.SchemeGroupVersion.WithResource("managedapplications")
+
+var managedapplicationsKind = v1beta1.SchemeGroupVersion.WithKind("ManagedApplication")
+
+// Get takes name of the managedApplication, and returns the corresponding managedApplication object, and an error if there is any.
+func (c *FakeManagedApplications) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.ManagedApplication, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(managedapplicationsResource, c.ns, name), &v1beta1.ManagedApplication{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ManagedApplication), err
+}
+
+// List takes label and field selectors, and returns the list of ManagedApplications that match those selectors.
+func (c *FakeManagedApplications) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.ManagedApplicationList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(managedapplicationsResource, managedapplicationsKind, c.ns, opts), &v1beta1.ManagedApplicationList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.ManagedApplicationList{ListMeta: obj.(*v1beta1.ManagedApplicationList).ListMeta}
+	for _, item := range obj.(*v1beta1.ManagedApplicationList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested managedApplications.
+func (c *FakeManagedApplications) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(managedapplicationsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a managedApplication and creates it.  Returns the server's representation of the managedApplication, and an error, if there is any.
+func (c *FakeManagedApplications) Create(ctx context.Context, managedApplication *v1beta1.ManagedApplication, opts v1.CreateOptions) (result *v1beta1.ManagedApplication, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(managedapplicationsResource, c.ns, managedApplication), &v1beta1.ManagedApplication{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ManagedApplication), err
+}
+
+// Update takes the representation of a managedApplication and updates it. Returns the server's representation of the managedApplication, and an error, if there is any.
+func (c *FakeManagedApplications) Update(ctx context.Context, managedApplication *v1beta1.ManagedApplication, opts v1.UpdateOptions) (result *v1beta1.ManagedApplication, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(managedapplicationsResource, c.ns, managedApplication), &v1beta1.ManagedApplication{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ManagedApplication), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeManagedApplications) UpdateStatus(ctx context.Context, managedApplication *v1beta1.ManagedApplication, opts v1.UpdateOptions) (*v1beta1.ManagedApplication, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(managedapplicationsResource, "status", c.ns, managedApplication), &v1beta1.ManagedApplication{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ManagedApplication), err
+}
+
+// Delete takes name of the managedApplication and deletes it. Returns an error if one occurs.
+func (c *FakeManagedApplications) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(managedapplicationsResource, c.ns, name, opts), &v1beta1.ManagedApplication{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeManagedApplications) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(managedapplicationsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.ManagedApplicationList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched managedApplication.
+func (c *FakeManagedApplications) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.ManagedApplication, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(managedapplicationsResource, c.ns, name, pt, data, subresources...), &v1beta1.ManagedApplication{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ManagedApplication), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied managedApplication.
+func (c *FakeManagedApplications) Apply(ctx context.Context, managedApplication *hubv1beta1.ManagedApplicationApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.ManagedApplication, err error) {
+	if managedApplication == nil {
+		return nil, fmt.Errorf("managedApplication provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(managedApplication)
+	if err != nil {
+		return nil, err
+	}
+	name := managedApplication.Name
+	if name == nil {
+		return nil, fmt.Errorf("managedApplication.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(managedapplicationsResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.ManagedApplication{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ManagedApplication), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeManagedApplications) ApplyStatus(ctx context.Context, managedApplication *hubv1beta1.ManagedApplicationApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.ManagedApplication, err error) {
+	if managedApplication == nil {
+		return nil, fmt.Errorf("managedApplication provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(managedApplication)
+	if err != nil {
+		return nil, err
+	}
+	name := managedApplication.Name
+	if name == nil {
+		return nil, fmt.Errorf("managedApplication.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(managedapplicationsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1beta1.ManagedApplication{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ManagedApplication), err
+}
@@ -0,0 +1,46 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+type APIExpansion interface{}
+
+type APIAuthExpansion interface{}
+
+type APIBundleExpansion interface{}
+
+type APICatalogItemExpansion interface{}
+
+type APIPlanExpansion interface{}
+
+type APIPortalExpansion interface{}
+
+type APIPortalAuthExpansion interface{}
+
+type APIVersionExpansion interface{}
+
+type ContentItemExpansion interface{}
+
+type ManagedApplicationExpansion interface{}
+
+type ManagedSubscriptionExpansion interface{}
+
+type UplinkExpansion interface{}
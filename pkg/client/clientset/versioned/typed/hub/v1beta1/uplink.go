@@ -0,0 +1,259 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+	"time"
+
+	v1beta1 "github.com/traefik/hub-crds/pkg/apis/hub/v1beta1"
+	hubv1beta1 "github.com/traefik/hub-crds/pkg/client/applyconfiguration/hub/v1beta1"
+	scheme "github.com/traefik/hub-crds/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// UplinksGetter has a method to return a UplinkInterface.
+// A group's client should implement this interface.
+type UplinksGetter interface {
+	Uplinks(namespace string) UplinkInterface
+}
+
+// UplinkInterface has methods to work with Uplink resources.
+type UplinkInterface interface {
+	Create(ctx context.Context, uplink *v1beta1.Uplink, opts v1.CreateOptions) (*v1beta1.Uplink, error)
+	Update(ctx context.Context, uplink *v1beta1.Uplink, opts v1.UpdateOptions) (*v1beta1.Uplink, error)
+	UpdateStatus(ctx context.Context, uplink *v1beta1.Uplink, opts v1.UpdateOptions) (*v1beta1.Uplink, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.Uplink, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.UplinkList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.Uplink, err error)
+	Apply(ctx context.Context, uplink *hubv1beta1.UplinkApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.Uplink, err error)
+	ApplyStatus(ctx context.Context, uplink *hubv1beta1.UplinkApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.Uplink, err error)
+	UplinkExpansion
+}
+
+// uplinks implements UplinkInterface
+type uplinks struct {
+	client rest.Interface
+	ns     string
+}
+
+// newUplinks returns a Uplinks
+func newUplinks(c *HubV1beta1Client, namespace string) *uplinks {
+	return &uplinks{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the uplink, and returns the corresponding uplink object, and an error if there is any.
+func (c *uplinks) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.Uplink, err error) {
+	result = &v1beta1.Uplink{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("uplinks").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Uplinks that match those selectors.
+func (c *uplinks) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.UplinkList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.UplinkList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("uplinks").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested uplinks.
+func (c *uplinks) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("uplinks").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a uplink and creates it.  Returns the server's representation of the uplink, and an error, if there is any.
+func (c *uplinks) Create(ctx context.Context, uplink *v1beta1.Uplink, opts v1.CreateOptions) (result *v1beta1.Uplink, err error) {
+	result = &v1beta1.Uplink{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("uplinks").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(uplink).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a uplink and updates it. Returns the server's representation of the uplink, and an error, if there is any.
+func (c *uplinks) Update(ctx context.Context, uplink *v1beta1.Uplink, opts v1.UpdateOptions) (result *v1beta1.Uplink, err error) {
+	result = &v1beta1.Uplink{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("uplinks").
+		Name(uplink.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(uplink).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *uplinks) UpdateStatus(ctx context.Context, uplink *v1beta1.Uplink, opts v1.UpdateOptions) (result *v1beta1.Uplink, err error) {
+	result = &v1beta1.Uplink{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("uplinks").
+		Name(uplink.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(uplink).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the uplink and deletes it. Returns an error if one occurs.
+func (c *uplinks) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("uplinks").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *uplinks) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("uplinks").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched uplink.
+func (c *uplinks) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.Uplink, err error) {
+	result = &v1beta1.Uplink{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("uplinks").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied uplink.
+func (c *uplinks) Apply(ctx context.Context, uplink *hubv1beta1.UplinkApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.Uplink, err error) {
+	if uplink == nil {
+		return nil, fmt.Errorf("uplink provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(uplink)
+	if err != nil {
+		return nil, err
+	}
+	name := uplink.Name
+	if name == nil {
+		return nil, fmt.Errorf("uplink.Name must be provided to Apply")
+	}
+	result = &v1beta1.Uplink{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("uplinks").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *uplinks) ApplyStatus(ctx context.Context, uplink *hubv1beta1.UplinkApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.Uplink, err error) {
+	if uplink == nil {
+		return nil, fmt.Errorf("uplink provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(uplink)
+	if err != nil {
+		return nil, err
+	}
+
+	name := uplink.Name
+	if name == nil {
+		return nil, fmt.Errorf("uplink.Name must be provided to Apply")
+	}
+
+	result = &v1beta1.Uplink{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("uplinks").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
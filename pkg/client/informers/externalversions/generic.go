@@ -25,6 +25,7 @@ import (
 	"fmt"
 
 	v1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	v1beta1 "github.com/traefik/hub-crds/pkg/apis/hub/v1beta1"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
 )
@@ -87,6 +88,32 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	case v1alpha1.SchemeGroupVersion.WithResource("uplinks"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Hub().V1alpha1().Uplinks().Informer()}, nil
 
+		// Group=hub.traefik.io, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithResource("apis"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Hub().V1beta1().APIs().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("apiauths"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Hub().V1beta1().APIAuths().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("apibundles"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Hub().V1beta1().APIBundles().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("apicatalogitems"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Hub().V1beta1().APICatalogItems().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("apiplans"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Hub().V1beta1().APIPlans().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("apiportals"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Hub().V1beta1().APIPortals().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("apiportalauths"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Hub().V1beta1().APIPortalAuths().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("apiversions"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Hub().V1beta1().APIVersions().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("contentitems"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Hub().V1beta1().ContentItems().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("managedapplications"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Hub().V1beta1().ManagedApplications().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("managedsubscriptions"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Hub().V1beta1().ManagedSubscriptions().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("uplinks"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Hub().V1beta1().Uplinks().Informer()}, nil
+
 	}
 
 	return nil, fmt.Errorf("no informer found for %v", resource)
@@ -0,0 +1,50 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	"github.com/traefik/hub-crds/pkg/index"
+	"k8s.io/client-go/tools/cache"
+)
+
+// AddReferenceIndexers adds the field indexes of the reference fields to the informers of the kinds holding them,
+// so that the reverse lookups of the listers, such as ManagedSubscriptions(namespace).ByAPIPlan(name), do not scan
+// the namespace. It must be called before the informers are started.
+func AddReferenceIndexers(informers Interface) error {
+	for _, referrer := range []struct {
+		obj      index.Object
+		informer cache.SharedIndexInformer
+	}{
+		{obj: &hubv1alpha1.AIService{}, informer: informers.AIServices().Informer()},
+		{obj: &hubv1alpha1.API{}, informer: informers.APIs().Informer()},
+		{obj: &hubv1alpha1.APIBundle{}, informer: informers.APIBundles().Informer()},
+		{obj: &hubv1alpha1.APICatalogItem{}, informer: informers.APICatalogItems().Informer()},
+		{obj: &hubv1alpha1.APIPortal{}, informer: informers.APIPortals().Informer()},
+		{obj: &hubv1alpha1.ContentItem{}, informer: informers.ContentItems().Informer()},
+		{obj: &hubv1alpha1.ManagedSubscription{}, informer: informers.ManagedSubscriptions().Informer()},
+	} {
+		if err := referrer.informer.AddIndexers(index.Indexers(referrer.obj)); err != nil {
+			return fmt.Errorf("adding %T indexers: %w", referrer.obj, err)
+		}
+	}
+
+	return nil
+}
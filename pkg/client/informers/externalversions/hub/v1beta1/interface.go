@@ -0,0 +1,125 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	internalinterfaces "github.com/traefik/hub-crds/pkg/client/informers/externalversions/internalinterfaces"
+)
+
+// Interface provides access to all the informers in this group version.
+type Interface interface {
+	// APIs returns a APIInformer.
+	APIs() APIInformer
+	// APIAuths returns a APIAuthInformer.
+	APIAuths() APIAuthInformer
+	// APIBundles returns a APIBundleInformer.
+	APIBundles() APIBundleInformer
+	// APICatalogItems returns a APICatalogItemInformer.
+	APICatalogItems() APICatalogItemInformer
+	// APIPlans returns a APIPlanInformer.
+	APIPlans() APIPlanInformer
+	// APIPortals returns a APIPortalInformer.
+	APIPortals() APIPortalInformer
+	// APIPortalAuths returns a APIPortalAuthInformer.
+	APIPortalAuths() APIPortalAuthInformer
+	// APIVersions returns a APIVersionInformer.
+	APIVersions() APIVersionInformer
+	// ContentItems returns a ContentItemInformer.
+	ContentItems() ContentItemInformer
+	// ManagedApplications returns a ManagedApplicationInformer.
+	ManagedApplications() ManagedApplicationInformer
+	// ManagedSubscriptions returns a ManagedSubscriptionInformer.
+	ManagedSubscriptions() ManagedSubscriptionInformer
+	// Uplinks returns a UplinkInformer.
+	Uplinks() UplinkInformer
+}
+
+type version struct {
+	factory          internalinterfaces.SharedInformerFactory
+	namespace        string
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// New returns a new Interface.
+func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakListOptions internalinterfaces.TweakListOptionsFunc) Interface {
+	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
+}
+
+// APIs returns a APIInformer.
+func (v *version) APIs() APIInformer {
+	return &aPIInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// APIAuths returns a APIAuthInformer.
+func (v *version) APIAuths() APIAuthInformer {
+	return &aPIAuthInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// APIBundles returns a APIBundleInformer.
+func (v *version) APIBundles() APIBundleInformer {
+	return &aPIBundleInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// APICatalogItems returns a APICatalogItemInformer.
+func (v *version) APICatalogItems() APICatalogItemInformer {
+	return &aPICatalogItemInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// APIPlans returns a APIPlanInformer.
+func (v *version) APIPlans() APIPlanInformer {
+	return &aPIPlanInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// APIPortals returns a APIPortalInformer.
+func (v *version) APIPortals() APIPortalInformer {
+	return &aPIPortalInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// APIPortalAuths returns a APIPortalAuthInformer.
+func (v *version) APIPortalAuths() APIPortalAuthInformer {
+	return &aPIPortalAuthInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// APIVersions returns a APIVersionInformer.
+func (v *version) APIVersions() APIVersionInformer {
+	return &aPIVersionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ContentItems returns a ContentItemInformer.
+func (v *version) ContentItems() ContentItemInformer {
+	return &contentItemInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ManagedApplications returns a ManagedApplicationInformer.
+func (v *version) ManagedApplications() ManagedApplicationInformer {
+	return &managedApplicationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ManagedSubscriptions returns a ManagedSubscriptionInformer.
+func (v *version) ManagedSubscriptions() ManagedSubscriptionInformer {
+	return &managedSubscriptionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// Uplinks returns a UplinkInformer.
+func (v *version) Uplinks() UplinkInformer {
+	return &uplinkInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -0,0 +1,44 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	"github.com/traefik/hub-crds/pkg/index"
+)
+
+// AIServiceListerExpansion allows custom methods to be added to
+// AIServiceLister.
+type AIServiceListerExpansion interface{}
+
+// AIServiceNamespaceListerExpansion allows custom methods to be added to
+// AIServiceNamespaceLister.
+type AIServiceNamespaceListerExpansion interface {
+	// ByBackend lists the AIServices routing requests to the given AIService.
+	// Objects returned here must be treated as read-only.
+	ByBackend(name string) (ret []*v1alpha1.AIService, err error)
+}
+
+// ByBackend lists the AIServices routing requests to the given AIService.
+func (s aIServiceNamespaceLister) ByBackend(name string) (ret []*v1alpha1.AIService, err error) {
+	err = byIndex(s.indexer, &v1alpha1.AIService{}, s.namespace, index.FieldAIServiceBackendName, name, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.AIService))
+	})
+
+	return ret, err
+}
@@ -0,0 +1,70 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	"github.com/traefik/hub-crds/pkg/index"
+)
+
+// APICatalogItemListerExpansion allows custom methods to be added to
+// APICatalogItemLister.
+type APICatalogItemListerExpansion interface{}
+
+// APICatalogItemNamespaceListerExpansion allows custom methods to be added to
+// APICatalogItemNamespaceLister.
+type APICatalogItemNamespaceListerExpansion interface {
+	// ByAPIPlan lists the APICatalogItems referencing the given APIPlan.
+	// Objects returned here must be treated as read-only.
+	ByAPIPlan(name string) (ret []*v1alpha1.APICatalogItem, err error)
+
+	// ByAPI lists the APICatalogItems referencing the given API.
+	// Objects returned here must be treated as read-only.
+	ByAPI(name string) (ret []*v1alpha1.APICatalogItem, err error)
+
+	// ByAPIBundle lists the APICatalogItems referencing the given APIBundle.
+	// Objects returned here must be treated as read-only.
+	ByAPIBundle(name string) (ret []*v1alpha1.APICatalogItem, err error)
+}
+
+// ByAPIPlan lists the APICatalogItems referencing the given APIPlan.
+func (s aPICatalogItemNamespaceLister) ByAPIPlan(name string) (ret []*v1alpha1.APICatalogItem, err error) {
+	err = byIndex(s.indexer, &v1alpha1.APICatalogItem{}, s.namespace, index.FieldAPIPlanName, name, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.APICatalogItem))
+	})
+
+	return ret, err
+}
+
+// ByAPI lists the APICatalogItems referencing the given API.
+func (s aPICatalogItemNamespaceLister) ByAPI(name string) (ret []*v1alpha1.APICatalogItem, err error) {
+	err = byIndex(s.indexer, &v1alpha1.APICatalogItem{}, s.namespace, index.FieldAPIName, name, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.APICatalogItem))
+	})
+
+	return ret, err
+}
+
+// ByAPIBundle lists the APICatalogItems referencing the given APIBundle.
+func (s aPICatalogItemNamespaceLister) ByAPIBundle(name string) (ret []*v1alpha1.APICatalogItem, err error) {
+	err = byIndex(s.indexer, &v1alpha1.APICatalogItem{}, s.namespace, index.FieldAPIBundleName, name, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.APICatalogItem))
+	})
+
+	return ret, err
+}
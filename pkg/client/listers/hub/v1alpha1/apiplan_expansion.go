@@ -0,0 +1,62 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	v1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+)
+
+// APIPlanListerExpansion allows custom methods to be added to
+// APIPlanLister.
+type APIPlanListerExpansion interface{}
+
+// APIPlanNamespaceListerExpansion allows custom methods to be added to
+// APIPlanNamespaceLister.
+type APIPlanNamespaceListerExpansion interface {
+	// ReferencedBy lists the APICatalogItems and the ManagedSubscriptions referencing the given APIPlan,
+	// using the given listers of the referencing kinds.
+	// Objects returned here must be treated as read-only.
+	ReferencedBy(name string, catalogItems APICatalogItemLister, subscriptions ManagedSubscriptionLister) (APIPlanReferrers, error)
+}
+
+// APIPlanReferrers are the objects referencing an APIPlan.
+type APIPlanReferrers struct {
+	APICatalogItems      []*v1alpha1.APICatalogItem
+	ManagedSubscriptions []*v1alpha1.ManagedSubscription
+}
+
+// ReferencedBy lists the APICatalogItems and the ManagedSubscriptions referencing the given APIPlan.
+// The lookups use the field indexes of the referencing informers when AddReferenceIndexers has been called on them.
+func (s aPIPlanNamespaceLister) ReferencedBy(name string, catalogItems APICatalogItemLister, subscriptions ManagedSubscriptionLister) (APIPlanReferrers, error) {
+	var referrers APIPlanReferrers
+
+	var err error
+	referrers.APICatalogItems, err = catalogItems.APICatalogItems(s.namespace).ByAPIPlan(name)
+	if err != nil {
+		return APIPlanReferrers{}, fmt.Errorf("listing APICatalogItems: %w", err)
+	}
+
+	referrers.ManagedSubscriptions, err = subscriptions.ManagedSubscriptions(s.namespace).ByAPIPlan(name)
+	if err != nil {
+		return APIPlanReferrers{}, fmt.Errorf("listing ManagedSubscriptions: %w", err)
+	}
+
+	return referrers, nil
+}
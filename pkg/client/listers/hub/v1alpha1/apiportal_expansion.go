@@ -0,0 +1,44 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	"github.com/traefik/hub-crds/pkg/index"
+)
+
+// APIPortalListerExpansion allows custom methods to be added to
+// APIPortalLister.
+type APIPortalListerExpansion interface{}
+
+// APIPortalNamespaceListerExpansion allows custom methods to be added to
+// APIPortalNamespaceLister.
+type APIPortalNamespaceListerExpansion interface {
+	// ByAuth lists the APIPortals referencing the given APIPortalAuth.
+	// Objects returned here must be treated as read-only.
+	ByAuth(name string) (ret []*v1alpha1.APIPortal, err error)
+}
+
+// ByAuth lists the APIPortals referencing the given APIPortalAuth.
+func (s aPIPortalNamespaceLister) ByAuth(name string) (ret []*v1alpha1.APIPortal, err error) {
+	err = byIndex(s.indexer, &v1alpha1.APIPortal{}, s.namespace, index.FieldAuthName, name, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.APIPortal))
+	})
+
+	return ret, err
+}
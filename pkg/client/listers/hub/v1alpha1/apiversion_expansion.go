@@ -0,0 +1,38 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package v1alpha1
+
+import v1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+
+// APIVersionListerExpansion allows custom methods to be added to
+// APIVersionLister.
+type APIVersionListerExpansion interface{}
+
+// APIVersionNamespaceListerExpansion allows custom methods to be added to
+// APIVersionNamespaceLister.
+type APIVersionNamespaceListerExpansion interface {
+	// OwningAPIs lists the APIs referencing the given APIVersion, using the given API lister.
+	// Objects returned here must be treated as read-only.
+	OwningAPIs(name string, apis APILister) (ret []*v1alpha1.API, err error)
+}
+
+// OwningAPIs lists the APIs referencing the given APIVersion.
+// The lookup uses the field index of the API informer when AddReferenceIndexers has been called on it.
+func (s aPIVersionNamespaceLister) OwningAPIs(name string, apis APILister) (ret []*v1alpha1.API, err error) {
+	return apis.APIs(s.namespace).ByVersion(name)
+}
@@ -0,0 +1,44 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	"github.com/traefik/hub-crds/pkg/index"
+)
+
+// ContentItemListerExpansion allows custom methods to be added to
+// ContentItemLister.
+type ContentItemListerExpansion interface{}
+
+// ContentItemNamespaceListerExpansion allows custom methods to be added to
+// ContentItemNamespaceLister.
+type ContentItemNamespaceListerExpansion interface {
+	// ForParent lists the ContentItems whose parent is the given APIPortal, API or APIBundle.
+	// Objects returned here must be treated as read-only.
+	ForParent(kind, name string) (ret []*v1alpha1.ContentItem, err error)
+}
+
+// ForParent lists the ContentItems whose parent is the given APIPortal, API or APIBundle.
+func (s contentItemNamespaceLister) ForParent(kind, name string) (ret []*v1alpha1.ContentItem, err error) {
+	err = byIndex(s.indexer, &v1alpha1.ContentItem{}, s.namespace, index.FieldParentRef, index.ParentRef(kind, name), func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ContentItem))
+	})
+
+	return ret, err
+}
@@ -21,22 +21,6 @@ along with this program.  If not, see <https://www.gnu.org/licenses/>.
 
 package v1alpha1
 
-// AIServiceListerExpansion allows custom methods to be added to
-// AIServiceLister.
-type AIServiceListerExpansion interface{}
-
-// AIServiceNamespaceListerExpansion allows custom methods to be added to
-// AIServiceNamespaceLister.
-type AIServiceNamespaceListerExpansion interface{}
-
-// APIListerExpansion allows custom methods to be added to
-// APILister.
-type APIListerExpansion interface{}
-
-// APINamespaceListerExpansion allows custom methods to be added to
-// APINamespaceLister.
-type APINamespaceListerExpansion interface{}
-
 // APIAuthListerExpansion allows custom methods to be added to
 // APIAuthLister.
 type APIAuthListerExpansion interface{}
@@ -45,38 +29,6 @@ type APIAuthListerExpansion interface{}
 // APIAuthNamespaceLister.
 type APIAuthNamespaceListerExpansion interface{}
 
-// APIBundleListerExpansion allows custom methods to be added to
-// APIBundleLister.
-type APIBundleListerExpansion interface{}
-
-// APIBundleNamespaceListerExpansion allows custom methods to be added to
-// APIBundleNamespaceLister.
-type APIBundleNamespaceListerExpansion interface{}
-
-// APICatalogItemListerExpansion allows custom methods to be added to
-// APICatalogItemLister.
-type APICatalogItemListerExpansion interface{}
-
-// APICatalogItemNamespaceListerExpansion allows custom methods to be added to
-// APICatalogItemNamespaceLister.
-type APICatalogItemNamespaceListerExpansion interface{}
-
-// APIPlanListerExpansion allows custom methods to be added to
-// APIPlanLister.
-type APIPlanListerExpansion interface{}
-
-// APIPlanNamespaceListerExpansion allows custom methods to be added to
-// APIPlanNamespaceLister.
-type APIPlanNamespaceListerExpansion interface{}
-
-// APIPortalListerExpansion allows custom methods to be added to
-// APIPortalLister.
-type APIPortalListerExpansion interface{}
-
-// APIPortalNamespaceListerExpansion allows custom methods to be added to
-// APIPortalNamespaceLister.
-type APIPortalNamespaceListerExpansion interface{}
-
 // APIPortalAuthListerExpansion allows custom methods to be added to
 // APIPortalAuthLister.
 type APIPortalAuthListerExpansion interface{}
@@ -93,26 +45,10 @@ type APIRateLimitListerExpansion interface{}
 // APIRateLimitNamespaceLister.
 type APIRateLimitNamespaceListerExpansion interface{}
 
-// APIVersionListerExpansion allows custom methods to be added to
-// APIVersionLister.
-type APIVersionListerExpansion interface{}
-
-// APIVersionNamespaceListerExpansion allows custom methods to be added to
-// APIVersionNamespaceLister.
-type APIVersionNamespaceListerExpansion interface{}
-
 // AccessControlPolicyListerExpansion allows custom methods to be added to
 // AccessControlPolicyLister.
 type AccessControlPolicyListerExpansion interface{}
 
-// ContentItemListerExpansion allows custom methods to be added to
-// ContentItemLister.
-type ContentItemListerExpansion interface{}
-
-// ContentItemNamespaceListerExpansion allows custom methods to be added to
-// ContentItemNamespaceLister.
-type ContentItemNamespaceListerExpansion interface{}
-
 // ManagedApplicationListerExpansion allows custom methods to be added to
 // ManagedApplicationLister.
 type ManagedApplicationListerExpansion interface{}
@@ -121,14 +57,6 @@ type ManagedApplicationListerExpansion interface{}
 // ManagedApplicationNamespaceLister.
 type ManagedApplicationNamespaceListerExpansion interface{}
 
-// ManagedSubscriptionListerExpansion allows custom methods to be added to
-// ManagedSubscriptionLister.
-type ManagedSubscriptionListerExpansion interface{}
-
-// ManagedSubscriptionNamespaceListerExpansion allows custom methods to be added to
-// ManagedSubscriptionNamespaceLister.
-type ManagedSubscriptionNamespaceListerExpansion interface{}
-
 // UplinkListerExpansion allows custom methods to be added to
 // UplinkLister.
 type UplinkListerExpansion interface{}
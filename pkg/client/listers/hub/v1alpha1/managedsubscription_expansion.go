@@ -0,0 +1,83 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	"github.com/traefik/hub-crds/pkg/index"
+)
+
+// ManagedSubscriptionListerExpansion allows custom methods to be added to
+// ManagedSubscriptionLister.
+type ManagedSubscriptionListerExpansion interface{}
+
+// ManagedSubscriptionNamespaceListerExpansion allows custom methods to be added to
+// ManagedSubscriptionNamespaceLister.
+type ManagedSubscriptionNamespaceListerExpansion interface {
+	// ByAPIPlan lists the ManagedSubscriptions referencing the given APIPlan.
+	// Objects returned here must be treated as read-only.
+	ByAPIPlan(name string) (ret []*v1alpha1.ManagedSubscription, err error)
+
+	// ByAPI lists the ManagedSubscriptions referencing the given API.
+	// Objects returned here must be treated as read-only.
+	ByAPI(name string) (ret []*v1alpha1.ManagedSubscription, err error)
+
+	// ByAPIBundle lists the ManagedSubscriptions referencing the given APIBundle.
+	// Objects returned here must be treated as read-only.
+	ByAPIBundle(name string) (ret []*v1alpha1.ManagedSubscription, err error)
+
+	// ByManagedApplication lists the ManagedSubscriptions referencing the given ManagedApplication.
+	// Objects returned here must be treated as read-only.
+	ByManagedApplication(name string) (ret []*v1alpha1.ManagedSubscription, err error)
+}
+
+// ByAPIPlan lists the ManagedSubscriptions referencing the given APIPlan.
+func (s managedSubscriptionNamespaceLister) ByAPIPlan(name string) (ret []*v1alpha1.ManagedSubscription, err error) {
+	err = byIndex(s.indexer, &v1alpha1.ManagedSubscription{}, s.namespace, index.FieldAPIPlanName, name, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ManagedSubscription))
+	})
+
+	return ret, err
+}
+
+// ByAPI lists the ManagedSubscriptions referencing the given API.
+func (s managedSubscriptionNamespaceLister) ByAPI(name string) (ret []*v1alpha1.ManagedSubscription, err error) {
+	err = byIndex(s.indexer, &v1alpha1.ManagedSubscription{}, s.namespace, index.FieldAPIName, name, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ManagedSubscription))
+	})
+
+	return ret, err
+}
+
+// ByAPIBundle lists the ManagedSubscriptions referencing the given APIBundle.
+func (s managedSubscriptionNamespaceLister) ByAPIBundle(name string) (ret []*v1alpha1.ManagedSubscription, err error) {
+	err = byIndex(s.indexer, &v1alpha1.ManagedSubscription{}, s.namespace, index.FieldAPIBundleName, name, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ManagedSubscription))
+	})
+
+	return ret, err
+}
+
+// ByManagedApplication lists the ManagedSubscriptions referencing the given ManagedApplication.
+func (s managedSubscriptionNamespaceLister) ByManagedApplication(name string) (ret []*v1alpha1.ManagedSubscription, err error) {
+	err = byIndex(s.indexer, &v1alpha1.ManagedSubscription{}, s.namespace, index.FieldManagedApplicationName, name, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ManagedSubscription))
+	})
+
+	return ret, err
+}
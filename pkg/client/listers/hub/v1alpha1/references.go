@@ -0,0 +1,69 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/traefik/hub-crds/pkg/index"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// byIndex calls fn for each object of the namespace whose indexed field has the given value.
+// It uses the field index when it has been added to the informer, see the informers AddReferenceIndexers function,
+// and falls back to a scan of the namespace otherwise.
+func byIndex(indexer cache.Indexer, kind index.Object, namespace, field, value string, fn func(obj interface{})) error {
+	key := index.Key(namespace, value)
+
+	if _, ok := indexer.GetIndexers()[field]; ok {
+		objs, err := indexer.ByIndex(field, key)
+		if err != nil {
+			return fmt.Errorf("listing by index %q: %w", field, err)
+		}
+
+		for _, obj := range objs {
+			fn(obj)
+		}
+
+		return nil
+	}
+
+	indexFunc, ok := index.Indexers(kind)[field]
+	if !ok {
+		return fmt.Errorf("unsupported index %q for %T", field, kind)
+	}
+
+	var indexErr error
+	err := cache.ListAllByNamespace(indexer, namespace, labels.Everything(), func(obj interface{}) {
+		keys, err := indexFunc(obj)
+		if err != nil {
+			indexErr = err
+			return
+		}
+		if slices.Contains(keys, key) {
+			fn(obj)
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("listing namespace %q: %w", namespace, err)
+	}
+
+	return indexErr
+}
@@ -0,0 +1,186 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package v1alpha1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	"github.com/traefik/hub-crds/pkg/client/clientset/versioned/fake"
+	"github.com/traefik/hub-crds/pkg/client/informers/externalversions"
+	informers "github.com/traefik/hub-crds/pkg/client/informers/externalversions/hub/v1alpha1"
+	listers "github.com/traefik/hub-crds/pkg/client/listers/hub/v1alpha1"
+	"github.com/traefik/hub-crds/pkg/index"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestManagedSubscriptionNamespaceLister_ByAPIPlan(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc     string
+		indexers cache.Indexers
+	}{
+		{
+			desc:     "indexed",
+			indexers: index.Indexers(&hubv1alpha1.ManagedSubscription{}),
+		},
+		{
+			desc:     "not indexed",
+			indexers: cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, test.indexers)
+			for _, sub := range []*hubv1alpha1.ManagedSubscription{
+				newSubscription("default", "gold-sub", "gold"),
+				newSubscription("default", "silver-sub", "silver"),
+				newSubscription("other", "other-gold-sub", "gold"),
+			} {
+				require.NoError(t, indexer.Add(sub))
+			}
+
+			subs, err := listers.NewManagedSubscriptionLister(indexer).ManagedSubscriptions("default").ByAPIPlan("gold")
+			require.NoError(t, err)
+			require.Len(t, subs, 1)
+			assert.Equal(t, "gold-sub", subs[0].Name)
+
+			subs, err = listers.NewManagedSubscriptionLister(indexer).ManagedSubscriptions("default").ByAPIPlan("bronze")
+			require.NoError(t, err)
+			assert.Empty(t, subs)
+		})
+	}
+}
+
+func TestContentItemNamespaceLister_ForParent(t *testing.T) {
+	t.Parallel()
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, index.Indexers(&hubv1alpha1.ContentItem{}))
+	require.NoError(t, indexer.Add(newContentItem("api-doc", "API", "users")))
+	require.NoError(t, indexer.Add(newContentItem("bundle-doc", "APIBundle", "users")))
+
+	items, err := listers.NewContentItemLister(indexer).ContentItems("default").ForParent("APIBundle", "users")
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "bundle-doc", items[0].Name)
+}
+
+func TestAddReferenceIndexers(t *testing.T) {
+	t.Parallel()
+
+	client := fake.NewSimpleClientset(&hubv1alpha1.API{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "users"},
+		Spec:       hubv1alpha1.APISpec{Versions: []hubv1alpha1.APIVersionRef{{Name: "users-v1"}}},
+	})
+
+	factory := externalversions.NewSharedInformerFactory(client, 0)
+	require.NoError(t, informers.AddReferenceIndexers(factory.Hub().V1alpha1()))
+
+	apiInformer := factory.Hub().V1alpha1().APIs()
+	assert.Contains(t, apiInformer.Informer().GetIndexer().GetIndexers(), index.FieldVersionName)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	factory.Start(stopCh)
+	factory.WaitForCacheSync(stopCh)
+
+	apis, err := apiInformer.Lister().APIs("default").ByVersion("users-v1")
+	require.NoError(t, err)
+	require.Len(t, apis, 1)
+	assert.Equal(t, "users", apis[0].Name)
+}
+
+func TestAPIPlanNamespaceLister_ReferencedBy(t *testing.T) {
+	t.Parallel()
+
+	client := fake.NewSimpleClientset(
+		&hubv1alpha1.APIPlan{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gold"}},
+		&hubv1alpha1.APICatalogItem{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "catalog"},
+			Spec:       hubv1alpha1.APICatalogItemSpec{APIPlan: &hubv1alpha1.APIPlanReference{Name: "gold"}},
+		},
+		newSubscription("default", "gold-sub", "gold"),
+		newSubscription("default", "silver-sub", "silver"),
+		newSubscription("other", "other-gold-sub", "gold"),
+	)
+
+	factory := externalversions.NewSharedInformerFactory(client, 0)
+	hub := factory.Hub().V1alpha1()
+	require.NoError(t, informers.AddReferenceIndexers(hub))
+
+	plans := hub.APIPlans().Lister()
+	catalogItems := hub.APICatalogItems().Lister()
+	subscriptions := hub.ManagedSubscriptions().Lister()
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	factory.Start(stopCh)
+	factory.WaitForCacheSync(stopCh)
+
+	referrers, err := plans.APIPlans("default").ReferencedBy("gold", catalogItems, subscriptions)
+	require.NoError(t, err)
+	require.Len(t, referrers.APICatalogItems, 1)
+	assert.Equal(t, "catalog", referrers.APICatalogItems[0].Name)
+	require.Len(t, referrers.ManagedSubscriptions, 1)
+	assert.Equal(t, "gold-sub", referrers.ManagedSubscriptions[0].Name)
+
+	referrers, err = plans.APIPlans("default").ReferencedBy("bronze", catalogItems, subscriptions)
+	require.NoError(t, err)
+	assert.Empty(t, referrers.APICatalogItems)
+	assert.Empty(t, referrers.ManagedSubscriptions)
+}
+
+func TestAPIVersionNamespaceLister_OwningAPIs(t *testing.T) {
+	t.Parallel()
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, index.Indexers(&hubv1alpha1.API{}))
+	require.NoError(t, indexer.Add(&hubv1alpha1.API{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "users"},
+		Spec:       hubv1alpha1.APISpec{Versions: []hubv1alpha1.APIVersionRef{{Name: "users-v1"}}},
+	}))
+
+	versions := listers.NewAPIVersionLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{}))
+
+	apis, err := versions.APIVersions("default").OwningAPIs("users-v1", listers.NewAPILister(indexer))
+	require.NoError(t, err)
+	require.Len(t, apis, 1)
+	assert.Equal(t, "users", apis[0].Name)
+}
+
+func newSubscription(namespace, name, plan string) *hubv1alpha1.ManagedSubscription {
+	return &hubv1alpha1.ManagedSubscription{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec:       hubv1alpha1.ManagedSubscriptionSpec{APIPlan: hubv1alpha1.APIPlanReference{Name: plan}},
+	}
+}
+
+func newContentItem(name, parentKind, parentName string) *hubv1alpha1.ContentItem {
+	return &hubv1alpha1.ContentItem{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+		Spec:       hubv1alpha1.ContentItemSpec{ParentRef: hubv1alpha1.ContentItemParentRef{Kind: parentKind, Name: parentName}},
+	}
+}
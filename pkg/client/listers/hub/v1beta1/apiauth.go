@@ -0,0 +1,102 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/traefik/hub-crds/pkg/apis/hub/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// APIAuthLister helps list APIAuths.
+// All objects returned here must be treated as read-only.
+type APIAuthLister interface {
+	// List lists all APIAuths in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.APIAuth, err error)
+	// APIAuths returns an object that can list and get APIAuths.
+	APIAuths(namespace string) APIAuthNamespaceLister
+	APIAuthListerExpansion
+}
+
+// aPIAuthLister implements the APIAuthLister interface.
+type aPIAuthLister struct {
+	indexer cache.Indexer
+}
+
+// NewAPIAuthLister returns a new APIAuthLister.
+func NewAPIAuthLister(indexer cache.Indexer) APIAuthLister {
+	return &aPIAuthLister{indexer: indexer}
+}
+
+// List lists all APIAuths in the indexer.
+func (s *aPIAuthLister) List(selector labels.Selector) (ret []*v1beta1.APIAuth, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.APIAuth))
+	})
+	return ret, err
+}
+
+// APIAuths returns an object that can list and get APIAuths.
+func (s *aPIAuthLister) APIAuths(namespace string) APIAuthNamespaceLister {
+	return aPIAuthNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// APIAuthNamespaceLister helps list and get APIAuths.
+// All objects returned here must be treated as read-only.
+type APIAuthNamespaceLister interface {
+	// List lists all APIAuths in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.APIAuth, err error)
+	// Get retrieves the APIAuth from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.APIAuth, error)
+	APIAuthNamespaceListerExpansion
+}
+
+// aPIAuthNamespaceLister implements the APIAuthNamespaceLister
+// interface.
+type aPIAuthNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all APIAuths in the indexer for a given namespace.
+func (s aPIAuthNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.APIAuth, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.APIAuth))
+	})
+	return ret, err
+}
+
+// Get retrieves the APIAuth from the indexer for a given namespace and name.
+func (s aPIAuthNamespaceLister) Get(name string) (*v1beta1.APIAuth, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("apiauth"), name)
+	}
+	return obj.(*v1beta1.APIAuth), nil
+}
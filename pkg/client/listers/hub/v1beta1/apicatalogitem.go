@@ -0,0 +1,102 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/traefik/hub-crds/pkg/apis/hub/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// APICatalogItemLister helps list APICatalogItems.
+// All objects returned here must be treated as read-only.
+type APICatalogItemLister interface {
+	// List lists all APICatalogItems in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.APICatalogItem, err error)
+	// APICatalogItems returns an object that can list and get APICatalogItems.
+	APICatalogItems(namespace string) APICatalogItemNamespaceLister
+	APICatalogItemListerExpansion
+}
+
+// aPICatalogItemLister implements the APICatalogItemLister interface.
+type aPICatalogItemLister struct {
+	indexer cache.Indexer
+}
+
+// NewAPICatalogItemLister returns a new APICatalogItemLister.
+func NewAPICatalogItemLister(indexer cache.Indexer) APICatalogItemLister {
+	return &aPICatalogItemLister{indexer: indexer}
+}
+
+// List lists all APICatalogItems in the indexer.
+func (s *aPICatalogItemLister) List(selector labels.Selector) (ret []*v1beta1.APICatalogItem, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.APICatalogItem))
+	})
+	return ret, err
+}
+
+// APICatalogItems returns an object that can list and get APICatalogItems.
+func (s *aPICatalogItemLister) APICatalogItems(namespace string) APICatalogItemNamespaceLister {
+	return aPICatalogItemNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// APICatalogItemNamespaceLister helps list and get APICatalogItems.
+// All objects returned here must be treated as read-only.
+type APICatalogItemNamespaceLister interface {
+	// List lists all APICatalogItems in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.APICatalogItem, err error)
+	// Get retrieves the APICatalogItem from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.APICatalogItem, error)
+	APICatalogItemNamespaceListerExpansion
+}
+
+// aPICatalogItemNamespaceLister implements the APICatalogItemNamespaceLister
+// interface.
+type aPICatalogItemNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all APICatalogItems in the indexer for a given namespace.
+func (s aPICatalogItemNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.APICatalogItem, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.APICatalogItem))
+	})
+	return ret, err
+}
+
+// Get retrieves the APICatalogItem from the indexer for a given namespace and name.
+func (s aPICatalogItemNamespaceLister) Get(name string) (*v1beta1.APICatalogItem, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("apicatalogitem"), name)
+	}
+	return obj.(*v1beta1.APICatalogItem), nil
+}
@@ -0,0 +1,102 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/traefik/hub-crds/pkg/apis/hub/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// APIPlanLister helps list APIPlans.
+// All objects returned here must be treated as read-only.
+type APIPlanLister interface {
+	// List lists all APIPlans in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.APIPlan, err error)
+	// APIPlans returns an object that can list and get APIPlans.
+	APIPlans(namespace string) APIPlanNamespaceLister
+	APIPlanListerExpansion
+}
+
+// aPIPlanLister implements the APIPlanLister interface.
+type aPIPlanLister struct {
+	indexer cache.Indexer
+}
+
+// NewAPIPlanLister returns a new APIPlanLister.
+func NewAPIPlanLister(indexer cache.Indexer) APIPlanLister {
+	return &aPIPlanLister{indexer: indexer}
+}
+
+// List lists all APIPlans in the indexer.
+func (s *aPIPlanLister) List(selector labels.Selector) (ret []*v1beta1.APIPlan, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.APIPlan))
+	})
+	return ret, err
+}
+
+// APIPlans returns an object that can list and get APIPlans.
+func (s *aPIPlanLister) APIPlans(namespace string) APIPlanNamespaceLister {
+	return aPIPlanNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// APIPlanNamespaceLister helps list and get APIPlans.
+// All objects returned here must be treated as read-only.
+type APIPlanNamespaceLister interface {
+	// List lists all APIPlans in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.APIPlan, err error)
+	// Get retrieves the APIPlan from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.APIPlan, error)
+	APIPlanNamespaceListerExpansion
+}
+
+// aPIPlanNamespaceLister implements the APIPlanNamespaceLister
+// interface.
+type aPIPlanNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all APIPlans in the indexer for a given namespace.
+func (s aPIPlanNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.APIPlan, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.APIPlan))
+	})
+	return ret, err
+}
+
+// Get retrieves the APIPlan from the indexer for a given namespace and name.
+func (s aPIPlanNamespaceLister) Get(name string) (*v1beta1.APIPlan, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("apiplan"), name)
+	}
+	return obj.(*v1beta1.APIPlan), nil
+}
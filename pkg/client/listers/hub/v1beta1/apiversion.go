@@ -0,0 +1,102 @@
+/*
+The GNU AFFERO GENERAL PUBLIC LICENSE
+
+Copyright (c) 2020-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/traefik/hub-crds/pkg/apis/hub/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// APIVersionLister helps list APIVersions.
+// All objects returned here must be treated as read-only.
+type APIVersionLister interface {
+	// List lists all APIVersions in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.APIVersion, err error)
+	// APIVersions returns an object that can list and get APIVersions.
+	APIVersions(namespace string) APIVersionNamespaceLister
+	APIVersionListerExpansion
+}
+
+// aPIVersionLister implements the APIVersionLister interface.
+type aPIVersionLister struct {
+	indexer cache.Indexer
+}
+
+// NewAPIVersionLister returns a new APIVersionLister.
+func NewAPIVersionLister(indexer cache.Indexer) APIVersionLister {
+	return &aPIVersionLister{indexer: indexer}
+}
+
+// List lists all APIVersions in the indexer.
+func (s *aPIVersionLister) List(selector labels.Selector) (ret []*v1beta1.APIVersion, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.APIVersion))
+	})
+	return ret, err
+}
+
+// APIVersions returns an object that can list and get APIVersions.
+func (s *aPIVersionLister) APIVersions(namespace string) APIVersionNamespaceLister {
+	return aPIVersionNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// APIVersionNamespaceLister helps list and get APIVersions.
+// All objects returned here must be treated as read-only.
+type APIVersionNamespaceLister interface {
+	// List lists all APIVersions in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.APIVersion, err error)
+	// Get retrieves the APIVersion from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.APIVersion, error)
+	APIVersionNamespaceListerExpansion
+}
+
+// aPIVersionNamespaceLister implements the APIVersionNamespaceLister
+// interface.
+type aPIVersionNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all APIVersions in the indexer for a given namespace.
+func (s aPIVersionNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.APIVersion, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.APIVersion))
+	})
+	return ret, err
+}
+
+// Get retrieves the APIVersion from the indexer for a given namespace and name.
+func (s aPIVersionNamespaceLister) Get(name string) (*v1beta1.APIVersion, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("apiversion"), name)
+	}
+	return obj.(*v1beta1.APIVersion), nil
+}
@@ -0,0 +1,399 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package compatibility compares two sets of CRDs, and reports the changes that may prevent the objects already
+// stored in a cluster from validating after an upgrade: removed fields, newly required fields without default,
+// narrowed enums, tightened limits, new CEL rules and scope changes. It backs the crd-compat command.
+package compatibility
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+)
+
+// Severity qualifies the impact of a change on the objects already stored in a cluster.
+type Severity string
+
+// Supported severities.
+const (
+	// SeverityBreaking indicates that existing objects may no longer validate against the new CRD.
+	SeverityBreaking Severity = "breaking"
+	// SeverityWarning indicates that existing objects might no longer validate, depending on their content.
+	SeverityWarning Severity = "warning"
+)
+
+// ChangeType identifies the kind of change detected between two CRDs.
+type ChangeType string
+
+// Supported change types.
+const (
+	ChangeCRDRemoved       ChangeType = "CRDRemoved"
+	ChangeVersionRemoved   ChangeType = "VersionRemoved"
+	ChangeScopeChanged     ChangeType = "ScopeChanged"
+	ChangeFieldRemoved     ChangeType = "FieldRemoved"
+	ChangeFieldTypeChanged ChangeType = "FieldTypeChanged"
+	ChangeFieldRequired    ChangeType = "FieldRequired"
+	ChangeEnumNarrowed     ChangeType = "EnumNarrowed"
+	ChangeLimitTightened   ChangeType = "LimitTightened"
+	ChangeCELRuleAdded     ChangeType = "CELRuleAdded"
+)
+
+// Finding is a change between two CRD versions that may affect existing objects.
+type Finding struct {
+	Severity Severity   `json:"severity"`
+	Type     ChangeType `json:"type"`
+	Kind     string     `json:"kind"`
+	Version  string     `json:"version,omitempty"`
+	Path     string     `json:"path,omitempty"`
+	Message  string     `json:"message"`
+}
+
+// String returns a human-readable representation of the Finding.
+func (f Finding) String() string {
+	location := f.Kind
+	if f.Version != "" {
+		location += "/" + f.Version
+	}
+
+	if f.Path != "" {
+		location += " " + f.Path
+	}
+
+	return fmt.Sprintf("[%s] %s: %s (%s)", f.Severity, location, f.Message, f.Type)
+}
+
+// HasBreaking returns true if at least one of the given findings is breaking.
+func HasBreaking(findings []Finding) bool {
+	return slices.ContainsFunc(findings, func(f Finding) bool {
+		return f.Severity == SeverityBreaking
+	})
+}
+
+// Compare reports the changes from oldCRDs to newCRDs that may prevent existing objects from validating.
+// CRDs are matched by name and versions by version name. CRDs and versions which only exist in newCRDs
+// are not reported as they cannot affect existing objects.
+// Findings are sorted by kind, version and path.
+func Compare(oldCRDs, newCRDs []*apiextensions.CustomResourceDefinition) ([]Finding, error) {
+	newByName := make(map[string]*apiextensions.CustomResourceDefinition, len(newCRDs))
+	for _, crd := range newCRDs {
+		newByName[crd.Name] = crd
+	}
+
+	var findings []Finding
+
+	for _, oldCRD := range oldCRDs {
+		kind := oldCRD.Spec.Names.Kind
+
+		newCRD, ok := newByName[oldCRD.Name]
+		if !ok {
+			findings = append(findings, Finding{
+				Severity: SeverityBreaking,
+				Type:     ChangeCRDRemoved,
+				Kind:     kind,
+				Message:  fmt.Sprintf("CRD %q has been removed", oldCRD.Name),
+			})
+
+			continue
+		}
+
+		if oldCRD.Spec.Scope != newCRD.Spec.Scope {
+			findings = append(findings, Finding{
+				Severity: SeverityBreaking,
+				Type:     ChangeScopeChanged,
+				Kind:     kind,
+				Message:  fmt.Sprintf("scope changed from %s to %s", oldCRD.Spec.Scope, newCRD.Spec.Scope),
+			})
+		}
+
+		crdFindings, err := compareVersions(oldCRD, newCRD)
+		if err != nil {
+			return nil, fmt.Errorf("comparing CRD %q: %w", oldCRD.Name, err)
+		}
+
+		findings = append(findings, crdFindings...)
+	}
+
+	slices.SortStableFunc(findings, func(a, b Finding) int {
+		if c := strings.Compare(a.Kind, b.Kind); c != 0 {
+			return c
+		}
+
+		if c := strings.Compare(a.Version, b.Version); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return findings, nil
+}
+
+func compareVersions(oldCRD, newCRD *apiextensions.CustomResourceDefinition) ([]Finding, error) {
+	var findings []Finding
+
+	kind := oldCRD.Spec.Names.Kind
+
+	for _, oldVersion := range oldCRD.Spec.Versions {
+		if !oldVersion.Served {
+			continue
+		}
+
+		if !hasServedVersion(newCRD, oldVersion.Name) {
+			findings = append(findings, Finding{
+				Severity: SeverityBreaking,
+				Type:     ChangeVersionRemoved,
+				Kind:     kind,
+				Version:  oldVersion.Name,
+				Message:  fmt.Sprintf("version %q is no longer served", oldVersion.Name),
+			})
+
+			continue
+		}
+
+		oldSchema, err := apiextensions.GetSchemaForVersion(oldCRD, oldVersion.Name)
+		if err != nil {
+			return nil, fmt.Errorf("obtaining old schema version %q: %w", oldVersion.Name, err)
+		}
+
+		newSchema, err := apiextensions.GetSchemaForVersion(newCRD, oldVersion.Name)
+		if err != nil {
+			return nil, fmt.Errorf("obtaining new schema version %q: %w", oldVersion.Name, err)
+		}
+
+		if oldSchema == nil || newSchema == nil {
+			continue
+		}
+
+		c := schemaComparator{kind: kind, version: oldVersion.Name}
+		c.compare("", oldSchema.OpenAPIV3Schema, newSchema.OpenAPIV3Schema)
+
+		findings = append(findings, c.findings...)
+	}
+
+	return findings, nil
+}
+
+func hasServedVersion(crd *apiextensions.CustomResourceDefinition, name string) bool {
+	return slices.ContainsFunc(crd.Spec.Versions, func(v apiextensions.CustomResourceDefinitionVersion) bool {
+		return v.Name == name && v.Served
+	})
+}
+
+// schemaComparator walks two OpenAPI schemas side by side and collects the findings.
+type schemaComparator struct {
+	kind     string
+	version  string
+	findings []Finding
+}
+
+func (c *schemaComparator) report(severity Severity, changeType ChangeType, path, format string, args ...any) {
+	c.findings = append(c.findings, Finding{
+		Severity: severity,
+		Type:     changeType,
+		Kind:     c.kind,
+		Version:  c.version,
+		Path:     path,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (c *schemaComparator) compare(path string, oldProps, newProps *apiextensions.JSONSchemaProps) {
+	if oldProps == nil || newProps == nil {
+		return
+	}
+
+	if oldProps.Type != newProps.Type && oldProps.Type != "" {
+		c.report(SeverityBreaking, ChangeFieldTypeChanged, path, "type changed from %q to %q", oldProps.Type, newProps.Type)
+
+		// Sub-schemas of different types can't be meaningfully compared.
+		return
+	}
+
+	c.compareRequired(path, oldProps, newProps)
+	c.compareEnum(path, oldProps, newProps)
+	c.compareLimits(path, oldProps, newProps)
+	c.compareRules(path, oldProps, newProps)
+
+	for _, name := range sortedKeys(oldProps.Properties) {
+		oldChild := oldProps.Properties[name]
+		childPath := joinPath(path, name)
+
+		newChild, ok := newProps.Properties[name]
+		if !ok {
+			if newProps.XPreserveUnknownFields != nil && *newProps.XPreserveUnknownFields {
+				continue
+			}
+
+			c.report(SeverityBreaking, ChangeFieldRemoved, childPath, "field has been removed")
+
+			continue
+		}
+
+		c.compare(childPath, &oldChild, &newChild)
+	}
+
+	if oldProps.Items != nil && newProps.Items != nil {
+		c.compare(path+"[*]", oldProps.Items.Schema, newProps.Items.Schema)
+	}
+
+	if oldProps.AdditionalProperties != nil && newProps.AdditionalProperties != nil {
+		c.compare(joinPath(path, "*"), oldProps.AdditionalProperties.Schema, newProps.AdditionalProperties.Schema)
+	}
+}
+
+func (c *schemaComparator) compareRequired(path string, oldProps, newProps *apiextensions.JSONSchemaProps) {
+	for _, name := range newProps.Required {
+		if slices.Contains(oldProps.Required, name) {
+			continue
+		}
+
+		// Defaulting sets the field on the stored objects missing it before they are validated.
+		if props, ok := newProps.Properties[name]; ok && props.Default != nil {
+			continue
+		}
+
+		c.report(SeverityBreaking, ChangeFieldRequired, joinPath(path, name), "field is now required")
+	}
+}
+
+func (c *schemaComparator) compareEnum(path string, oldProps, newProps *apiextensions.JSONSchemaProps) {
+	if len(newProps.Enum) == 0 {
+		return
+	}
+
+	if len(oldProps.Enum) == 0 {
+		c.report(SeverityBreaking, ChangeEnumNarrowed, path, "values are now restricted to %s", formatEnum(newProps.Enum))
+
+		return
+	}
+
+	newValues := make(map[string]struct{}, len(newProps.Enum))
+	for _, value := range newProps.Enum {
+		newValues[enumKey(value)] = struct{}{}
+	}
+
+	var removed []apiextensions.JSON
+
+	for _, value := range oldProps.Enum {
+		if _, ok := newValues[enumKey(value)]; !ok {
+			removed = append(removed, value)
+		}
+	}
+
+	if len(removed) > 0 {
+		c.report(SeverityBreaking, ChangeEnumNarrowed, path, "values %s are no longer allowed", formatEnum(removed))
+	}
+}
+
+func (c *schemaComparator) compareLimits(path string, oldProps, newProps *apiextensions.JSONSchemaProps) {
+	c.compareUpperBound(path, "maxLength", oldProps.MaxLength, newProps.MaxLength)
+	c.compareUpperBound(path, "maxItems", oldProps.MaxItems, newProps.MaxItems)
+	c.compareUpperBound(path, "maxProperties", oldProps.MaxProperties, newProps.MaxProperties)
+	c.compareLowerBound(path, "minLength", oldProps.MinLength, newProps.MinLength)
+	c.compareLowerBound(path, "minItems", oldProps.MinItems, newProps.MinItems)
+	c.compareLowerBound(path, "minProperties", oldProps.MinProperties, newProps.MinProperties)
+
+	switch {
+	case newProps.Maximum == nil:
+	case oldProps.Maximum == nil:
+		c.report(SeverityBreaking, ChangeLimitTightened, path, "maximum set to %v", *newProps.Maximum)
+	case *newProps.Maximum < *oldProps.Maximum:
+		c.report(SeverityBreaking, ChangeLimitTightened, path, "maximum lowered from %v to %v", *oldProps.Maximum, *newProps.Maximum)
+	}
+
+	switch {
+	case newProps.Minimum == nil:
+	case oldProps.Minimum == nil:
+		c.report(SeverityBreaking, ChangeLimitTightened, path, "minimum set to %v", *newProps.Minimum)
+	case *newProps.Minimum > *oldProps.Minimum:
+		c.report(SeverityBreaking, ChangeLimitTightened, path, "minimum raised from %v to %v", *oldProps.Minimum, *newProps.Minimum)
+	}
+}
+
+func (c *schemaComparator) compareUpperBound(path, name string, oldLimit, newLimit *int64) {
+	switch {
+	case newLimit == nil:
+	case oldLimit == nil:
+		c.report(SeverityBreaking, ChangeLimitTightened, path, "%s set to %d", name, *newLimit)
+	case *newLimit < *oldLimit:
+		c.report(SeverityBreaking, ChangeLimitTightened, path, "%s lowered from %d to %d", name, *oldLimit, *newLimit)
+	}
+}
+
+func (c *schemaComparator) compareLowerBound(path, name string, oldLimit, newLimit *int64) {
+	switch {
+	case newLimit == nil:
+	case oldLimit == nil:
+		c.report(SeverityBreaking, ChangeLimitTightened, path, "%s set to %d", name, *newLimit)
+	case *newLimit > *oldLimit:
+		c.report(SeverityBreaking, ChangeLimitTightened, path, "%s raised from %d to %d", name, *oldLimit, *newLimit)
+	}
+}
+
+func (c *schemaComparator) compareRules(path string, oldProps, newProps *apiextensions.JSONSchemaProps) {
+	for _, rule := range newProps.XValidations {
+		known := slices.ContainsFunc(oldProps.XValidations, func(r apiextensions.ValidationRule) bool {
+			return r.Rule == rule.Rule
+		})
+		if known {
+			continue
+		}
+
+		c.report(SeverityWarning, ChangeCELRuleAdded, path, "new validation rule %q", rule.Rule)
+	}
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+func sortedKeys(properties map[string]apiextensions.JSONSchemaProps) []string {
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
+
+func enumKey(value apiextensions.JSON) string {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(raw)
+}
+
+func formatEnum(values []apiextensions.JSON) string {
+	keys := make([]string, 0, len(values))
+	for _, value := range values {
+		keys = append(keys, enumKey(value))
+	}
+
+	return "[" + strings.Join(keys, ", ") + "]"
+}
@@ -0,0 +1,269 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package compatibility_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hubcrd "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1/crd"
+	"github.com/traefik/hub-crds/pkg/compatibility"
+	"github.com/traefik/hub-crds/pkg/crd"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCompare_embeddedCRDs(t *testing.T) {
+	t.Parallel()
+
+	oldCRDs, err := crd.GetCRDs(hubcrd.CRDs)
+	require.NoError(t, err)
+
+	newCRDs, err := crd.GetCRDs(hubcrd.CRDs)
+	require.NoError(t, err)
+
+	findings, err := compatibility.Compare(oldCRDs, newCRDs)
+	require.NoError(t, err)
+	assert.Empty(t, findings)
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc         string
+		update       func(crd *apiextensions.CustomResourceDefinition)
+		wantFindings []compatibility.Finding
+		wantBreaking bool
+	}{
+		{
+			desc:   "no change",
+			update: func(*apiextensions.CustomResourceDefinition) {},
+		},
+		{
+			desc: "new optional field",
+			update: func(crd *apiextensions.CustomResourceDefinition) {
+				updateSpec(crd, func(spec *apiextensions.JSONSchemaProps) {
+					spec.Properties["baz"] = apiextensions.JSONSchemaProps{Type: "string"}
+				})
+			},
+		},
+		{
+			desc: "relaxed limits",
+			update: func(crd *apiextensions.CustomResourceDefinition) {
+				updateSpec(crd, func(spec *apiextensions.JSONSchemaProps) {
+					props := spec.Properties["names"]
+					props.MaxItems = ptr(int64(20))
+					spec.Properties["names"] = props
+				})
+			},
+		},
+		{
+			desc: "scope changed",
+			update: func(crd *apiextensions.CustomResourceDefinition) {
+				crd.Spec.Scope = apiextensions.ClusterScoped
+			},
+			wantFindings: []compatibility.Finding{
+				{Severity: compatibility.SeverityBreaking, Type: compatibility.ChangeScopeChanged, Kind: "MyResource", Message: "scope changed from Namespaced to Cluster"},
+			},
+			wantBreaking: true,
+		},
+		{
+			desc: "version no longer served",
+			update: func(crd *apiextensions.CustomResourceDefinition) {
+				crd.Spec.Versions[0].Served = false
+			},
+			wantFindings: []compatibility.Finding{
+				{Severity: compatibility.SeverityBreaking, Type: compatibility.ChangeVersionRemoved, Kind: "MyResource", Version: "v1alpha1", Message: `version "v1alpha1" is no longer served`},
+			},
+			wantBreaking: true,
+		},
+		{
+			desc: "field removed",
+			update: func(crd *apiextensions.CustomResourceDefinition) {
+				updateSpec(crd, func(spec *apiextensions.JSONSchemaProps) {
+					delete(spec.Properties, "mode")
+				})
+			},
+			wantFindings: []compatibility.Finding{
+				{Severity: compatibility.SeverityBreaking, Type: compatibility.ChangeFieldRemoved, Kind: "MyResource", Version: "v1alpha1", Path: "spec.mode", Message: "field has been removed"},
+			},
+			wantBreaking: true,
+		},
+		{
+			desc: "field now required",
+			update: func(crd *apiextensions.CustomResourceDefinition) {
+				updateSpec(crd, func(spec *apiextensions.JSONSchemaProps) {
+					spec.Required = append(spec.Required, "mode")
+				})
+			},
+			wantFindings: []compatibility.Finding{
+				{Severity: compatibility.SeverityBreaking, Type: compatibility.ChangeFieldRequired, Kind: "MyResource", Version: "v1alpha1", Path: "spec.mode", Message: "field is now required"},
+			},
+			wantBreaking: true,
+		},
+		{
+			desc: "field now required with a default",
+			update: func(crd *apiextensions.CustomResourceDefinition) {
+				updateSpec(crd, func(spec *apiextensions.JSONSchemaProps) {
+					props := spec.Properties["mode"]
+					props.Default = ptr(apiextensions.JSON("local"))
+					spec.Properties["mode"] = props
+					spec.Required = append(spec.Required, "mode")
+				})
+			},
+		},
+		{
+			desc: "enum narrowed",
+			update: func(crd *apiextensions.CustomResourceDefinition) {
+				updateSpec(crd, func(spec *apiextensions.JSONSchemaProps) {
+					props := spec.Properties["mode"]
+					props.Enum = []apiextensions.JSON{"local"}
+					spec.Properties["mode"] = props
+				})
+			},
+			wantFindings: []compatibility.Finding{
+				{Severity: compatibility.SeverityBreaking, Type: compatibility.ChangeEnumNarrowed, Kind: "MyResource", Version: "v1alpha1", Path: "spec.mode", Message: `values ["distributed"] are no longer allowed`},
+			},
+			wantBreaking: true,
+		},
+		{
+			desc: "limits tightened",
+			update: func(crd *apiextensions.CustomResourceDefinition) {
+				updateSpec(crd, func(spec *apiextensions.JSONSchemaProps) {
+					props := spec.Properties["names"]
+					props.MaxItems = ptr(int64(5))
+					props.Items.Schema.MaxLength = ptr(int64(63))
+					spec.Properties["names"] = props
+				})
+			},
+			wantFindings: []compatibility.Finding{
+				{Severity: compatibility.SeverityBreaking, Type: compatibility.ChangeLimitTightened, Kind: "MyResource", Version: "v1alpha1", Path: "spec.names", Message: "maxItems lowered from 10 to 5"},
+				{Severity: compatibility.SeverityBreaking, Type: compatibility.ChangeLimitTightened, Kind: "MyResource", Version: "v1alpha1", Path: "spec.names[*]", Message: "maxLength set to 63"},
+			},
+			wantBreaking: true,
+		},
+		{
+			desc: "type changed",
+			update: func(crd *apiextensions.CustomResourceDefinition) {
+				updateSpec(crd, func(spec *apiextensions.JSONSchemaProps) {
+					spec.Properties["mode"] = apiextensions.JSONSchemaProps{Type: "integer"}
+				})
+			},
+			wantFindings: []compatibility.Finding{
+				{Severity: compatibility.SeverityBreaking, Type: compatibility.ChangeFieldTypeChanged, Kind: "MyResource", Version: "v1alpha1", Path: "spec.mode", Message: `type changed from "string" to "integer"`},
+			},
+			wantBreaking: true,
+		},
+		{
+			desc: "new CEL rule",
+			update: func(crd *apiextensions.CustomResourceDefinition) {
+				updateSpec(crd, func(spec *apiextensions.JSONSchemaProps) {
+					spec.XValidations = append(spec.XValidations, apiextensions.ValidationRule{Rule: "has(self.mode)"})
+				})
+			},
+			wantFindings: []compatibility.Finding{
+				{Severity: compatibility.SeverityWarning, Type: compatibility.ChangeCELRuleAdded, Kind: "MyResource", Version: "v1alpha1", Path: "spec", Message: `new validation rule "has(self.mode)"`},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			newCRD := newTestCRD()
+			test.update(newCRD)
+
+			findings, err := compatibility.Compare(
+				[]*apiextensions.CustomResourceDefinition{newTestCRD()},
+				[]*apiextensions.CustomResourceDefinition{newCRD},
+			)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.wantFindings, findings)
+			assert.Equal(t, test.wantBreaking, compatibility.HasBreaking(findings))
+		})
+	}
+}
+
+func TestCompare_crdRemoved(t *testing.T) {
+	t.Parallel()
+
+	findings, err := compatibility.Compare([]*apiextensions.CustomResourceDefinition{newTestCRD()}, nil)
+	require.NoError(t, err)
+
+	want := []compatibility.Finding{
+		{Severity: compatibility.SeverityBreaking, Type: compatibility.ChangeCRDRemoved, Kind: "MyResource", Message: `CRD "myresources.test" has been removed`},
+	}
+	assert.Equal(t, want, findings)
+}
+
+func newTestCRD() *apiextensions.CustomResourceDefinition {
+	return &apiextensions.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "myresources.test"},
+		Spec: apiextensions.CustomResourceDefinitionSpec{
+			Group: "test",
+			Names: apiextensions.CustomResourceDefinitionNames{Kind: "MyResource"},
+			Scope: apiextensions.NamespaceScoped,
+			Versions: []apiextensions.CustomResourceDefinitionVersion{
+				{
+					Name:    "v1alpha1",
+					Served:  true,
+					Storage: true,
+					Schema: &apiextensions.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+							Type: "object",
+							Properties: map[string]apiextensions.JSONSchemaProps{
+								"spec": {
+									Type: "object",
+									Properties: map[string]apiextensions.JSONSchemaProps{
+										"mode": {
+											Type: "string",
+											Enum: []apiextensions.JSON{"local", "distributed"},
+										},
+										"names": {
+											Type:     "array",
+											MaxItems: ptr(int64(10)),
+											Items: &apiextensions.JSONSchemaPropsOrArray{
+												Schema: &apiextensions.JSONSchemaProps{Type: "string"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func updateSpec(crd *apiextensions.CustomResourceDefinition, update func(spec *apiextensions.JSONSchemaProps)) {
+	root := crd.Spec.Versions[0].Schema.OpenAPIV3Schema
+
+	spec := root.Properties["spec"]
+	update(&spec)
+	root.Properties["spec"] = spec
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
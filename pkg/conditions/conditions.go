@@ -0,0 +1,156 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package conditions provides helpers to read and write the status conditions of Hub objects,
+// whatever their kind or version.
+package conditions
+
+import (
+	"fmt"
+
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Object is a Hub object reporting status conditions.
+type Object interface {
+	metav1.Object
+	GetConditions() []metav1.Condition
+	SetConditions(conditions []metav1.Condition)
+}
+
+// Get returns the condition of the given type, or nil if the object doesn't report it.
+func Get(obj Object, conditionType string) *metav1.Condition {
+	return meta.FindStatusCondition(obj.GetConditions(), conditionType)
+}
+
+// IsTrue reports whether the condition of the given type is true for the current generation of the object.
+func IsTrue(obj Object, conditionType string) bool {
+	condition := Get(obj, conditionType)
+
+	return condition != nil && condition.Status == metav1.ConditionTrue && condition.ObservedGeneration == obj.GetGeneration()
+}
+
+// Set adds or updates the condition of the object. The LastTransitionTime is only updated when the status
+// changes, and the ObservedGeneration defaults to the generation of the object.
+// It reports whether the conditions have changed.
+func Set(obj Object, condition metav1.Condition) bool {
+	if condition.ObservedGeneration == 0 {
+		condition.ObservedGeneration = obj.GetGeneration()
+	}
+
+	conditions := obj.GetConditions()
+	changed := meta.SetStatusCondition(&conditions, condition)
+	obj.SetConditions(conditions)
+
+	return changed
+}
+
+// Remove removes the condition of the given type. It reports whether the condition was present.
+func Remove(obj Object, conditionType string) bool {
+	conditions := obj.GetConditions()
+	removed := meta.RemoveStatusCondition(&conditions, conditionType)
+	obj.SetConditions(conditions)
+
+	return removed
+}
+
+// Readiness computes the Ready condition of the object from all its other conditions:
+//   - Unknown with the Pending reason when no condition is reported, or when a condition doesn't
+//     reflect the current generation of the object.
+//   - False when a condition is false, with the reason of the first false condition.
+//   - Unknown when a condition is unknown, with the reason of the first unknown condition.
+//   - True with the Ready reason otherwise.
+func Readiness(obj Object) metav1.Condition {
+	readiness := metav1.Condition{
+		Type:               hubv1alpha1.ConditionReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: obj.GetGeneration(),
+		Reason:             hubv1alpha1.ReasonReady,
+	}
+
+	var found bool
+	var unknown *metav1.Condition
+
+	for _, condition := range obj.GetConditions() {
+		if condition.Type == hubv1alpha1.ConditionReady {
+			continue
+		}
+		found = true
+
+		if condition.ObservedGeneration < obj.GetGeneration() {
+			readiness.Status = metav1.ConditionUnknown
+			readiness.Reason = hubv1alpha1.ReasonPending
+			readiness.Message = fmt.Sprintf("%s condition is outdated", condition.Type)
+
+			return readiness
+		}
+
+		switch condition.Status {
+		case metav1.ConditionFalse:
+			readiness.Status = metav1.ConditionFalse
+			readiness.Reason = condition.Reason
+			readiness.Message = fmt.Sprintf("%s: %s", condition.Type, condition.Message)
+
+			return readiness
+		case metav1.ConditionUnknown:
+			if unknown == nil {
+				unknown = &condition
+			}
+		}
+	}
+
+	switch {
+	case !found:
+		readiness.Status = metav1.ConditionUnknown
+		readiness.Reason = hubv1alpha1.ReasonPending
+		readiness.Message = "no condition reported yet"
+	case unknown != nil:
+		readiness.Status = metav1.ConditionUnknown
+		readiness.Reason = unknown.Reason
+		readiness.Message = fmt.Sprintf("%s: %s", unknown.Type, unknown.Message)
+	}
+
+	return readiness
+}
+
+// SetReady sets the Ready condition of the object computed by Readiness.
+// It reports whether the conditions have changed.
+func SetReady(obj Object) bool {
+	return Set(obj, Readiness(obj))
+}
+
+// AllReady reports whether all the given objects, of any kind, are ready for their current generation.
+// The Ready condition is computed with Readiness for the objects which don't report it.
+func AllReady(objs ...Object) bool {
+	for _, obj := range objs {
+		if Get(obj, hubv1alpha1.ConditionReady) == nil {
+			if Readiness(obj).Status != metav1.ConditionTrue {
+				return false
+			}
+
+			continue
+		}
+
+		if !IsTrue(obj, hubv1alpha1.ConditionReady) {
+			return false
+		}
+	}
+
+	return true
+}
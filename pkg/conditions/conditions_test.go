@@ -0,0 +1,203 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package conditions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	hubv1beta1 "github.com/traefik/hub-crds/pkg/apis/hub/v1beta1"
+	"github.com/traefik/hub-crds/pkg/conditions"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSet(t *testing.T) {
+	t.Parallel()
+
+	plan := &hubv1alpha1.APIPlan{ObjectMeta: metav1.ObjectMeta{Generation: 2}}
+
+	changed := conditions.Set(plan, metav1.Condition{
+		Type:   hubv1alpha1.ConditionSynced,
+		Status: metav1.ConditionFalse,
+		Reason: hubv1alpha1.ReasonSyncFailed,
+	})
+	assert.True(t, changed)
+
+	got := conditions.Get(plan, hubv1alpha1.ConditionSynced)
+	require.NotNil(t, got)
+	assert.Equal(t, int64(2), got.ObservedGeneration)
+	assert.False(t, got.LastTransitionTime.IsZero())
+	assert.False(t, conditions.IsTrue(plan, hubv1alpha1.ConditionSynced))
+
+	transition := metav1.NewTime(time.Now().Add(-time.Hour))
+	plan.Status.Conditions[0].LastTransitionTime = transition
+
+	changed = conditions.Set(plan, metav1.Condition{
+		Type:   hubv1alpha1.ConditionSynced,
+		Status: metav1.ConditionFalse,
+		Reason: hubv1alpha1.ReasonSyncFailed,
+	})
+	assert.False(t, changed)
+	assert.Equal(t, transition, plan.Status.Conditions[0].LastTransitionTime)
+
+	changed = conditions.Set(plan, metav1.Condition{
+		Type:   hubv1alpha1.ConditionSynced,
+		Status: metav1.ConditionTrue,
+		Reason: hubv1alpha1.ReasonSynced,
+	})
+	assert.True(t, changed)
+	assert.NotEqual(t, transition, plan.Status.Conditions[0].LastTransitionTime)
+	assert.True(t, conditions.IsTrue(plan, hubv1alpha1.ConditionSynced))
+
+	plan.Generation = 3
+	assert.False(t, conditions.IsTrue(plan, hubv1alpha1.ConditionSynced))
+
+	assert.True(t, conditions.Remove(plan, hubv1alpha1.ConditionSynced))
+	assert.False(t, conditions.Remove(plan, hubv1alpha1.ConditionSynced))
+	assert.Empty(t, plan.Status.Conditions)
+}
+
+func TestReadiness(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc       string
+		conditions []metav1.Condition
+		want       metav1.Condition
+	}{
+		{
+			desc: "no condition",
+			want: metav1.Condition{
+				Type:               hubv1alpha1.ConditionReady,
+				Status:             metav1.ConditionUnknown,
+				ObservedGeneration: 2,
+				Reason:             hubv1alpha1.ReasonPending,
+				Message:            "no condition reported yet",
+			},
+		},
+		{
+			desc: "all true",
+			conditions: []metav1.Condition{
+				{Type: hubv1alpha1.ConditionSynced, Status: metav1.ConditionTrue, ObservedGeneration: 2, Reason: hubv1alpha1.ReasonSynced},
+				{Type: hubv1alpha1.ConditionReferencesResolved, Status: metav1.ConditionTrue, ObservedGeneration: 2, Reason: hubv1alpha1.ReasonResolved},
+				{Type: hubv1alpha1.ConditionReady, Status: metav1.ConditionFalse, ObservedGeneration: 1, Reason: hubv1alpha1.ReasonInvalidSpec},
+			},
+			want: metav1.Condition{
+				Type:               hubv1alpha1.ConditionReady,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: 2,
+				Reason:             hubv1alpha1.ReasonReady,
+			},
+		},
+		{
+			desc: "outdated condition",
+			conditions: []metav1.Condition{
+				{Type: hubv1alpha1.ConditionSynced, Status: metav1.ConditionTrue, ObservedGeneration: 1, Reason: hubv1alpha1.ReasonSynced},
+			},
+			want: metav1.Condition{
+				Type:               hubv1alpha1.ConditionReady,
+				Status:             metav1.ConditionUnknown,
+				ObservedGeneration: 2,
+				Reason:             hubv1alpha1.ReasonPending,
+				Message:            "Synced condition is outdated",
+			},
+		},
+		{
+			desc: "false condition",
+			conditions: []metav1.Condition{
+				{Type: hubv1alpha1.ConditionSynced, Status: metav1.ConditionUnknown, ObservedGeneration: 2, Reason: hubv1alpha1.ReasonPending},
+				{Type: hubv1alpha1.ConditionReferencesResolved, Status: metav1.ConditionFalse, ObservedGeneration: 2, Reason: hubv1alpha1.ReasonReferenceNotFound, Message: `APIPlan "gold" not found`},
+			},
+			want: metav1.Condition{
+				Type:               hubv1alpha1.ConditionReady,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: 2,
+				Reason:             hubv1alpha1.ReasonReferenceNotFound,
+				Message:            `ReferencesResolved: APIPlan "gold" not found`,
+			},
+		},
+		{
+			desc: "unknown condition",
+			conditions: []metav1.Condition{
+				{Type: hubv1alpha1.ConditionSynced, Status: metav1.ConditionUnknown, ObservedGeneration: 2, Reason: hubv1alpha1.ReasonPending, Message: "waiting for the platform"},
+				{Type: hubv1alpha1.ConditionReferencesResolved, Status: metav1.ConditionTrue, ObservedGeneration: 2, Reason: hubv1alpha1.ReasonResolved},
+			},
+			want: metav1.Condition{
+				Type:               hubv1alpha1.ConditionReady,
+				Status:             metav1.ConditionUnknown,
+				ObservedGeneration: 2,
+				Reason:             hubv1alpha1.ReasonPending,
+				Message:            "Synced: waiting for the platform",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			api := &hubv1alpha1.API{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Status:     hubv1alpha1.APIStatus{Conditions: test.conditions},
+			}
+
+			assert.Equal(t, test.want, conditions.Readiness(api))
+		})
+	}
+}
+
+func TestAllReady(t *testing.T) {
+	t.Parallel()
+
+	synced := metav1.Condition{Type: hubv1alpha1.ConditionSynced, Status: metav1.ConditionTrue, ObservedGeneration: 1, Reason: hubv1alpha1.ReasonSynced}
+
+	api := &hubv1alpha1.API{ObjectMeta: metav1.ObjectMeta{Generation: 1}}
+	conditions.Set(api, synced)
+	conditions.SetReady(api)
+
+	uplink := &hubv1beta1.Uplink{ObjectMeta: metav1.ObjectMeta{Generation: 1}}
+	conditions.Set(uplink, synced)
+
+	assert.True(t, conditions.IsTrue(api, hubv1alpha1.ConditionReady))
+	assert.True(t, conditions.AllReady(api, uplink))
+
+	plan := &hubv1alpha1.APIPlan{ObjectMeta: metav1.ObjectMeta{Generation: 1}}
+	assert.False(t, conditions.AllReady(api, uplink, plan))
+
+	api.Generation = 2
+	assert.False(t, conditions.AllReady(api, uplink))
+}
+
+func TestReadiness_aiService(t *testing.T) {
+	t.Parallel()
+
+	service := &hubv1alpha1.AIService{ObjectMeta: metav1.ObjectMeta{Generation: 1}}
+	conditions.Set(service, metav1.Condition{Type: hubv1alpha1.ConditionCredentialsResolved, Status: metav1.ConditionTrue, Reason: hubv1alpha1.ReasonResolved})
+	conditions.Set(service, metav1.Condition{Type: hubv1alpha1.ConditionModelAvailable, Status: metav1.ConditionFalse, Reason: hubv1alpha1.ReasonModelNotFound, Message: `model "gpt-5" not found`})
+
+	assert.True(t, conditions.SetReady(service))
+
+	ready := conditions.Get(service, hubv1alpha1.ConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionFalse, ready.Status)
+	assert.Equal(t, hubv1alpha1.ReasonModelNotFound, ready.Reason)
+	assert.Equal(t, `ModelAvailable: model "gpt-5" not found`, ready.Message)
+}
@@ -0,0 +1,40 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package conversion defines the hub and spoke model used to convert Hub objects between API versions.
+//
+// Each kind has a single hub version, the storage version, which every other version (the spokes)
+// converts to and from. The interfaces mirror the ones of sigs.k8s.io/controller-runtime/pkg/conversion,
+// so that the Hub types can be used with controller-runtime conversion webhooks as is.
+package conversion
+
+import "k8s.io/apimachinery/pkg/runtime"
+
+// Hub marks that a given type is the hub type for conversion.
+// This means that all conversions will first convert to the hub type, then convert from the hub type
+// to the destination type. All types besides the hub type should implement Convertible.
+type Hub interface {
+	runtime.Object
+	Hub()
+}
+
+// Convertible defines capability of a type to be convertible i.e. it can be converted to/from a hub type.
+type Convertible interface {
+	runtime.Object
+	ConvertTo(dst Hub) error
+	ConvertFrom(src Hub) error
+}
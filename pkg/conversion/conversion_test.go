@@ -0,0 +1,70 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package conversion_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/hub-crds/pkg/apis/hub"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	hubv1beta1 "github.com/traefik/hub-crds/pkg/apis/hub/v1beta1"
+	"github.com/traefik/hub-crds/pkg/conversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestKinds(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, hub.AddToScheme(scheme))
+
+	spokes := scheme.KnownTypes(hubv1beta1.SchemeGroupVersion)
+	require.NotEmpty(t, spokes)
+
+	for kind := range spokes {
+		object, err := scheme.New(hubv1beta1.SchemeGroupVersion.WithKind(kind))
+		require.NoError(t, err)
+
+		// Skip lists and the meta types, such as WatchEvent or ListOptions, registered in every group version.
+		if _, ok := object.(metav1.Object); !ok {
+			continue
+		}
+
+		t.Run(kind, func(t *testing.T) {
+			t.Parallel()
+
+			spoke, err := scheme.New(hubv1beta1.SchemeGroupVersion.WithKind(kind))
+			require.NoError(t, err)
+
+			hubObject, err := scheme.New(hubv1alpha1.SchemeGroupVersion.WithKind(kind))
+			require.NoError(t, err)
+
+			convertible, ok := spoke.(conversion.Convertible)
+			require.True(t, ok, "%T must be a conversion.Convertible", spoke)
+
+			hubVersion, ok := hubObject.(conversion.Hub)
+			require.True(t, ok, "%T must be a conversion.Hub", hubObject)
+
+			assert.NoError(t, convertible.ConvertTo(hubVersion))
+			assert.NoError(t, convertible.ConvertFrom(hubVersion))
+		})
+	}
+}
@@ -0,0 +1,229 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package cors evaluates the Cross-Origin Resource Sharing configuration of APIs and APIVersions, and returns the
+// headers the gateway responds with.
+//
+// Preflight requests, OPTIONS requests with an Origin and an Access-Control-Request-Method header, are answered
+// by the gateway without reaching the backend. Other requests reach the backend, and the CORS headers are added to
+// its response. The Access-Control-Allow-Origin header is only set for allowed origins: origins of the
+// AllowOriginsList, or matching one of the AllowOriginListRegex. A "*" in the AllowOriginsList allows any origin.
+package cors
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+)
+
+// Response headers.
+const (
+	HeaderAllowOrigin      = "Access-Control-Allow-Origin"
+	HeaderAllowCredentials = "Access-Control-Allow-Credentials"
+	HeaderAllowHeaders     = "Access-Control-Allow-Headers"
+	HeaderAllowMethods     = "Access-Control-Allow-Methods"
+	HeaderExposeHeaders    = "Access-Control-Expose-Headers"
+	HeaderMaxAge           = "Access-Control-Max-Age"
+	HeaderVary             = "Vary"
+)
+
+// wildcard is the origin allowing any origin.
+const wildcard = "*"
+
+// Result is the outcome of a request.
+type Result struct {
+	// Preflight indicates that the request is a preflight request, answered by the gateway.
+	Preflight bool
+	// Allowed indicates that the origin of the request is allowed.
+	Allowed bool
+	// Headers are the CORS headers of the response.
+	Headers http.Header
+}
+
+// Policy evaluates requests against a Cors configuration.
+type Policy struct {
+	cors          hubv1alpha1.Cors
+	originRegexes []*regexp.Regexp
+}
+
+// NewPolicy creates a Policy for the given configuration. A nil configuration adds no header.
+func NewPolicy(cors *hubv1alpha1.Cors) (*Policy, error) {
+	if cors == nil {
+		return &Policy{}, nil
+	}
+
+	p := &Policy{cors: *cors}
+	for _, expr := range cors.AllowOriginListRegex {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("compiling origin regex %q: %w", expr, err)
+		}
+
+		p.originRegexes = append(p.originRegexes, re)
+	}
+
+	return p, nil
+}
+
+// Evaluate returns the CORS headers of the response to the given request.
+func (p *Policy) Evaluate(req *http.Request) Result {
+	origin := req.Header.Get("Origin")
+
+	result := Result{
+		Preflight: req.Method == http.MethodOptions && origin != "" && req.Header.Get("Access-Control-Request-Method") != "",
+		Headers:   make(http.Header),
+	}
+
+	allowOrigin, allowed := p.allowOrigin(origin)
+	result.Allowed = allowed
+	if allowed {
+		result.Headers.Set(HeaderAllowOrigin, allowOrigin)
+	}
+
+	if p.cors.AllowCredentials {
+		result.Headers.Set(HeaderAllowCredentials, "true")
+	}
+
+	if result.Preflight {
+		if len(p.cors.AllowHeadersList) > 0 {
+			result.Headers.Set(HeaderAllowHeaders, strings.Join(p.cors.AllowHeadersList, ","))
+		}
+		if len(p.cors.AllowMethodsList) > 0 {
+			result.Headers.Set(HeaderAllowMethods, strings.Join(p.cors.AllowMethodsList, ","))
+		}
+		result.Headers.Set(HeaderMaxAge, strconv.FormatInt(p.cors.MaxAge, 10))
+
+		return result
+	}
+
+	if len(p.cors.ExposeHeadersList) > 0 {
+		result.Headers.Set(HeaderExposeHeaders, strings.Join(p.cors.ExposeHeadersList, ","))
+	}
+	if p.cors.AddVaryHeader && origin != "" {
+		result.Headers.Add(HeaderVary, "Origin")
+	}
+
+	return result
+}
+
+// allowOrigin returns the value of the Access-Control-Allow-Origin header for the given origin,
+// and whether the origin is allowed.
+func (p *Policy) allowOrigin(origin string) (string, bool) {
+	if slices.Contains(p.cors.AllowOriginsList, wildcard) {
+		return wildcard, true
+	}
+	if origin == "" {
+		return "", false
+	}
+
+	if slices.Contains(p.cors.AllowOriginsList, origin) {
+		return origin, true
+	}
+
+	for _, re := range p.originRegexes {
+		if re.MatchString(origin) {
+			return origin, true
+		}
+	}
+
+	return "", false
+}
+
+// Lint returns warnings about the unsafe or ineffective settings of the given configuration.
+func Lint(cors *hubv1alpha1.Cors) []string {
+	if cors == nil {
+		return nil
+	}
+
+	var warnings []string
+
+	wildcardOrigin := slices.Contains(cors.AllowOriginsList, wildcard)
+	if wildcardOrigin && cors.AllowCredentials {
+		warnings = append(warnings, `allowCredentials is set with the "*" origin: browsers reject credentialed responses allowing any origin`)
+	}
+	if wildcardOrigin && (len(cors.AllowOriginsList) > 1 || len(cors.AllowOriginListRegex) > 0) {
+		warnings = append(warnings, `the "*" origin allows any origin, the other allowed origins are ignored`)
+	}
+
+	for _, expr := range cors.AllowOriginListRegex {
+		if _, err := regexp.Compile(expr); err != nil {
+			warnings = append(warnings, fmt.Sprintf("origin regex %q is invalid: %v", expr, err))
+			continue
+		}
+
+		if !strings.HasPrefix(expr, "^") || !strings.HasSuffix(expr, "$") {
+			warnings = append(warnings, fmt.Sprintf("origin regex %q is not anchored with ^ and $: it matches any origin containing it", expr))
+		}
+	}
+
+	if !wildcardOrigin && (len(cors.AllowOriginsList) > 0 || len(cors.AllowOriginListRegex) > 0) && !cors.AddVaryHeader {
+		warnings = append(warnings, "addVaryHeader is not set: caches may serve a response allowing an origin to another origin")
+	}
+
+	if slices.Contains(cors.AllowHeadersList, wildcard) && cors.AllowCredentials {
+		warnings = append(warnings, `allowCredentials is set with the "*" header: browsers treat it as a literal header name for credentialed requests`)
+	}
+
+	return warnings
+}
+
+// Merge returns the configuration applying to an APIVersion, given the configuration of its API and its own.
+// The configuration of the APIVersion, when set, replaces the one of the API as a whole: its unset fields do not
+// inherit the values of the API. Merge also returns the JSON names of the fields whose value differs from the API.
+func Merge(api, version *hubv1alpha1.Cors) (*hubv1alpha1.Cors, []string) {
+	if version == nil {
+		return api.DeepCopy(), nil
+	}
+
+	var base hubv1alpha1.Cors
+	if api != nil {
+		base = *api
+	}
+
+	var overridden []string
+	if !slices.Equal(base.AllowOriginsList, version.AllowOriginsList) {
+		overridden = append(overridden, "allowOriginsList")
+	}
+	if !slices.Equal(base.AllowOriginListRegex, version.AllowOriginListRegex) {
+		overridden = append(overridden, "allowOriginListRegex")
+	}
+	if !slices.Equal(base.AllowMethodsList, version.AllowMethodsList) {
+		overridden = append(overridden, "allowMethodsList")
+	}
+	if !slices.Equal(base.AllowHeadersList, version.AllowHeadersList) {
+		overridden = append(overridden, "allowHeadersList")
+	}
+	if !slices.Equal(base.ExposeHeadersList, version.ExposeHeadersList) {
+		overridden = append(overridden, "exposeHeadersList")
+	}
+	if base.MaxAge != version.MaxAge {
+		overridden = append(overridden, "maxAge")
+	}
+	if base.AddVaryHeader != version.AddVaryHeader {
+		overridden = append(overridden, "addVaryHeader")
+	}
+	if base.AllowCredentials != version.AllowCredentials {
+		overridden = append(overridden, "allowCredentials")
+	}
+
+	return version.DeepCopy(), overridden
+}
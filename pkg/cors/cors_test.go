@@ -0,0 +1,251 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cors_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	"github.com/traefik/hub-crds/pkg/cors"
+)
+
+func TestPolicy_Evaluate(t *testing.T) {
+	t.Parallel()
+
+	config := &hubv1alpha1.Cors{
+		AllowOriginsList:     []string{"https://app.example.com"},
+		AllowOriginListRegex: []string{`^https://[a-z]+\.example\.org$`},
+		AllowMethodsList:     []string{"GET", "POST"},
+		AllowHeadersList:     []string{"Authorization", "Content-Type"},
+		ExposeHeadersList:    []string{"X-Request-Id"},
+		MaxAge:               600,
+		AddVaryHeader:        true,
+		AllowCredentials:     true,
+	}
+
+	tests := []struct {
+		desc    string
+		cors    *hubv1alpha1.Cors
+		method  string
+		headers map[string]string
+		want    cors.Result
+	}{
+		{
+			desc:    "preflight request",
+			cors:    config,
+			method:  http.MethodOptions,
+			headers: map[string]string{"Origin": "https://app.example.com", "Access-Control-Request-Method": "POST"},
+			want: cors.Result{
+				Preflight: true,
+				Allowed:   true,
+				Headers: http.Header{
+					cors.HeaderAllowOrigin:      {"https://app.example.com"},
+					cors.HeaderAllowCredentials: {"true"},
+					cors.HeaderAllowHeaders:     {"Authorization,Content-Type"},
+					cors.HeaderAllowMethods:     {"GET,POST"},
+					cors.HeaderMaxAge:           {"600"},
+				},
+			},
+		},
+		{
+			desc:    "preflight request from a disallowed origin",
+			cors:    config,
+			method:  http.MethodOptions,
+			headers: map[string]string{"Origin": "https://evil.com", "Access-Control-Request-Method": "POST"},
+			want: cors.Result{
+				Preflight: true,
+				Headers: http.Header{
+					cors.HeaderAllowCredentials: {"true"},
+					cors.HeaderAllowHeaders:     {"Authorization,Content-Type"},
+					cors.HeaderAllowMethods:     {"GET,POST"},
+					cors.HeaderMaxAge:           {"600"},
+				},
+			},
+		},
+		{
+			desc:    "simple request from an origin matching a regex",
+			cors:    config,
+			method:  http.MethodGet,
+			headers: map[string]string{"Origin": "https://portal.example.org"},
+			want: cors.Result{
+				Allowed: true,
+				Headers: http.Header{
+					cors.HeaderAllowOrigin:      {"https://portal.example.org"},
+					cors.HeaderAllowCredentials: {"true"},
+					cors.HeaderExposeHeaders:    {"X-Request-Id"},
+					cors.HeaderVary:             {"Origin"},
+				},
+			},
+		},
+		{
+			desc:    "OPTIONS request without Access-Control-Request-Method",
+			cors:    config,
+			method:  http.MethodOptions,
+			headers: map[string]string{"Origin": "https://app.example.com"},
+			want: cors.Result{
+				Allowed: true,
+				Headers: http.Header{
+					cors.HeaderAllowOrigin:      {"https://app.example.com"},
+					cors.HeaderAllowCredentials: {"true"},
+					cors.HeaderExposeHeaders:    {"X-Request-Id"},
+					cors.HeaderVary:             {"Origin"},
+				},
+			},
+		},
+		{
+			desc:   "wildcard origin",
+			cors:   &hubv1alpha1.Cors{AllowOriginsList: []string{"*"}},
+			method: http.MethodGet,
+			headers: map[string]string{
+				"Origin": "https://any.example.com",
+			},
+			want: cors.Result{
+				Allowed: true,
+				Headers: http.Header{cors.HeaderAllowOrigin: {"*"}},
+			},
+		},
+		{
+			desc:    "no configuration",
+			method:  http.MethodGet,
+			headers: map[string]string{"Origin": "https://app.example.com"},
+			want:    cors.Result{Headers: http.Header{}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			policy, err := cors.NewPolicy(test.cors)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(test.method, "https://api.example.com/users", nil)
+			for name, value := range test.headers {
+				req.Header.Set(name, value)
+			}
+
+			assert.Equal(t, test.want, policy.Evaluate(req))
+		})
+	}
+}
+
+func TestNewPolicy_invalidRegex(t *testing.T) {
+	t.Parallel()
+
+	_, err := cors.NewPolicy(&hubv1alpha1.Cors{AllowOriginListRegex: []string{"^(https://"}})
+	assert.Error(t, err)
+}
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc string
+		cors *hubv1alpha1.Cors
+		want []string
+	}{
+		{
+			desc: "nil",
+		},
+		{
+			desc: "safe configuration",
+			cors: &hubv1alpha1.Cors{
+				AllowOriginsList:     []string{"https://app.example.com"},
+				AllowOriginListRegex: []string{`^https://[a-z]+\.example\.org$`},
+				AddVaryHeader:        true,
+				AllowCredentials:     true,
+			},
+		},
+		{
+			desc: "credentials with wildcard origin",
+			cors: &hubv1alpha1.Cors{
+				AllowOriginsList: []string{"*", "https://app.example.com"},
+				AllowCredentials: true,
+			},
+			want: []string{
+				`allowCredentials is set with the "*" origin: browsers reject credentialed responses allowing any origin`,
+				`the "*" origin allows any origin, the other allowed origins are ignored`,
+			},
+		},
+		{
+			desc: "unanchored and invalid regexes",
+			cors: &hubv1alpha1.Cors{
+				AllowOriginListRegex: []string{`example\.com`, `^(https://`},
+				AddVaryHeader:        true,
+			},
+			want: []string{
+				`origin regex "example\\.com" is not anchored with ^ and $: it matches any origin containing it`,
+				"origin regex \"^(https://\" is invalid: error parsing regexp: missing closing ): `^(https://`",
+			},
+		},
+		{
+			desc: "missing vary header and wildcard header with credentials",
+			cors: &hubv1alpha1.Cors{
+				AllowOriginsList: []string{"https://app.example.com"},
+				AllowHeadersList: []string{"*"},
+				AllowCredentials: true,
+			},
+			want: []string{
+				"addVaryHeader is not set: caches may serve a response allowing an origin to another origin",
+				`allowCredentials is set with the "*" header: browsers treat it as a literal header name for credentialed requests`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.want, cors.Lint(test.cors))
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	api := &hubv1alpha1.Cors{
+		AllowOriginsList: []string{"https://app.example.com"},
+		AllowMethodsList: []string{"GET"},
+		MaxAge:           600,
+		AddVaryHeader:    true,
+	}
+
+	got, overridden := cors.Merge(api, nil)
+	assert.Equal(t, api, got)
+	assert.Empty(t, overridden)
+
+	version := &hubv1alpha1.Cors{
+		AllowOriginsList: []string{"https://app.example.com"},
+		AllowMethodsList: []string{"GET", "POST"},
+		AddVaryHeader:    true,
+		AllowCredentials: true,
+	}
+
+	got, overridden = cors.Merge(api, version)
+	assert.Equal(t, version, got)
+	assert.Equal(t, []string{"allowMethodsList", "maxAge", "allowCredentials"}, overridden)
+
+	got, overridden = cors.Merge(nil, version)
+	assert.Equal(t, version, got)
+	assert.Equal(t, []string{"allowOriginsList", "allowMethodsList", "addVaryHeader", "allowCredentials"}, overridden)
+}
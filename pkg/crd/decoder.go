@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	hubv1beta1 "github.com/traefik/hub-crds/pkg/apis/hub/v1beta1"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -75,6 +76,9 @@ func NewHubDecoder() (*HubDecoder, error) {
 	if err := hubv1alpha1.AddToScheme(scheme); err != nil {
 		return nil, fmt.Errorf("adding hub.traefik.io/v1alpha1 resources: %w", err)
 	}
+	if err := hubv1beta1.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("adding hub.traefik.io/v1beta1 resources: %w", err)
+	}
 
 	decoder := serializer.NewCodecFactory(scheme, serializer.EnableStrict).UniversalDeserializer()
 
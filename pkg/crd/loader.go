@@ -27,6 +27,7 @@ import (
 	"strings"
 
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/yaml"
 )
 
@@ -56,6 +57,50 @@ func GetCRDs(filesystem fs.FS) ([]*apiextensions.CustomResourceDefinition, error
 	return crds, nil
 }
 
+// GetObjects returns the Kubernetes objects defined in the given filesystem.
+// Lists, such as the ones produced by "kubectl get -o yaml", are flattened into their items.
+func GetObjects(filesystem fs.FS) ([]*unstructured.Unstructured, error) {
+	manifests, err := loadManifests(filesystem)
+	if err != nil {
+		return nil, fmt.Errorf("loading documents: %w", err)
+	}
+
+	var objects []*unstructured.Unstructured
+
+	for _, m := range manifests {
+		data, err := yaml.ToJSON(m.Data)
+		if err != nil {
+			return nil, fmt.Errorf("converting manifest %s to JSON: %w", m.Path, err)
+		}
+
+		if len(data) == 0 || string(data) == "null" {
+			continue
+		}
+
+		var object unstructured.Unstructured
+		if err = object.UnmarshalJSON(data); err != nil {
+			return nil, fmt.Errorf("decoding manifest %s: %w", m.Path, err)
+		}
+
+		if !object.IsList() {
+			objects = append(objects, &object)
+
+			continue
+		}
+
+		list, err := object.ToList()
+		if err != nil {
+			return nil, fmt.Errorf("decoding list %s: %w", m.Path, err)
+		}
+
+		for i := range list.Items {
+			objects = append(objects, &list.Items[i])
+		}
+	}
+
+	return objects, nil
+}
+
 type manifest struct {
 	Path string
 	Data []byte
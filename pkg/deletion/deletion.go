@@ -0,0 +1,105 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package deletion checks whether Hub objects can safely be deleted, using the reference graph of the Hub kinds.
+// It backs the deletion admission handler of the webhook package, and provides finalizer helpers for the
+// controllers preferring to block deletion until the references are gone.
+package deletion
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/traefik/hub-crds/pkg/graph"
+)
+
+// Mode defines how the deletion of referenced objects is handled.
+type Mode string
+
+// Modes.
+const (
+	// ModeDeny refuses the deletion of objects referenced by name.
+	ModeDeny Mode = "Deny"
+	// ModeWarn allows the deletion of objects referenced by name, with a warning.
+	ModeWarn Mode = "Warn"
+)
+
+// Result is the result of a deletion check.
+type Result struct {
+	// Allowed is false when the deletion must be refused.
+	Allowed bool
+	// References are the references by name to the object, from existing objects.
+	References []graph.Edge
+	// Selectors are the label selectors of existing objects matching the object.
+	Selectors []graph.Edge
+	// Warnings are the messages to report when the deletion is allowed despite references or selectors.
+	Warnings []string
+}
+
+// Message returns the description of the references to the object, or an empty string if there is none.
+func (r Result) Message() string {
+	if len(r.References) == 0 {
+		return ""
+	}
+
+	return "referenced by " + describe(r.References)
+}
+
+// Check checks whether the object of the given node can be deleted.
+// References by name, which would dangle after the deletion, refuse it in ModeDeny and warn otherwise.
+// Label selectors matching the object only warn, as they don't require any object to match.
+// Referrers already being deleted are ignored, so that an object and its referrers can be deleted together.
+func Check(g *graph.Graph, node graph.Node, mode Mode) Result {
+	result := Result{Allowed: true}
+
+	for _, edge := range g.Referrers(node) {
+		if !g.Exists(edge.From) || g.Deleting(edge.From) {
+			continue
+		}
+
+		if edge.Selector {
+			result.Selectors = append(result.Selectors, edge)
+		} else {
+			result.References = append(result.References, edge)
+		}
+	}
+
+	if len(result.References) > 0 {
+		if mode == ModeDeny {
+			result.Allowed = false
+		} else {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("%s %s/%s is %s",
+				node.Kind, node.Namespace, node.Name, result.Message()))
+		}
+	}
+
+	if len(result.Selectors) > 0 {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("%s %s/%s is selected by %s",
+			node.Kind, node.Namespace, node.Name, describe(result.Selectors)))
+	}
+
+	return result
+}
+
+func describe(edges []graph.Edge) string {
+	referrers := make([]string, 0, len(edges))
+	for _, edge := range edges {
+		referrers = append(referrers, fmt.Sprintf("%s %s (%s)", edge.From.Kind, edge.From.Name, edge.Field))
+	}
+
+	return strings.Join(referrers, ", ")
+}
@@ -0,0 +1,196 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package deletion_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	"github.com/traefik/hub-crds/pkg/deletion"
+	"github.com/traefik/hub-crds/pkg/graph"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	g := newGraph(t)
+
+	tests := []struct {
+		desc         string
+		node         graph.Node
+		mode         deletion.Mode
+		wantAllowed  bool
+		wantMessage  string
+		wantWarnings []string
+	}{
+		{
+			desc:        "plan used by a subscription",
+			node:        node("APIPlan", "gold"),
+			mode:        deletion.ModeDeny,
+			wantMessage: "referenced by ManagedSubscription sub (spec.apiPlan.name)",
+		},
+		{
+			desc:         "plan used by a subscription in warn mode",
+			node:         node("APIPlan", "gold"),
+			mode:         deletion.ModeWarn,
+			wantAllowed:  true,
+			wantMessage:  "referenced by ManagedSubscription sub (spec.apiPlan.name)",
+			wantWarnings: []string{"APIPlan default/gold is referenced by ManagedSubscription sub (spec.apiPlan.name)"},
+		},
+		{
+			desc:        "portal auth used by a portal",
+			node:        node("APIPortalAuth", "oidc"),
+			mode:        deletion.ModeDeny,
+			wantMessage: "referenced by APIPortal portal (spec.auth.name)",
+		},
+		{
+			desc:        "AIService routed to by another AIService",
+			node:        node("AIService", "primary"),
+			mode:        deletion.ModeDeny,
+			wantMessage: "referenced by AIService router (spec.routing.backends[].aiService.name)",
+		},
+		{
+			desc:        "AIService routing to another AIService",
+			node:        node("AIService", "router"),
+			mode:        deletion.ModeDeny,
+			wantAllowed: true,
+		},
+		{
+			desc:         "API selected by a bundle",
+			node:         node("API", "users"),
+			mode:         deletion.ModeDeny,
+			wantAllowed:  true,
+			wantWarnings: []string{"API default/users is selected by APIBundle all (spec.apiSelector)"},
+		},
+		{
+			desc:        "unreferenced plan",
+			node:        node("APIPlan", "silver"),
+			mode:        deletion.ModeDeny,
+			wantAllowed: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			result := deletion.Check(g, test.node, test.mode)
+			assert.Equal(t, test.wantAllowed, result.Allowed)
+			assert.Equal(t, test.wantMessage, result.Message())
+			assert.Equal(t, test.wantWarnings, result.Warnings)
+		})
+	}
+}
+
+func TestReleaseFinalizer(t *testing.T) {
+	t.Parallel()
+
+	g := newGraph(t)
+
+	plan := &hubv1alpha1.APIPlan{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gold"}}
+	assert.True(t, deletion.AddFinalizer(plan))
+	assert.False(t, deletion.AddFinalizer(plan))
+	assert.Equal(t, []string{deletion.Finalizer}, plan.Finalizers)
+
+	// Not being deleted.
+	assert.False(t, deletion.ReleaseFinalizer(g, "APIPlan", plan))
+
+	now := metav1.Now()
+	plan.DeletionTimestamp = &now
+
+	// Still referenced.
+	assert.False(t, deletion.ReleaseFinalizer(g, "APIPlan", plan))
+	assert.True(t, deletion.HasFinalizer(plan))
+
+	plan.Name = "silver"
+	assert.True(t, deletion.ReleaseFinalizer(g, "APIPlan", plan))
+	assert.False(t, deletion.HasFinalizer(plan))
+	assert.Empty(t, plan.Finalizers)
+	assert.False(t, deletion.RemoveFinalizer(plan))
+}
+
+func TestReleaseFinalizer_referrerBeingDeleted(t *testing.T) {
+	t.Parallel()
+
+	now := metav1.Now()
+	plan := &hubv1alpha1.APIPlan{ObjectMeta: metav1.ObjectMeta{
+		Namespace:         "default",
+		Name:              "gold",
+		Finalizers:        []string{deletion.Finalizer},
+		DeletionTimestamp: &now,
+	}}
+	subscription := &hubv1alpha1.ManagedSubscription{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "sub", DeletionTimestamp: &now},
+		Spec:       hubv1alpha1.ManagedSubscriptionSpec{APIPlan: hubv1alpha1.APIPlanReference{Name: "gold"}},
+	}
+
+	g, err := graph.Build(plan, subscription)
+	require.NoError(t, err)
+
+	assert.True(t, g.Deleting(node("ManagedSubscription", "sub")))
+
+	result := deletion.Check(g, node("APIPlan", "gold"), deletion.ModeDeny)
+	assert.True(t, result.Allowed)
+	assert.Empty(t, result.Message())
+
+	assert.True(t, deletion.ReleaseFinalizer(g, "APIPlan", plan))
+	assert.False(t, deletion.HasFinalizer(plan))
+}
+
+func newGraph(t *testing.T) *graph.Graph {
+	t.Helper()
+
+	g, err := graph.Build(
+		&hubv1alpha1.AIService{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "primary"},
+			Spec:       hubv1alpha1.AIServiceSpec{AIServiceProvider: hubv1alpha1.AIServiceProvider{OpenAI: &hubv1alpha1.OpenAI{}}},
+		},
+		&hubv1alpha1.AIService{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "router"},
+			Spec: hubv1alpha1.AIServiceSpec{Routing: &hubv1alpha1.AIServiceRouting{
+				Backends: []hubv1alpha1.AIServiceBackend{{AIService: &hubv1alpha1.AIServiceReference{Name: "primary"}}},
+			}},
+		},
+		&hubv1alpha1.API{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "users"}},
+		&hubv1alpha1.APIBundle{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "all"},
+			Spec:       hubv1alpha1.APIBundleSpec{APISelector: &metav1.LabelSelector{}},
+		},
+		&hubv1alpha1.APIPlan{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gold"}},
+		&hubv1alpha1.APIPlan{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "silver"}},
+		&hubv1alpha1.APIPortalAuth{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "oidc"}},
+		&hubv1alpha1.APIPortal{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "portal"},
+			Spec:       hubv1alpha1.APIPortalSpec{Auth: &hubv1alpha1.APIPortalAuthReference{Name: "oidc"}},
+		},
+		&hubv1alpha1.ManagedSubscription{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "sub"},
+			Spec:       hubv1alpha1.ManagedSubscriptionSpec{APIPlan: hubv1alpha1.APIPlanReference{Name: "gold"}},
+		},
+	)
+	require.NoError(t, err)
+
+	return g
+}
+
+func node(kind, name string) graph.Node {
+	return graph.Node{Kind: kind, Namespace: "default", Name: name}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package deletion
+
+import (
+	"slices"
+
+	"github.com/traefik/hub-crds/pkg/graph"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Finalizer is the finalizer blocking the deletion of Hub objects while they are referenced by name.
+const Finalizer = "hub.traefik.io/referenced"
+
+// HasFinalizer returns whether the object has the Finalizer.
+func HasFinalizer(obj metav1.Object) bool {
+	return slices.Contains(obj.GetFinalizers(), Finalizer)
+}
+
+// AddFinalizer adds the Finalizer to the object. It returns true when the object has been updated.
+func AddFinalizer(obj metav1.Object) bool {
+	if HasFinalizer(obj) {
+		return false
+	}
+
+	obj.SetFinalizers(append(obj.GetFinalizers(), Finalizer))
+
+	return true
+}
+
+// RemoveFinalizer removes the Finalizer from the object. It returns true when the object has been updated.
+func RemoveFinalizer(obj metav1.Object) bool {
+	if !HasFinalizer(obj) {
+		return false
+	}
+
+	obj.SetFinalizers(slices.DeleteFunc(slices.Clone(obj.GetFinalizers()), func(f string) bool {
+		return f == Finalizer
+	}))
+
+	return true
+}
+
+// ReleaseFinalizer removes the Finalizer from an object being deleted once nothing references it by name anymore,
+// the referrers being deleted aside.
+// It returns true when the object has been updated. Controllers call it on each reconciliation of a deleted object,
+// and requeue the object when its referrers change, see the index package.
+func ReleaseFinalizer(g *graph.Graph, kind string, obj metav1.Object) bool {
+	if obj.GetDeletionTimestamp() == nil {
+		return false
+	}
+
+	node := graph.Node{Kind: kind, Namespace: obj.GetNamespace(), Name: obj.GetName()}
+	if result := Check(g, node, ModeDeny); !result.Allowed {
+		return false
+	}
+
+	return RemoveFinalizer(obj)
+}
@@ -0,0 +1,295 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/traefik/hub-crds/pkg/apis/hub"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	hubinformers "github.com/traefik/hub-crds/pkg/client/informers/externalversions/hub/v1alpha1"
+	"github.com/traefik/hub-crds/pkg/index"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Kinds of the graph nodes.
+const (
+	kindAIService          = "AIService"
+	kindAPI                = "API"
+	kindAPIBundle          = "APIBundle"
+	kindAPIPlan            = "APIPlan"
+	kindAPIPortalAuth      = "APIPortalAuth"
+	kindAPIVersion         = "APIVersion"
+	kindManagedApplication = "ManagedApplication"
+)
+
+// Label selector fields.
+const (
+	fieldAPISelector         = "spec.apiSelector"
+	fieldApplicationSelector = "spec.managedApplicationSelector"
+)
+
+// referenceKinds are the kinds referenced by the indexed fields, except index.FieldParentRef which holds the kind.
+var referenceKinds = map[string]string{
+	index.FieldAPIPlanName:            kindAPIPlan,
+	index.FieldAPIName:                kindAPI,
+	index.FieldAPIBundleName:          kindAPIBundle,
+	index.FieldVersionName:            kindAPIVersion,
+	index.FieldAuthName:               kindAPIPortalAuth,
+	index.FieldManagedApplicationName: kindManagedApplication,
+	index.FieldAIServiceBackendName:   kindAIService,
+}
+
+type selectorRef struct {
+	from     Node
+	field    string
+	kind     string
+	selector labels.Selector
+}
+
+// Builder builds a Graph from hub.traefik.io objects.
+type Builder struct {
+	scheme *runtime.Scheme
+
+	nodes     map[Node]labels.Set
+	deleting  map[Node]bool
+	edges     []Edge
+	selectors []selectorRef
+}
+
+// NewBuilder creates a new Builder.
+func NewBuilder() (*Builder, error) {
+	scheme := runtime.NewScheme()
+	if err := hub.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("adding hub.traefik.io resources: %w", err)
+	}
+
+	return &Builder{
+		scheme:   scheme,
+		nodes:    map[Node]labels.Set{},
+		deleting: map[Node]bool{},
+	}, nil
+}
+
+// Build builds the graph of the given objects.
+func Build(objs ...runtime.Object) (*Graph, error) {
+	builder, err := NewBuilder()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, obj := range objs {
+		if err = builder.Add(obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return builder.Graph(), nil
+}
+
+// FromInformers builds the graph of the objects held by the given informers.
+// The informers must have been started and synced.
+func FromInformers(informers hubinformers.Interface) (*Graph, error) {
+	builder, err := NewBuilder()
+	if err != nil {
+		return nil, err
+	}
+
+	var objs []runtime.Object
+	var errs []error
+	appendAll := func(items []runtime.Object, err error) {
+		objs = append(objs, items...)
+		errs = append(errs, err)
+	}
+
+	appendAll(list(informers.AIServices().Lister().List(labels.Everything())))
+	appendAll(list(informers.APIs().Lister().List(labels.Everything())))
+	appendAll(list(informers.APIBundles().Lister().List(labels.Everything())))
+	appendAll(list(informers.APICatalogItems().Lister().List(labels.Everything())))
+	appendAll(list(informers.APIPlans().Lister().List(labels.Everything())))
+	appendAll(list(informers.APIPortals().Lister().List(labels.Everything())))
+	appendAll(list(informers.APIPortalAuths().Lister().List(labels.Everything())))
+	appendAll(list(informers.APIRateLimits().Lister().List(labels.Everything())))
+	appendAll(list(informers.APIVersions().Lister().List(labels.Everything())))
+	appendAll(list(informers.ContentItems().Lister().List(labels.Everything())))
+	appendAll(list(informers.ManagedApplications().Lister().List(labels.Everything())))
+	appendAll(list(informers.ManagedSubscriptions().Lister().List(labels.Everything())))
+	if err = errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("listing objects: %w", err)
+	}
+
+	for _, obj := range objs {
+		if err = builder.Add(obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return builder.Graph(), nil
+}
+
+func list[T runtime.Object](items []T, err error) ([]runtime.Object, error) {
+	objs := make([]runtime.Object, 0, len(items))
+	for _, item := range items {
+		objs = append(objs, item)
+	}
+
+	return objs, err
+}
+
+// Add adds an object to the graph. The object can be typed or unstructured, of any hub.traefik.io version.
+// Objects of other API groups are ignored.
+func (b *Builder) Add(obj runtime.Object) error {
+	hubObj, err := b.toHub(obj)
+	if err != nil {
+		return err
+	}
+	if hubObj == nil {
+		return nil
+	}
+
+	gvks, _, err := b.scheme.ObjectKinds(hubObj)
+	if err != nil {
+		return fmt.Errorf("getting object kind: %w", err)
+	}
+
+	object, ok := hubObj.(index.Object)
+	if !ok {
+		return fmt.Errorf("unexpected object of type %T", hubObj)
+	}
+
+	from := Node{Kind: gvks[0].Kind, Namespace: object.GetNamespace(), Name: object.GetName()}
+	b.nodes[from] = object.GetLabels()
+	b.deleting[from] = object.GetDeletionTimestamp() != nil
+
+	for _, idx := range index.Indexes() {
+		for _, value := range idx.Extract(object) {
+			to := Node{Kind: referenceKinds[idx.Field], Namespace: from.Namespace, Name: value}
+			if idx.Field == index.FieldParentRef {
+				to.Kind, to.Name, _ = strings.Cut(value, "/")
+			}
+
+			b.edges = append(b.edges, Edge{From: from, To: to, Field: idx.Field})
+		}
+	}
+
+	switch o := hubObj.(type) {
+	case *hubv1alpha1.APIBundle:
+		return b.addSelector(from, fieldAPISelector, kindAPI, o.Spec.APISelector)
+	case *hubv1alpha1.APICatalogItem:
+		return b.addSelector(from, fieldAPISelector, kindAPI, o.Spec.APISelector)
+	case *hubv1alpha1.APIRateLimit:
+		return b.addSelector(from, fieldAPISelector, kindAPI, o.Spec.APISelector)
+	case *hubv1alpha1.ManagedSubscription:
+		if err = b.addSelector(from, fieldAPISelector, kindAPI, o.Spec.APISelector); err != nil {
+			return err
+		}
+
+		return b.addSelector(from, fieldApplicationSelector, kindManagedApplication, o.Spec.ManagedApplicationSelector)
+	}
+
+	return nil
+}
+
+// Graph returns the graph of the objects added so far, resolving the label selectors.
+func (b *Builder) Graph() *Graph {
+	g := newGraph()
+	for node := range b.nodes {
+		g.addNode(node, b.deleting[node])
+	}
+
+	for _, edge := range b.edges {
+		g.addEdge(edge)
+	}
+
+	for _, ref := range b.selectors {
+		for node, nodeLabels := range b.nodes {
+			if node.Kind != ref.kind || node.Namespace != ref.from.Namespace || !ref.selector.Matches(nodeLabels) {
+				continue
+			}
+
+			g.addEdge(Edge{From: ref.from, To: node, Field: ref.field, Selector: true})
+		}
+	}
+
+	return g
+}
+
+func (b *Builder) addSelector(from Node, field, kind string, selector *metav1.LabelSelector) error {
+	if selector == nil {
+		return nil
+	}
+
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return fmt.Errorf("parsing %s of %s: %w", field, from, err)
+	}
+
+	b.selectors = append(b.selectors, selectorRef{from: from, field: field, kind: kind, selector: s})
+
+	return nil
+}
+
+// toHub returns the given object as a typed hub.traefik.io/v1alpha1 object, or nil if it is not a hub object.
+func (b *Builder) toHub(obj runtime.Object) (runtime.Object, error) {
+	gvks, _, err := b.scheme.ObjectKinds(obj)
+	if err != nil {
+		if runtime.IsNotRegisteredError(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("getting object kind: %w", err)
+	}
+
+	gvk := gvks[0]
+	if gvk.Group != hubv1alpha1.SchemeGroupVersion.Group {
+		return nil, nil
+	}
+
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		typed, err := b.scheme.New(gvk)
+		if err != nil {
+			return nil, fmt.Errorf("creating %s object: %w", gvk, err)
+		}
+
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), typed); err != nil {
+			return nil, fmt.Errorf("converting %s %s/%s: %w", gvk.Kind, u.GetNamespace(), u.GetName(), err)
+		}
+
+		obj = typed
+	}
+
+	if gvk.GroupVersion() == hubv1alpha1.SchemeGroupVersion {
+		return obj, nil
+	}
+
+	hubObj, err := b.scheme.New(hubv1alpha1.SchemeGroupVersion.WithKind(gvk.Kind))
+	if err != nil {
+		return nil, fmt.Errorf("creating %s object: %w", gvk.Kind, err)
+	}
+
+	if err = b.scheme.Convert(obj, hubObj, nil); err != nil {
+		return nil, fmt.Errorf("converting %s to %s: %w", gvk, hubv1alpha1.SchemeGroupVersion, err)
+	}
+
+	return hubObj, nil
+}
@@ -0,0 +1,92 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package graph
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DOT returns the Graphviz DOT representation of the graph.
+// Label selector edges are dashed, and missing objects are drawn in red.
+func (g *Graph) DOT() string {
+	var b strings.Builder
+
+	b.WriteString("digraph hub {\n")
+	b.WriteString("\trankdir=LR;\n")
+	b.WriteString("\tnode [shape=box];\n")
+
+	for _, node := range g.Nodes() {
+		attrs := fmt.Sprintf("label=%s", strconv.Quote(node.Kind+"\n"+node.Namespace+"/"+node.Name))
+		if !g.nodes[node] {
+			attrs += ", style=dashed, color=red"
+		}
+
+		fmt.Fprintf(&b, "\t%s [%s];\n", strconv.Quote(node.String()), attrs)
+	}
+
+	for _, edge := range g.Edges() {
+		attrs := fmt.Sprintf("label=%s", strconv.Quote(edge.Field))
+		if edge.Selector {
+			attrs += ", style=dashed"
+		}
+
+		fmt.Fprintf(&b, "\t%s -> %s [%s];\n", strconv.Quote(edge.From.String()), strconv.Quote(edge.To.String()), attrs)
+	}
+
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+// Mermaid returns the Mermaid flowchart representation of the graph.
+// Label selector edges are dotted, and missing objects use the "missing" class.
+func (g *Graph) Mermaid() string {
+	var b strings.Builder
+
+	b.WriteString("flowchart LR\n")
+
+	ids := map[Node]string{}
+	var missing []string
+	for i, node := range g.Nodes() {
+		id := "n" + strconv.Itoa(i)
+		ids[node] = id
+
+		fmt.Fprintf(&b, "\t%s[\"%s<br>%s/%s\"]\n", id, node.Kind, node.Namespace, node.Name)
+		if !g.nodes[node] {
+			missing = append(missing, id)
+		}
+	}
+
+	for _, edge := range g.Edges() {
+		arrow := "-->"
+		if edge.Selector {
+			arrow = "-.->"
+		}
+
+		fmt.Fprintf(&b, "\t%s %s|\"%s\"| %s\n", ids[edge.From], arrow, edge.Field, ids[edge.To])
+	}
+
+	if len(missing) > 0 {
+		b.WriteString("\tclassDef missing stroke:#f00,stroke-dasharray:4\n")
+		fmt.Fprintf(&b, "\tclass %s missing\n", strings.Join(missing, ","))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,218 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package graph builds the dependency graph of Traefik Hub objects: the references and label selectors linking
+// them. It exports the graph to Graphviz DOT and Mermaid, detects the objects referenced by nothing, and lists the
+// objects affected by the deletion of another one.
+package graph
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// Node is an object of the graph.
+type Node struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// String returns the Kind/namespace/name representation of the node.
+func (n Node) String() string {
+	return n.Kind + "/" + n.Namespace + "/" + n.Name
+}
+
+func compareNodes(a, b Node) int {
+	return cmp.Or(
+		strings.Compare(a.Namespace, b.Namespace),
+		strings.Compare(a.Kind, b.Kind),
+		strings.Compare(a.Name, b.Name),
+	)
+}
+
+// Edge links an object to an object it depends on.
+type Edge struct {
+	From Node
+	To   Node
+	// Field is the field of the From object holding the reference or the label selector.
+	Field string
+	// Selector is true when the edge comes from a label selector rather than a reference by name.
+	Selector bool
+}
+
+func compareEdges(a, b Edge) int {
+	return cmp.Or(
+		compareNodes(a.From, b.From),
+		compareNodes(a.To, b.To),
+		strings.Compare(a.Field, b.Field),
+	)
+}
+
+// referencedKinds are the kinds that only exist to be referenced by other objects,
+// and are therefore orphans when nothing references them.
+var referencedKinds = []string{
+	kindAPI,
+	kindAPIBundle,
+	kindAPIPlan,
+	kindAPIPortalAuth,
+	kindAPIVersion,
+	kindManagedApplication,
+}
+
+// Graph is the dependency graph of Hub objects. Referenced objects that have not been added to the graph are kept
+// as missing nodes, so that dangling references can be reported.
+type Graph struct {
+	nodes    map[Node]bool
+	deleting map[Node]bool
+	edges    []Edge
+	incoming map[Node][]Edge
+	outgoing map[Node][]Edge
+}
+
+func newGraph() *Graph {
+	return &Graph{
+		nodes:    map[Node]bool{},
+		deleting: map[Node]bool{},
+		incoming: map[Node][]Edge{},
+		outgoing: map[Node][]Edge{},
+	}
+}
+
+func (g *Graph) addNode(node Node, deleting bool) {
+	g.nodes[node] = true
+	if deleting {
+		g.deleting[node] = true
+	}
+}
+
+func (g *Graph) addEdge(edge Edge) {
+	if _, ok := g.nodes[edge.To]; !ok {
+		g.nodes[edge.To] = false
+	}
+
+	if slices.Contains(g.outgoing[edge.From], edge) {
+		return
+	}
+
+	g.edges = append(g.edges, edge)
+	g.outgoing[edge.From] = append(g.outgoing[edge.From], edge)
+	g.incoming[edge.To] = append(g.incoming[edge.To], edge)
+}
+
+// Nodes returns the nodes of the graph, missing ones included, sorted by namespace, kind and name.
+func (g *Graph) Nodes() []Node {
+	nodes := make([]Node, 0, len(g.nodes))
+	for node := range g.nodes {
+		nodes = append(nodes, node)
+	}
+	slices.SortFunc(nodes, compareNodes)
+
+	return nodes
+}
+
+// Edges returns the edges of the graph, sorted.
+func (g *Graph) Edges() []Edge {
+	edges := slices.Clone(g.edges)
+	slices.SortFunc(edges, compareEdges)
+
+	return edges
+}
+
+// Exists returns whether the object of the node has been added to the graph.
+func (g *Graph) Exists(node Node) bool {
+	return g.nodes[node]
+}
+
+// Deleting returns whether the object of the node is being deleted, that is it has a deletion timestamp.
+func (g *Graph) Deleting(node Node) bool {
+	return g.deleting[node]
+}
+
+// References returns the edges from the given node to the objects it depends on.
+func (g *Graph) References(node Node) []Edge {
+	edges := slices.Clone(g.outgoing[node])
+	slices.SortFunc(edges, compareEdges)
+
+	return edges
+}
+
+// Referrers returns the edges from the objects depending on the given node.
+func (g *Graph) Referrers(node Node) []Edge {
+	edges := slices.Clone(g.incoming[node])
+	slices.SortFunc(edges, compareEdges)
+
+	return edges
+}
+
+// Dangling returns the edges referencing objects missing from the graph.
+func (g *Graph) Dangling() []Edge {
+	var edges []Edge
+	for _, edge := range g.edges {
+		if !g.nodes[edge.To] {
+			edges = append(edges, edge)
+		}
+	}
+	slices.SortFunc(edges, compareEdges)
+
+	return edges
+}
+
+// Orphans returns the objects referenced by nothing, among the kinds that only exist to be referenced:
+// APIs, APIBundles, APIPlans, APIPortalAuths, APIVersions and ManagedApplications.
+func (g *Graph) Orphans() []Node {
+	var orphans []Node
+	for node, exists := range g.nodes {
+		if !exists || !slices.Contains(referencedKinds, node.Kind) {
+			continue
+		}
+		if len(g.incoming[node]) == 0 {
+			orphans = append(orphans, node)
+		}
+	}
+	slices.SortFunc(orphans, compareNodes)
+
+	return orphans
+}
+
+// Impact returns the objects affected by the deletion of the given node: the objects depending on it, directly or
+// transitively. For instance, deleting an API affects the APIBundles selecting it, and the APICatalogItems and
+// ManagedSubscriptions referencing these bundles.
+func (g *Graph) Impact(node Node) []Node {
+	visited := map[Node]struct{}{node: {}}
+	queue := []Node{node}
+
+	var affected []Node
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, edge := range g.incoming[current] {
+			if _, ok := visited[edge.From]; ok {
+				continue
+			}
+			visited[edge.From] = struct{}{}
+
+			affected = append(affected, edge.From)
+			queue = append(queue, edge.From)
+		}
+	}
+	slices.SortFunc(affected, compareNodes)
+
+	return affected
+}
@@ -0,0 +1,244 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	"github.com/traefik/hub-crds/pkg/client/clientset/versioned/fake"
+	"github.com/traefik/hub-crds/pkg/client/informers/externalversions"
+	"github.com/traefik/hub-crds/pkg/graph"
+	"github.com/traefik/hub-crds/pkg/index"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestBuild(t *testing.T) {
+	t.Parallel()
+
+	g, err := graph.Build(objects()...)
+	require.NoError(t, err)
+
+	assert.Equal(t, []graph.Edge{
+		{From: node("API", "users"), To: node("APIVersion", "users-v1"), Field: index.FieldVersionName},
+		{From: node("APIBundle", "all"), To: node("API", "users"), Field: "spec.apiSelector", Selector: true},
+		{From: node("APIPortal", "portal"), To: node("APIPortalAuth", "oidc"), Field: index.FieldAuthName},
+		{From: node("ContentItem", "users-doc"), To: node("API", "users"), Field: index.FieldParentRef},
+		{From: node("ManagedSubscription", "sub"), To: node("APIBundle", "all"), Field: index.FieldAPIBundleName},
+		{From: node("ManagedSubscription", "sub"), To: node("APIPlan", "gold"), Field: index.FieldAPIPlanName},
+		{From: node("ManagedSubscription", "sub"), To: node("ManagedApplication", "app"), Field: index.FieldManagedApplicationName},
+	}, g.Edges())
+
+	assert.False(t, g.Exists(node("APIPortalAuth", "oidc")))
+	assert.Equal(t, []graph.Edge{
+		{From: node("APIPortal", "portal"), To: node("APIPortalAuth", "oidc"), Field: index.FieldAuthName},
+	}, g.Dangling())
+}
+
+func TestFromInformers(t *testing.T) {
+	t.Parallel()
+
+	client := fake.NewSimpleClientset(
+		&hubv1alpha1.APIPlan{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gold"}},
+		&hubv1alpha1.ManagedSubscription{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "sub"},
+			Spec:       hubv1alpha1.ManagedSubscriptionSpec{APIPlan: hubv1alpha1.APIPlanReference{Name: "gold"}},
+		},
+	)
+
+	factory := externalversions.NewSharedInformerFactory(client, 0)
+	informers := factory.Hub().V1alpha1()
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	// Listers must be requested before starting the factory for their informers to be started.
+	_, err := graph.FromInformers(informers)
+	require.NoError(t, err)
+
+	factory.Start(stopCh)
+	factory.WaitForCacheSync(stopCh)
+
+	g, err := graph.FromInformers(informers)
+	require.NoError(t, err)
+
+	assert.Equal(t, []graph.Edge{
+		{From: node("ManagedSubscription", "sub"), To: node("APIPlan", "gold"), Field: index.FieldAPIPlanName},
+	}, g.Edges())
+	assert.Empty(t, g.Dangling())
+}
+
+func TestGraph_Orphans(t *testing.T) {
+	t.Parallel()
+
+	g, err := graph.Build(objects()...)
+	require.NoError(t, err)
+
+	assert.Equal(t, []graph.Node{
+		node("API", "orders"),
+		node("APIPlan", "silver"),
+		{Kind: "API", Namespace: "other", Name: "users"},
+	}, g.Orphans())
+}
+
+func TestGraph_Impact(t *testing.T) {
+	t.Parallel()
+
+	g, err := graph.Build(objects()...)
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc string
+		node graph.Node
+		want []graph.Node
+	}{
+		{
+			desc: "API version",
+			node: node("APIVersion", "users-v1"),
+			want: []graph.Node{
+				node("API", "users"),
+				node("APIBundle", "all"),
+				node("ContentItem", "users-doc"),
+				node("ManagedSubscription", "sub"),
+			},
+		},
+		{
+			desc: "API plan",
+			node: node("APIPlan", "gold"),
+			want: []graph.Node{node("ManagedSubscription", "sub")},
+		},
+		{
+			desc: "unreferenced object",
+			node: node("APIPlan", "silver"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.want, g.Impact(test.node))
+		})
+	}
+}
+
+func TestGraph_DOT(t *testing.T) {
+	t.Parallel()
+
+	g, err := graph.Build(&hubv1alpha1.APIPortal{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "portal"},
+		Spec:       hubv1alpha1.APIPortalSpec{Auth: &hubv1alpha1.APIPortalAuthReference{Name: "oidc"}},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, `digraph hub {
+	rankdir=LR;
+	node [shape=box];
+	"APIPortal/default/portal" [label="APIPortal\ndefault/portal"];
+	"APIPortalAuth/default/oidc" [label="APIPortalAuth\ndefault/oidc", style=dashed, color=red];
+	"APIPortal/default/portal" -> "APIPortalAuth/default/oidc" [label="spec.auth.name"];
+}
+`, g.DOT())
+}
+
+func TestGraph_Mermaid(t *testing.T) {
+	t.Parallel()
+
+	g, err := graph.Build(
+		&hubv1alpha1.API{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "users"}},
+		&hubv1alpha1.APIBundle{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "all"},
+			Spec:       hubv1alpha1.APIBundleSpec{APISelector: &metav1.LabelSelector{}},
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, `flowchart LR
+	n0["API<br>default/users"]
+	n1["APIBundle<br>default/all"]
+	n1 -.->|"spec.apiSelector"| n0
+`, g.Mermaid())
+}
+
+func TestBuild_invalidSelector(t *testing.T) {
+	t.Parallel()
+
+	_, err := graph.Build(&hubv1alpha1.APIBundle{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "all"},
+		Spec: hubv1alpha1.APIBundleSpec{APISelector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: "Unknown"}},
+		}},
+	})
+	require.Error(t, err)
+}
+
+func objects() []runtime.Object {
+	return []runtime.Object{
+		&hubv1alpha1.API{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "users", Labels: map[string]string{"team": "users"}},
+			Spec:       hubv1alpha1.APISpec{Versions: []hubv1alpha1.APIVersionRef{{Name: "users-v1"}}},
+		},
+		&hubv1alpha1.API{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "orders", Labels: map[string]string{"team": "orders"}},
+		},
+		// Objects of other namespaces are not selected.
+		&hubv1alpha1.API{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "users", Labels: map[string]string{"team": "users"}},
+		},
+		&hubv1alpha1.APIBundle{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "all"},
+			Spec: hubv1alpha1.APIBundleSpec{APISelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"team": "users"},
+			}},
+		},
+		&hubv1alpha1.APIVersion{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "users-v1"}},
+		&hubv1alpha1.APIPlan{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gold"}},
+		&hubv1alpha1.APIPlan{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "silver"}},
+		&hubv1alpha1.APIPortal{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "portal"},
+			Spec:       hubv1alpha1.APIPortalSpec{Auth: &hubv1alpha1.APIPortalAuthReference{Name: "oidc"}},
+		},
+		&hubv1alpha1.ContentItem{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "users-doc"},
+			Spec:       hubv1alpha1.ContentItemSpec{ParentRef: hubv1alpha1.ContentItemParentRef{Kind: "API", Name: "users"}},
+		},
+		&hubv1alpha1.ManagedApplication{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "app"}},
+		// Unstructured objects of any version are supported.
+		&unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "hub.traefik.io/v1beta1",
+			"kind":       "ManagedSubscription",
+			"metadata":   map[string]interface{}{"namespace": "default", "name": "sub"},
+			"spec": map[string]interface{}{
+				"apiPlan":             map[string]interface{}{"name": "gold"},
+				"apiBundles":          []interface{}{map[string]interface{}{"name": "all"}},
+				"managedApplications": []interface{}{map[string]interface{}{"name": "app"}},
+			},
+		}},
+		// Objects of other groups are ignored.
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "config"}},
+	}
+}
+
+func node(kind, name string) graph.Node {
+	return graph.Node{Kind: kind, Namespace: "default", Name: name}
+}
@@ -0,0 +1,350 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package index provides the field indexes of the hub.traefik.io/v1alpha1 reference fields, and the functions mapping a
+// referenced object to the objects referencing it. They are meant to back the field indexers and the watches of
+// controllers, either built with controller-runtime or with client-go informers.
+//
+// With controller-runtime, each Index is registered with the field indexer of the manager, and MapReferrers maps a
+// referenced object to the requests of the objects referencing it. With client-go informers, Indexers returns the
+// indexers to add to an informer, and the listers of the referencing kinds answer the reverse lookups, such as
+// ByAPIPlan or ForParent, once AddReferenceIndexers has been called.
+package index
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Indexed fields.
+const (
+	// FieldAPIPlanName indexes the APIPlan referenced by APICatalogItems and ManagedSubscriptions.
+	FieldAPIPlanName = "spec.apiPlan.name"
+	// FieldAPIName indexes the APIs referenced by APIBundles, APICatalogItems, ManagedSubscriptions and APIRateLimits.
+	FieldAPIName = "spec.apis[].name"
+	// FieldAPIBundleName indexes the APIBundles referenced by APICatalogItems and ManagedSubscriptions.
+	FieldAPIBundleName = "spec.apiBundles[].name"
+	// FieldVersionName indexes the APIVersions referenced by APIs.
+	FieldVersionName = "spec.versions[].name"
+	// FieldParentRef indexes the parent referenced by ContentItems. Values are built with ParentRef.
+	FieldParentRef = "spec.parentRef"
+	// FieldAuthName indexes the APIPortalAuth referenced by APIPortals.
+	FieldAuthName = "spec.auth.name"
+	// FieldManagedApplicationName indexes the ManagedApplications referenced by ManagedSubscriptions.
+	FieldManagedApplicationName = "spec.managedApplications[].name"
+	// FieldAIServiceBackendName indexes the AIServices referenced by the routing backends of AIServices.
+	FieldAIServiceBackendName = "spec.routing.backends[].aiService.name"
+)
+
+// Object is a Kubernetes object. It has the same method set as the controller-runtime client.Object.
+type Object interface {
+	metav1.Object
+	runtime.Object
+}
+
+// Index is a field index of a kind.
+type Index struct {
+	// Object is an empty object of the indexed kind.
+	Object Object
+	// Field is the name of the indexed field.
+	Field string
+	// Extract returns the values of the field for the given object.
+	// It returns nil when the object is not of the indexed kind.
+	Extract func(obj Object) []string
+}
+
+// Indexes returns all the field indexes of the hub.traefik.io/v1alpha1 kinds.
+func Indexes() []Index {
+	return []Index{
+		{Object: &hubv1alpha1.APICatalogItem{}, Field: FieldAPIPlanName, Extract: catalogItemAPIPlan},
+		{Object: &hubv1alpha1.APICatalogItem{}, Field: FieldAPIName, Extract: catalogItemAPIs},
+		{Object: &hubv1alpha1.APICatalogItem{}, Field: FieldAPIBundleName, Extract: catalogItemAPIBundles},
+		{Object: &hubv1alpha1.ManagedSubscription{}, Field: FieldAPIPlanName, Extract: subscriptionAPIPlan},
+		{Object: &hubv1alpha1.ManagedSubscription{}, Field: FieldAPIName, Extract: subscriptionAPIs},
+		{Object: &hubv1alpha1.ManagedSubscription{}, Field: FieldAPIBundleName, Extract: subscriptionAPIBundles},
+		{Object: &hubv1alpha1.ManagedSubscription{}, Field: FieldManagedApplicationName, Extract: subscriptionApplications},
+		{Object: &hubv1alpha1.APIBundle{}, Field: FieldAPIName, Extract: bundleAPIs},
+		{Object: &hubv1alpha1.APIRateLimit{}, Field: FieldAPIName, Extract: rateLimitAPIs},
+		{Object: &hubv1alpha1.API{}, Field: FieldVersionName, Extract: apiVersions},
+		{Object: &hubv1alpha1.ContentItem{}, Field: FieldParentRef, Extract: contentItemParent},
+		{Object: &hubv1alpha1.APIPortal{}, Field: FieldAuthName, Extract: portalAuth},
+		{Object: &hubv1alpha1.AIService{}, Field: FieldAIServiceBackendName, Extract: aiServiceBackends},
+	}
+}
+
+// ParentRef returns the FieldParentRef value of a parent of the given kind and name.
+func ParentRef(kind, name string) string {
+	return kind + "/" + name
+}
+
+// Key returns the key under which the Indexers store a value of an object in the given namespace.
+func Key(namespace, value string) string {
+	return namespace + "/" + value
+}
+
+// Indexers returns the client-go indexers of the kind of the given object, to be added to its informer.
+// Values are stored under Key, so that lookups are scoped to a namespace.
+func Indexers(obj Object) cache.Indexers {
+	kind := fmt.Sprintf("%T", obj)
+
+	indexers := cache.Indexers{}
+	for _, idx := range Indexes() {
+		if fmt.Sprintf("%T", idx.Object) != kind {
+			continue
+		}
+
+		extract := idx.Extract
+		indexers[idx.Field] = func(o interface{}) ([]string, error) {
+			object, ok := o.(Object)
+			if !ok {
+				return nil, fmt.Errorf("unexpected object of type %T", o)
+			}
+
+			var keys []string
+			for _, value := range extract(object) {
+				keys = append(keys, Key(object.GetNamespace(), value))
+			}
+
+			return keys, nil
+		}
+	}
+
+	return indexers
+}
+
+// ListFunc lists the objects of the given namespace whose indexed field has the given value.
+type ListFunc func(ctx context.Context, namespace, field, value string) ([]types.NamespacedName, error)
+
+// MapFunc maps an object to the objects to reconcile.
+type MapFunc func(ctx context.Context, obj Object) []types.NamespacedName
+
+// IndexerListFunc returns a ListFunc backed by an informer indexer having the Indexers of the listed kind.
+func IndexerListFunc(indexer cache.Indexer) ListFunc {
+	return func(_ context.Context, namespace, field, value string) ([]types.NamespacedName, error) {
+		objs, err := indexer.ByIndex(field, Key(namespace, value))
+		if err != nil {
+			return nil, fmt.Errorf("listing by index %q: %w", field, err)
+		}
+
+		names := make([]types.NamespacedName, 0, len(objs))
+		for _, o := range objs {
+			object, ok := o.(Object)
+			if !ok {
+				return nil, fmt.Errorf("unexpected object of type %T", o)
+			}
+
+			names = append(names, types.NamespacedName{Namespace: object.GetNamespace(), Name: object.GetName()})
+		}
+
+		return names, nil
+	}
+}
+
+// MapReferrers returns a MapFunc mapping a referenced object to the objects referencing it by name through the
+// given field. For instance, MapReferrers(listSubscriptions, FieldAPIPlanName) maps an APIPlan to the
+// ManagedSubscriptions using it.
+// As watch handlers cannot report errors, the object is mapped to nothing when listing fails.
+func MapReferrers(list ListFunc, field string) MapFunc {
+	return func(ctx context.Context, obj Object) []types.NamespacedName {
+		return mapReferrers(ctx, list, obj.GetNamespace(), field, obj.GetName())
+	}
+}
+
+// MapParentReferrers returns a MapFunc mapping a parent object of the given kind to the ContentItems referencing it.
+// The kind is given explicitly as objects read from a cache usually have an empty TypeMeta.
+func MapParentReferrers(list ListFunc, kind string) MapFunc {
+	return func(ctx context.Context, obj Object) []types.NamespacedName {
+		return mapReferrers(ctx, list, obj.GetNamespace(), FieldParentRef, ParentRef(kind, obj.GetName()))
+	}
+}
+
+func mapReferrers(ctx context.Context, list ListFunc, namespace, field, value string) []types.NamespacedName {
+	names, err := list(ctx, namespace, field, value)
+	if err != nil {
+		return nil
+	}
+
+	return names
+}
+
+func catalogItemAPIPlan(obj Object) []string {
+	item, ok := obj.(*hubv1alpha1.APICatalogItem)
+	if !ok || item.Spec.APIPlan == nil || item.Spec.APIPlan.Name == "" {
+		return nil
+	}
+
+	return []string{item.Spec.APIPlan.Name}
+}
+
+func catalogItemAPIs(obj Object) []string {
+	item, ok := obj.(*hubv1alpha1.APICatalogItem)
+	if !ok {
+		return nil
+	}
+
+	return apiNames(item.Spec.APIs)
+}
+
+func catalogItemAPIBundles(obj Object) []string {
+	item, ok := obj.(*hubv1alpha1.APICatalogItem)
+	if !ok {
+		return nil
+	}
+
+	return apiBundleNames(item.Spec.APIBundles)
+}
+
+func subscriptionAPIPlan(obj Object) []string {
+	sub, ok := obj.(*hubv1alpha1.ManagedSubscription)
+	if !ok || sub.Spec.APIPlan.Name == "" {
+		return nil
+	}
+
+	return []string{sub.Spec.APIPlan.Name}
+}
+
+func subscriptionAPIs(obj Object) []string {
+	sub, ok := obj.(*hubv1alpha1.ManagedSubscription)
+	if !ok {
+		return nil
+	}
+
+	return apiNames(sub.Spec.APIs)
+}
+
+func subscriptionAPIBundles(obj Object) []string {
+	sub, ok := obj.(*hubv1alpha1.ManagedSubscription)
+	if !ok {
+		return nil
+	}
+
+	return apiBundleNames(sub.Spec.APIBundles)
+}
+
+func subscriptionApplications(obj Object) []string {
+	sub, ok := obj.(*hubv1alpha1.ManagedSubscription)
+	if !ok {
+		return nil
+	}
+
+	var names []string
+	for _, ref := range sub.Spec.ManagedApplications {
+		names = appendName(names, ref.Name)
+	}
+
+	return names
+}
+
+func bundleAPIs(obj Object) []string {
+	bundle, ok := obj.(*hubv1alpha1.APIBundle)
+	if !ok {
+		return nil
+	}
+
+	return apiNames(bundle.Spec.APIs)
+}
+
+func rateLimitAPIs(obj Object) []string {
+	rateLimit, ok := obj.(*hubv1alpha1.APIRateLimit)
+	if !ok {
+		return nil
+	}
+
+	return apiNames(rateLimit.Spec.APIs)
+}
+
+func apiVersions(obj Object) []string {
+	api, ok := obj.(*hubv1alpha1.API)
+	if !ok {
+		return nil
+	}
+
+	var names []string
+	for _, ref := range api.Spec.Versions {
+		names = appendName(names, ref.Name)
+	}
+
+	return names
+}
+
+func contentItemParent(obj Object) []string {
+	item, ok := obj.(*hubv1alpha1.ContentItem)
+	if !ok || item.Spec.ParentRef.Name == "" {
+		return nil
+	}
+
+	return []string{ParentRef(item.Spec.ParentRef.Kind, item.Spec.ParentRef.Name)}
+}
+
+func portalAuth(obj Object) []string {
+	portal, ok := obj.(*hubv1alpha1.APIPortal)
+	if !ok || portal.Spec.Auth == nil || portal.Spec.Auth.Name == "" {
+		return nil
+	}
+
+	return []string{portal.Spec.Auth.Name}
+}
+
+func aiServiceBackends(obj Object) []string {
+	service, ok := obj.(*hubv1alpha1.AIService)
+	if !ok || service.Spec.Routing == nil {
+		return nil
+	}
+
+	var names []string
+	for _, backend := range service.Spec.Routing.Backends {
+		if backend.AIService != nil {
+			names = appendName(names, backend.AIService.Name)
+		}
+	}
+
+	return names
+}
+
+func apiNames(refs []hubv1alpha1.APIReference) []string {
+	var names []string
+	for _, ref := range refs {
+		names = appendName(names, ref.Name)
+	}
+
+	return names
+}
+
+func apiBundleNames(refs []hubv1alpha1.APIBundleReference) []string {
+	var names []string
+	for _, ref := range refs {
+		names = appendName(names, ref.Name)
+	}
+
+	return names
+}
+
+// appendName appends the name to the names when it is set and not already present.
+func appendName(names []string, name string) []string {
+	if name == "" || slices.Contains(names, name) {
+		return names
+	}
+
+	return append(names, name)
+}
@@ -0,0 +1,190 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package index_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	"github.com/traefik/hub-crds/pkg/index"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestIndexes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc  string
+		obj   index.Object
+		field string
+		want  []string
+	}{
+		{
+			desc:  "catalog item plan",
+			obj:   &hubv1alpha1.APICatalogItem{Spec: hubv1alpha1.APICatalogItemSpec{APIPlan: &hubv1alpha1.APIPlanReference{Name: "gold"}}},
+			field: index.FieldAPIPlanName,
+			want:  []string{"gold"},
+		},
+		{
+			desc:  "catalog item without plan",
+			obj:   &hubv1alpha1.APICatalogItem{},
+			field: index.FieldAPIPlanName,
+		},
+		{
+			desc: "subscription APIs are deduplicated",
+			obj: &hubv1alpha1.ManagedSubscription{Spec: hubv1alpha1.ManagedSubscriptionSpec{
+				APIs: []hubv1alpha1.APIReference{{Name: "a"}, {Name: "b"}, {Name: "a"}},
+			}},
+			field: index.FieldAPIName,
+			want:  []string{"a", "b"},
+		},
+		{
+			desc: "subscription applications",
+			obj: &hubv1alpha1.ManagedSubscription{Spec: hubv1alpha1.ManagedSubscriptionSpec{
+				ManagedApplications: []hubv1alpha1.ManagedApplicationReference{{Name: "app"}},
+			}},
+			field: index.FieldManagedApplicationName,
+			want:  []string{"app"},
+		},
+		{
+			desc: "API versions",
+			obj: &hubv1alpha1.API{Spec: hubv1alpha1.APISpec{
+				Versions: []hubv1alpha1.APIVersionRef{{Name: "v1"}, {Name: "v2"}},
+			}},
+			field: index.FieldVersionName,
+			want:  []string{"v1", "v2"},
+		},
+		{
+			desc: "content item parent",
+			obj: &hubv1alpha1.ContentItem{Spec: hubv1alpha1.ContentItemSpec{
+				ParentRef: hubv1alpha1.ContentItemParentRef{Kind: "API", Name: "users"},
+			}},
+			field: index.FieldParentRef,
+			want:  []string{"API/users"},
+		},
+		{
+			desc:  "portal auth",
+			obj:   &hubv1alpha1.APIPortal{Spec: hubv1alpha1.APIPortalSpec{Auth: &hubv1alpha1.APIPortalAuthReference{Name: "oidc"}}},
+			field: index.FieldAuthName,
+			want:  []string{"oidc"},
+		},
+		{
+			desc: "AIService backends",
+			obj: &hubv1alpha1.AIService{Spec: hubv1alpha1.AIServiceSpec{Routing: &hubv1alpha1.AIServiceRouting{
+				Backends: []hubv1alpha1.AIServiceBackend{
+					{AIService: &hubv1alpha1.AIServiceReference{Name: "primary"}},
+					{AIServiceProvider: hubv1alpha1.AIServiceProvider{OpenAI: &hubv1alpha1.OpenAI{}}},
+					{AIService: &hubv1alpha1.AIServiceReference{Name: "secondary"}},
+				},
+			}}},
+			field: index.FieldAIServiceBackendName,
+			want:  []string{"primary", "secondary"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			indexers := index.Indexers(test.obj)
+			require.Contains(t, indexers, test.field)
+
+			var want []string
+			for _, value := range test.want {
+				want = append(want, index.Key("default", value))
+			}
+
+			test.obj.SetNamespace("default")
+			got, err := indexers[test.field](test.obj)
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestIndexes_wrongKind(t *testing.T) {
+	t.Parallel()
+
+	for _, idx := range index.Indexes() {
+		assert.Nil(t, idx.Extract(&hubv1alpha1.APIPlan{}), idx.Field)
+	}
+}
+
+func TestMapReferrers(t *testing.T) {
+	t.Parallel()
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, index.Indexers(&hubv1alpha1.ManagedSubscription{}))
+	for _, sub := range []*hubv1alpha1.ManagedSubscription{
+		newSubscription("default", "gold-sub", "gold"),
+		newSubscription("default", "silver-sub", "silver"),
+		newSubscription("other", "other-gold-sub", "gold"),
+	} {
+		require.NoError(t, indexer.Add(sub))
+	}
+
+	mapFunc := index.MapReferrers(index.IndexerListFunc(indexer), index.FieldAPIPlanName)
+
+	plan := &hubv1alpha1.APIPlan{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gold"}}
+	assert.Equal(t, []types.NamespacedName{{Namespace: "default", Name: "gold-sub"}}, mapFunc(context.Background(), plan))
+
+	plan.Name = "bronze"
+	assert.Empty(t, mapFunc(context.Background(), plan))
+}
+
+func TestMapParentReferrers(t *testing.T) {
+	t.Parallel()
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, index.Indexers(&hubv1alpha1.ContentItem{}))
+	require.NoError(t, indexer.Add(&hubv1alpha1.ContentItem{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "api-doc"},
+		Spec:       hubv1alpha1.ContentItemSpec{ParentRef: hubv1alpha1.ContentItemParentRef{Kind: "API", Name: "users"}},
+	}))
+	require.NoError(t, indexer.Add(&hubv1alpha1.ContentItem{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bundle-doc"},
+		Spec:       hubv1alpha1.ContentItemSpec{ParentRef: hubv1alpha1.ContentItemParentRef{Kind: "APIBundle", Name: "users"}},
+	}))
+
+	mapFunc := index.MapParentReferrers(index.IndexerListFunc(indexer), "API")
+
+	api := &hubv1alpha1.API{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "users"}}
+	assert.Equal(t, []types.NamespacedName{{Namespace: "default", Name: "api-doc"}}, mapFunc(context.Background(), api))
+}
+
+func TestMapReferrers_listError(t *testing.T) {
+	t.Parallel()
+
+	list := func(context.Context, string, string, string) ([]types.NamespacedName, error) {
+		return nil, errors.New("boom")
+	}
+
+	mapFunc := index.MapReferrers(list, index.FieldAPIPlanName)
+	assert.Nil(t, mapFunc(context.Background(), &hubv1alpha1.APIPlan{}))
+}
+
+func newSubscription(namespace, name, plan string) *hubv1alpha1.ManagedSubscription {
+	return &hubv1alpha1.ManagedSubscription{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec:       hubv1alpha1.ManagedSubscriptionSpec{APIPlan: hubv1alpha1.APIPlanReference{Name: plan}},
+	}
+}
@@ -0,0 +1,178 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package jwtauth
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"math/big"
+	"strings"
+)
+
+// header is the JOSE header of a token.
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid,omitempty"`
+}
+
+// token is a parsed, not yet verified, JWS compact serialization.
+type token struct {
+	header       header
+	claims       map[string]any
+	signingInput []byte
+	signature    []byte
+}
+
+func parseToken(raw string) (*token, error) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token: expected 3 parts")
+	}
+
+	headerJSON, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("decoding header: %w", err)
+	}
+
+	var tok token
+	if err = json.Unmarshal(headerJSON, &tok.header); err != nil {
+		return nil, fmt.Errorf("unmarshaling header: %w", err)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("decoding payload: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	if err = decoder.Decode(&tok.claims); err != nil {
+		return nil, fmt.Errorf("unmarshaling claims: %w", err)
+	}
+
+	tok.signature, err = base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("decoding signature: %w", err)
+	}
+
+	tok.signingInput = []byte(parts[0] + "." + parts[1])
+
+	return &tok, nil
+}
+
+// hashes are the hash functions of the supported algorithms, by size.
+var hashes = map[string]struct {
+	hash crypto.Hash
+	new  func() hash.Hash
+}{
+	"256": {hash: crypto.SHA256, new: sha256.New},
+	"384": {hash: crypto.SHA384, new: sha512.New384},
+	"512": {hash: crypto.SHA512, new: sha512.New},
+}
+
+// ecdsaCurveSizes are the curve sizes of the ECDSA algorithms.
+var ecdsaCurveSizes = map[string]int{"ES256": 256, "ES384": 384, "ES512": 521}
+
+// compatible returns whether the key can verify signatures of the given algorithm.
+func compatible(alg string, key any) bool {
+	switch key.(type) {
+	case []byte:
+		return strings.HasPrefix(alg, "HS")
+	case *rsa.PublicKey:
+		return strings.HasPrefix(alg, "RS") || strings.HasPrefix(alg, "PS")
+	case *ecdsa.PublicKey:
+		return strings.HasPrefix(alg, "ES")
+	case ed25519.PublicKey:
+		return alg == "EdDSA"
+	default:
+		return false
+	}
+}
+
+// verifySignature verifies the signature of the token with the given key.
+func verifySignature(tok *token, key any) error {
+	alg := tok.header.Alg
+	if !compatible(alg, key) {
+		return fmt.Errorf("key of type %T cannot verify %s signatures", key, alg)
+	}
+
+	if alg == "EdDSA" {
+		if !ed25519.Verify(key.(ed25519.PublicKey), tok.signingInput, tok.signature) {
+			return errors.New("invalid signature")
+		}
+
+		return nil
+	}
+
+	h, ok := hashes[alg[2:]]
+	if !ok {
+		return fmt.Errorf("unsupported algorithm %q", alg)
+	}
+
+	digest := h.new()
+	digest.Write(tok.signingInput)
+
+	switch k := key.(type) {
+	case []byte:
+		mac := hmac.New(h.new, k)
+		mac.Write(tok.signingInput)
+		if !hmac.Equal(mac.Sum(nil), tok.signature) {
+			return errors.New("invalid signature")
+		}
+
+	case *rsa.PublicKey:
+		var err error
+		if strings.HasPrefix(alg, "PS") {
+			err = rsa.VerifyPSS(k, h.hash, digest.Sum(nil), tok.signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		} else {
+			err = rsa.VerifyPKCS1v15(k, h.hash, digest.Sum(nil), tok.signature)
+		}
+		if err != nil {
+			return errors.New("invalid signature")
+		}
+
+	case *ecdsa.PublicKey:
+		if k.Curve.Params().BitSize != ecdsaCurveSizes[alg] {
+			return fmt.Errorf("%s signatures cannot be verified with a %s key", alg, k.Curve.Params().Name)
+		}
+
+		size := (k.Curve.Params().BitSize + 7) / 8
+		if len(tok.signature) != 2*size {
+			return errors.New("invalid signature size")
+		}
+
+		r := new(big.Int).SetBytes(tok.signature[:size])
+		s := new(big.Int).SetBytes(tok.signature[size:])
+		if !ecdsa.Verify(k, digest.Sum(nil), r, s) {
+			return errors.New("invalid signature")
+		}
+	}
+
+	return nil
+}
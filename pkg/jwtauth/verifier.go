@@ -0,0 +1,451 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package jwtauth verifies JWTs offline against the JWT configuration of an APIAuth, and returns what the gateway
+// would extract from them: the application ID, the token name and the forwarded headers.
+// The JWKS of the URL sources are fetched with the HTTP client of the Options, so that a local JWKS server can
+// stand in for the identity provider.
+package jwtauth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+)
+
+// Options configures a Verifier.
+type Options struct {
+	// HTTPClient fetches the JWKS of the JWKSURL and TrustedIssuers sources.
+	// Defaults to a client built from the ClientConfig of the spec.
+	HTTPClient *http.Client
+	// SigningSecret is the "value" key of the Secret named by SigningSecretName.
+	SigningSecret []byte
+	// Now returns the current time, used to check the "exp" and "nbf" claims. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// Result is what is extracted from a valid token.
+type Result struct {
+	// AppID is the value of the AppIDClaim.
+	AppID string
+	// TokenName is the value of the TokenNameClaim, if any.
+	TokenName string
+	// Headers are the ForwardHeaders, with the values of their claims. Headers of missing claims are omitted.
+	Headers map[string]string
+	// Claims are all the claims of the token.
+	Claims map[string]any
+}
+
+// Verifier verifies tokens against a JWTAuthSpec.
+type Verifier struct {
+	spec       hubv1alpha1.JWTAuthSpec
+	client     *http.Client
+	maxRetries int
+	now        func() time.Time
+
+	// keys are the keys of the SigningSecretName, PublicKey and JWKSFile sources.
+	keys []key
+
+	jwksMu sync.Mutex
+	jwks   map[string]*jwksEntry
+}
+
+// jwksRefreshInterval is the minimum interval between two fetches of the JWKS of a URL.
+// A JWKS is fetched again when a token has a kid it doesn't hold, which happens when the keys are rotated,
+// and the interval prevents tokens with unknown kids from flooding the JWKS endpoint.
+const jwksRefreshInterval = time.Minute
+
+// jwksEntry is the cached JWKS of a URL.
+type jwksEntry struct {
+	keys   []key
+	loaded bool
+	// fetchedAt is the time of the last fetch, successful or not.
+	fetchedAt time.Time
+	// fetch is the fetch in progress, if any, shared by the verifications waiting for it.
+	fetch *jwksFetch
+}
+
+// jwksFetch is a fetch of a JWKS.
+type jwksFetch struct {
+	done chan struct{}
+	keys []key
+	err  error
+}
+
+// NewVerifier creates a Verifier for the given spec.
+// Static sources are parsed upfront, while the JWKS of the URL sources are fetched on first use and cached,
+// then fetched again when a token has a kid they don't hold, at most once per minute.
+func NewVerifier(spec hubv1alpha1.JWTAuthSpec, opts Options) (*Verifier, error) {
+	if spec.AppIDClaim == "" {
+		return nil, errors.New("appIdClaim is required")
+	}
+
+	v := &Verifier{
+		spec:   spec,
+		client: opts.HTTPClient,
+		now:    opts.Now,
+		jwks:   make(map[string]*jwksEntry),
+	}
+	if v.now == nil {
+		v.now = time.Now
+	}
+
+	if spec.ClientConfig != nil {
+		v.maxRetries = spec.ClientConfig.MaxRetries
+	}
+	if v.client == nil {
+		client, err := newHTTPClient(spec.ClientConfig)
+		if err != nil {
+			return nil, err
+		}
+		v.client = client
+	}
+
+	var sources int
+	if spec.SigningSecretName != "" {
+		sources++
+		if len(opts.SigningSecret) == 0 {
+			return nil, fmt.Errorf("signing secret %q is not provided", spec.SigningSecretName)
+		}
+
+		v.keys = append(v.keys, key{value: opts.SigningSecret})
+	}
+	if spec.PublicKey != "" {
+		sources++
+		publicKey, err := parsePublicKey(spec.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("parsing public key: %w", err)
+		}
+
+		v.keys = append(v.keys, key{value: publicKey})
+	}
+	if spec.JWKSFile != "" {
+		sources++
+		keys, err := parseJWKS([]byte(spec.JWKSFile))
+		if err != nil {
+			return nil, fmt.Errorf("parsing JWKS file: %w", err)
+		}
+
+		v.keys = append(v.keys, keys...)
+	}
+	if spec.JWKSURL != "" {
+		sources++
+	}
+	if len(spec.TrustedIssuers) > 0 {
+		sources++
+	}
+
+	if sources != 1 {
+		return nil, errors.New("exactly one of signingSecretName, publicKey, jwksFile, jwksUrl, or trustedIssuers must be specified")
+	}
+
+	return v, nil
+}
+
+// Verify verifies the signature and the time claims of the token, pins its issuer when verified with a trusted issuer,
+// and returns the values extracted from its claims.
+func (v *Verifier) Verify(ctx context.Context, rawToken string) (*Result, error) {
+	tok, err := parseToken(rawToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if tok.header.Alg == "" || tok.header.Alg == "none" {
+		return nil, errors.New("unsigned tokens are not supported")
+	}
+
+	keys, err := v.keysFor(ctx, tok)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = verifyWithKeys(tok, keys); err != nil {
+		return nil, err
+	}
+
+	if err = v.checkTime(tok.claims); err != nil {
+		return nil, err
+	}
+
+	return v.extract(tok.claims)
+}
+
+// keysFor returns the keys that may have signed the token.
+func (v *Verifier) keysFor(ctx context.Context, tok *token) ([]key, error) {
+	var url string
+	switch {
+	case v.spec.JWKSURL != "":
+		url = v.spec.JWKSURL
+
+	case len(v.spec.TrustedIssuers) > 0:
+		issuer, _ := tok.claims["iss"].(string)
+
+		for _, trusted := range v.spec.TrustedIssuers {
+			if trusted.Issuer == issuer {
+				url = trusted.JWKSURL
+				break
+			}
+			if trusted.Issuer == "" {
+				url = trusted.JWKSURL
+			}
+		}
+		if url == "" {
+			return nil, fmt.Errorf("untrusted issuer %q", issuer)
+		}
+
+	default:
+		return v.keys, nil
+	}
+
+	return v.jwksKeys(ctx, url, tok.header.Kid)
+}
+
+// jwksKeys returns the keys of the JWKS of the URL, fetching it if it has not been loaded yet, or if it doesn't hold
+// the given kid and has not been fetched for jwksRefreshInterval. Concurrent verifications share the same fetch,
+// and the lock is not held while fetching.
+func (v *Verifier) jwksKeys(ctx context.Context, url, kid string) ([]key, error) {
+	v.jwksMu.Lock()
+
+	entry, ok := v.jwks[url]
+	if !ok {
+		entry = &jwksEntry{}
+		v.jwks[url] = entry
+	}
+
+	if entry.fetch == nil && entry.loaded && (hasKeyID(entry.keys, kid) || v.now().Sub(entry.fetchedAt) < jwksRefreshInterval) {
+		keys := entry.keys
+		v.jwksMu.Unlock()
+
+		return keys, nil
+	}
+
+	fetch := entry.fetch
+	if fetch != nil {
+		v.jwksMu.Unlock()
+
+		select {
+		case <-fetch.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		return fetch.keys, fetch.err
+	}
+
+	fetch = &jwksFetch{done: make(chan struct{})}
+	entry.fetch = fetch
+	v.jwksMu.Unlock()
+
+	fetch.keys, fetch.err = fetchJWKS(ctx, v.client, url, v.maxRetries)
+
+	v.jwksMu.Lock()
+	entry.fetch = nil
+	entry.fetchedAt = v.now()
+	if fetch.err == nil {
+		entry.keys = fetch.keys
+		entry.loaded = true
+	}
+	v.jwksMu.Unlock()
+
+	close(fetch.done)
+
+	return fetch.keys, fetch.err
+}
+
+// hasKeyID reports whether the given keys may verify a token with the given kid.
+// As in verifyWithKeys, tokens without kid and keys without ID match any key and any kid.
+func hasKeyID(keys []key, kid string) bool {
+	if kid == "" {
+		return true
+	}
+
+	for _, k := range keys {
+		if k.id == "" || k.id == kid {
+			return true
+		}
+	}
+
+	return false
+}
+
+// verifyWithKeys verifies the token signature with the keys matching its header.
+func verifyWithKeys(tok *token, keys []key) error {
+	var candidates int
+	for _, k := range keys {
+		if tok.header.Kid != "" && k.id != "" && k.id != tok.header.Kid {
+			continue
+		}
+		if k.alg != "" && k.alg != tok.header.Alg {
+			continue
+		}
+		if !compatible(tok.header.Alg, k.value) {
+			continue
+		}
+
+		candidates++
+		if verifySignature(tok, k.value) == nil {
+			return nil
+		}
+	}
+
+	if candidates == 0 {
+		return fmt.Errorf("no key found to verify %s token with kid %q", tok.header.Alg, tok.header.Kid)
+	}
+
+	return errors.New("invalid signature")
+}
+
+func (v *Verifier) checkTime(claims map[string]any) error {
+	now := v.now()
+
+	if exp, ok, err := numericDate(claims, "exp"); err != nil {
+		return err
+	} else if ok && !now.Before(exp) {
+		return fmt.Errorf("token expired at %s", exp.UTC().Format(time.RFC3339))
+	}
+
+	if nbf, ok, err := numericDate(claims, "nbf"); err != nil {
+		return err
+	} else if ok && now.Before(nbf) {
+		return fmt.Errorf("token not valid before %s", nbf.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func (v *Verifier) extract(claims map[string]any) (*Result, error) {
+	appID, ok := claimString(claims, v.spec.AppIDClaim)
+	if !ok {
+		return nil, fmt.Errorf("missing app ID claim %q", v.spec.AppIDClaim)
+	}
+
+	result := &Result{
+		AppID:  appID,
+		Claims: claims,
+	}
+
+	if v.spec.TokenNameClaim != "" {
+		result.TokenName, _ = claimString(claims, v.spec.TokenNameClaim)
+	}
+
+	for name, claim := range v.spec.ForwardHeaders {
+		if value, ok := claimString(claims, claim); ok {
+			if result.Headers == nil {
+				result.Headers = make(map[string]string)
+			}
+			result.Headers[name] = value
+		}
+	}
+
+	return result, nil
+}
+
+// claimString returns the value of the claim as a string. Strings are returned as is, other values JSON encoded.
+func claimString(claims map[string]any, name string) (string, bool) {
+	value, ok := claims[name]
+	if !ok || value == nil {
+		return "", false
+	}
+
+	if s, ok := value.(string); ok {
+		return s, true
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", false
+	}
+
+	return string(data), true
+}
+
+func numericDate(claims map[string]any, name string) (time.Time, bool, error) {
+	value, ok := claims[name]
+	if !ok {
+		return time.Time{}, false, nil
+	}
+
+	number, ok := value.(json.Number)
+	if !ok {
+		return time.Time{}, false, fmt.Errorf("claim %q is not a number", name)
+	}
+
+	seconds, err := number.Float64()
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("parsing claim %q: %w", name, err)
+	}
+
+	return time.Unix(0, int64(seconds*float64(time.Second))), true, nil
+}
+
+func newHTTPClient(cfg *hubv1alpha1.HTTPClientConfig) (*http.Client, error) {
+	client := &http.Client{Timeout: 5 * time.Second}
+	if cfg == nil {
+		return client, nil
+	}
+
+	if cfg.TimeoutSeconds > 0 {
+		client.Timeout = time.Duration(cfg.TimeoutSeconds) * time.Second
+	}
+
+	if cfg.TLS != nil {
+		tlsConfig := &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			// #nosec G402 -- mirrors the explicit opt-in of the APIAuth.
+			InsecureSkipVerify: cfg.TLS.InsecureSkipVerify,
+		}
+
+		if cfg.TLS.CA != "" {
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM([]byte(cfg.TLS.CA)) {
+				return nil, errors.New("parsing client TLS CA: no certificate found")
+			}
+			tlsConfig.RootCAs = pool
+		}
+
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = tlsConfig
+		client.Transport = transport
+	}
+
+	return client, nil
+}
+
+// TokenFromRequest returns the token of the request, read from the Authorization bearer header, or from the
+// TokenQueryKey query parameter if configured.
+func TokenFromRequest(spec hubv1alpha1.JWTAuthSpec, req *http.Request) (string, bool) {
+	if scheme, token, ok := strings.Cut(req.Header.Get("Authorization"), " "); ok && strings.EqualFold(scheme, "Bearer") && token != "" {
+		return token, true
+	}
+
+	if spec.TokenQueryKey != "" {
+		if token := req.URL.Query().Get(spec.TokenQueryKey); token != "" {
+			return token, true
+		}
+	}
+
+	return "", false
+}
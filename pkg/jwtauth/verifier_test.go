@@ -0,0 +1,472 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package jwtauth_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	"github.com/traefik/hub-crds/pkg/jwtauth"
+)
+
+var now = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestVerifier_Verify(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rsaDER, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	require.NoError(t, err)
+	rsaPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: rsaDER}))
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecJWKS := jwks(t, map[string]any{
+		"kty": "EC", "kid": "ec", "crv": "P-256",
+		"x": b64(ecKey.X.FillBytes(make([]byte, 32))),
+		"y": b64(ecKey.Y.FillBytes(make([]byte, 32))),
+	})
+
+	secret := []byte("my-signing-secret")
+
+	claims := map[string]any{
+		"sub":       "user",
+		"client_id": "my-app",
+		"name":      "ci-token",
+		"groups":    []string{"admin"},
+		"exp":       now.Add(time.Hour).Unix(),
+	}
+
+	tests := []struct {
+		desc    string
+		spec    hubv1alpha1.JWTAuthSpec
+		opts    jwtauth.Options
+		token   string
+		want    *jwtauth.Result
+		wantErr string
+	}{
+		{
+			desc:  "HMAC signing secret",
+			spec:  hubv1alpha1.JWTAuthSpec{AppIDClaim: "client_id", SigningSecretName: "secret"},
+			opts:  jwtauth.Options{SigningSecret: secret},
+			token: signHMAC(t, secret, claims),
+			want:  &jwtauth.Result{AppID: "my-app"},
+		},
+		{
+			desc:    "wrong signing secret",
+			spec:    hubv1alpha1.JWTAuthSpec{AppIDClaim: "client_id", SigningSecretName: "secret"},
+			opts:    jwtauth.Options{SigningSecret: []byte("other")},
+			token:   signHMAC(t, secret, claims),
+			wantErr: "invalid signature",
+		},
+		{
+			desc: "RSA public key with token name and forwarded headers",
+			spec: hubv1alpha1.JWTAuthSpec{
+				AppIDClaim:     "client_id",
+				TokenNameClaim: "name",
+				ForwardHeaders: map[string]string{"X-User": "sub", "X-Groups": "groups", "X-Missing": "missing"},
+				PublicKey:      rsaPEM,
+			},
+			token: signRSA(t, rsaKey, "", claims),
+			want: &jwtauth.Result{
+				AppID:     "my-app",
+				TokenName: "ci-token",
+				Headers:   map[string]string{"X-User": "user", "X-Groups": `["admin"]`},
+			},
+		},
+		{
+			desc:    "HMAC token against a public key",
+			spec:    hubv1alpha1.JWTAuthSpec{AppIDClaim: "client_id", PublicKey: rsaPEM},
+			token:   signHMAC(t, []byte(rsaPEM), claims),
+			wantErr: `no key found to verify HS256 token with kid ""`,
+		},
+		{
+			desc:  "ECDSA JWKS file",
+			spec:  hubv1alpha1.JWTAuthSpec{AppIDClaim: "client_id", JWKSFile: ecJWKS},
+			token: signECDSA(t, ecKey, "ec", claims),
+			want:  &jwtauth.Result{AppID: "my-app"},
+		},
+		{
+			desc:    "unknown kid",
+			spec:    hubv1alpha1.JWTAuthSpec{AppIDClaim: "client_id", JWKSFile: ecJWKS},
+			token:   signECDSA(t, ecKey, "other", claims),
+			wantErr: `no key found to verify ES256 token with kid "other"`,
+		},
+		{
+			desc:    "expired token",
+			spec:    hubv1alpha1.JWTAuthSpec{AppIDClaim: "client_id", SigningSecretName: "secret"},
+			opts:    jwtauth.Options{SigningSecret: secret},
+			token:   signHMAC(t, secret, map[string]any{"client_id": "my-app", "exp": now.Unix()}),
+			wantErr: "token expired at 2025-01-01T00:00:00Z",
+		},
+		{
+			desc:    "token not valid yet",
+			spec:    hubv1alpha1.JWTAuthSpec{AppIDClaim: "client_id", SigningSecretName: "secret"},
+			opts:    jwtauth.Options{SigningSecret: secret},
+			token:   signHMAC(t, secret, map[string]any{"client_id": "my-app", "nbf": now.Add(time.Minute).Unix()}),
+			wantErr: "token not valid before 2025-01-01T00:01:00Z",
+		},
+		{
+			desc:    "missing app ID",
+			spec:    hubv1alpha1.JWTAuthSpec{AppIDClaim: "azp", SigningSecretName: "secret"},
+			opts:    jwtauth.Options{SigningSecret: secret},
+			token:   signHMAC(t, secret, claims),
+			wantErr: `missing app ID claim "azp"`,
+		},
+		{
+			desc:    "unsigned token",
+			spec:    hubv1alpha1.JWTAuthSpec{AppIDClaim: "client_id", SigningSecretName: "secret"},
+			opts:    jwtauth.Options{SigningSecret: secret},
+			token:   encode(t, map[string]any{"alg": "none"}) + "." + encode(t, claims) + ".",
+			wantErr: "unsigned tokens are not supported",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			test.opts.Now = func() time.Time { return now }
+
+			verifier, err := jwtauth.NewVerifier(test.spec, test.opts)
+			require.NoError(t, err)
+
+			got, err := verifier.Verify(context.Background(), test.token)
+			if test.wantErr != "" {
+				require.EqualError(t, err, test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.want.AppID, got.AppID)
+			assert.Equal(t, test.want.TokenName, got.TokenName)
+			assert.Equal(t, test.want.Headers, got.Headers)
+		})
+	}
+}
+
+func TestVerifier_Verify_trustedIssuers(t *testing.T) {
+	t.Parallel()
+
+	pinnedKey, pinnedPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	fallbackKey, fallbackPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	var pinnedCalls, fallbackCalls, failures atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/pinned", func(rw http.ResponseWriter, _ *http.Request) {
+		// The first call fails to exercise the retries.
+		if failures.Add(1) == 1 {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		pinnedCalls.Add(1)
+		_, _ = rw.Write([]byte(jwks(t, map[string]any{"kty": "OKP", "crv": "Ed25519", "x": b64(pinnedKey)})))
+	})
+	mux.HandleFunc("/fallback", func(rw http.ResponseWriter, _ *http.Request) {
+		fallbackCalls.Add(1)
+		_, _ = rw.Write([]byte(jwks(t, map[string]any{"kty": "OKP", "crv": "Ed25519", "x": b64(fallbackKey)})))
+	})
+
+	srv := httptest.NewTLSServer(mux)
+	t.Cleanup(srv.Close)
+
+	verifier, err := jwtauth.NewVerifier(hubv1alpha1.JWTAuthSpec{
+		AppIDClaim: "client_id",
+		TrustedIssuers: []hubv1alpha1.TrustedIssuer{
+			{JWKSURL: srv.URL + "/fallback"},
+			{JWKSURL: srv.URL + "/pinned", Issuer: "https://idp.example.com"},
+		},
+		ClientConfig: &hubv1alpha1.HTTPClientConfig{MaxRetries: 1},
+	}, jwtauth.Options{HTTPClient: srv.Client(), Now: func() time.Time { return now }})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	for range 2 {
+		got, err := verifier.Verify(ctx, signEd25519(t, pinnedPriv, map[string]any{"iss": "https://idp.example.com", "client_id": "pinned"}))
+		require.NoError(t, err)
+		assert.Equal(t, "pinned", got.AppID)
+	}
+
+	got, err := verifier.Verify(ctx, signEd25519(t, fallbackPriv, map[string]any{"iss": "https://other.example.com", "client_id": "fallback"}))
+	require.NoError(t, err)
+	assert.Equal(t, "fallback", got.AppID)
+
+	// The pinned issuer's tokens must be signed by its own keys.
+	_, err = verifier.Verify(ctx, signEd25519(t, fallbackPriv, map[string]any{"iss": "https://idp.example.com", "client_id": "pinned"}))
+	require.EqualError(t, err, "invalid signature")
+
+	assert.Equal(t, int32(1), pinnedCalls.Load())
+	assert.Equal(t, int32(1), fallbackCalls.Load())
+}
+
+func TestVerifier_Verify_keyRotation(t *testing.T) {
+	t.Parallel()
+
+	oldKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	newKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	var (
+		calls   atomic.Int32
+		rotated atomic.Bool
+	)
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+
+		keys := []map[string]any{ecJWK(oldKey, "old")}
+		if rotated.Load() {
+			keys = append(keys, ecJWK(newKey, "new"))
+		}
+
+		_, _ = rw.Write([]byte(jwks(t, keys...)))
+	}))
+	t.Cleanup(srv.Close)
+
+	clock := now
+	verifier, err := jwtauth.NewVerifier(hubv1alpha1.JWTAuthSpec{
+		AppIDClaim: "client_id",
+		JWKSURL:    srv.URL,
+	}, jwtauth.Options{HTTPClient: srv.Client(), Now: func() time.Time { return clock }})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	claims := map[string]any{"client_id": "app"}
+
+	_, err = verifier.Verify(ctx, signECDSA(t, oldKey, "old", claims))
+	require.NoError(t, err)
+
+	rotated.Store(true)
+
+	// The JWKS is not fetched again before the refresh interval, even for unknown kids.
+	_, err = verifier.Verify(ctx, signECDSA(t, newKey, "new", claims))
+	require.EqualError(t, err, `no key found to verify ES256 token with kid "new"`)
+	assert.Equal(t, int32(1), calls.Load())
+
+	clock = clock.Add(time.Minute)
+
+	_, err = verifier.Verify(ctx, signECDSA(t, newKey, "new", claims))
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+
+	// Known kids never trigger a fetch, unknown kids trigger one per refresh interval at most.
+	clock = clock.Add(time.Hour)
+
+	_, err = verifier.Verify(ctx, signECDSA(t, oldKey, "old", claims))
+	require.NoError(t, err)
+
+	_, err = verifier.Verify(ctx, signECDSA(t, oldKey, "unknown", claims))
+	require.EqualError(t, err, `no key found to verify ES256 token with kid "unknown"`)
+
+	_, err = verifier.Verify(ctx, signECDSA(t, oldKey, "unknown", claims))
+	require.EqualError(t, err, `no key found to verify ES256 token with kid "unknown"`)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestVerifier_Verify_concurrentFetches(t *testing.T) {
+	t.Parallel()
+
+	slowKey, slowPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	fastKey, fastPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	var slowCalls atomic.Int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/slow", func(rw http.ResponseWriter, _ *http.Request) {
+		if slowCalls.Add(1) == 1 {
+			close(started)
+		}
+		<-release
+
+		_, _ = rw.Write([]byte(jwks(t, map[string]any{"kty": "OKP", "crv": "Ed25519", "x": b64(slowKey)})))
+	})
+	mux.HandleFunc("/fast", func(rw http.ResponseWriter, _ *http.Request) {
+		_, _ = rw.Write([]byte(jwks(t, map[string]any{"kty": "OKP", "crv": "Ed25519", "x": b64(fastKey)})))
+	})
+
+	srv := httptest.NewTLSServer(mux)
+	t.Cleanup(srv.Close)
+
+	verifier, err := jwtauth.NewVerifier(hubv1alpha1.JWTAuthSpec{
+		AppIDClaim: "client_id",
+		TrustedIssuers: []hubv1alpha1.TrustedIssuer{
+			{JWKSURL: srv.URL + "/slow", Issuer: "https://slow.example.com"},
+			{JWKSURL: srv.URL + "/fast", Issuer: "https://fast.example.com"},
+		},
+	}, jwtauth.Options{HTTPClient: srv.Client(), Now: func() time.Time { return now }})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	slowToken := signEd25519(t, slowPriv, map[string]any{"iss": "https://slow.example.com", "client_id": "slow"})
+
+	errs := make(chan error, 3)
+	for range cap(errs) {
+		go func() {
+			_, err := verifier.Verify(ctx, slowToken)
+			errs <- err
+		}()
+	}
+
+	<-started
+
+	// The fetch of a JWKS doesn't block the verifications of the other sources.
+	_, err = verifier.Verify(ctx, signEd25519(t, fastPriv, map[string]any{"iss": "https://fast.example.com", "client_id": "fast"}))
+	require.NoError(t, err)
+
+	close(release)
+
+	for range cap(errs) {
+		require.NoError(t, <-errs)
+	}
+	assert.Equal(t, int32(1), slowCalls.Load())
+}
+
+func TestNewVerifier(t *testing.T) {
+	t.Parallel()
+
+	_, err := jwtauth.NewVerifier(hubv1alpha1.JWTAuthSpec{AppIDClaim: "sub"}, jwtauth.Options{})
+	require.Error(t, err)
+
+	_, err = jwtauth.NewVerifier(hubv1alpha1.JWTAuthSpec{AppIDClaim: "sub", SigningSecretName: "secret"}, jwtauth.Options{})
+	require.EqualError(t, err, `signing secret "secret" is not provided`)
+
+	_, err = jwtauth.NewVerifier(hubv1alpha1.JWTAuthSpec{AppIDClaim: "sub", PublicKey: "not a key"}, jwtauth.Options{})
+	require.EqualError(t, err, "parsing public key: no PEM block found")
+}
+
+func TestTokenFromRequest(t *testing.T) {
+	t.Parallel()
+
+	spec := hubv1alpha1.JWTAuthSpec{TokenQueryKey: "token"}
+
+	req := httptest.NewRequest(http.MethodGet, "/?token=from-query", http.NoBody)
+	token, ok := jwtauth.TokenFromRequest(spec, req)
+	assert.True(t, ok)
+	assert.Equal(t, "from-query", token)
+
+	req.Header.Set("Authorization", "Bearer from-header")
+	token, ok = jwtauth.TokenFromRequest(spec, req)
+	assert.True(t, ok)
+	assert.Equal(t, "from-header", token)
+
+	_, ok = jwtauth.TokenFromRequest(hubv1alpha1.JWTAuthSpec{}, httptest.NewRequest(http.MethodGet, "/?token=x", http.NoBody))
+	assert.False(t, ok)
+}
+
+func signHMAC(t *testing.T, secret []byte, claims map[string]any) string {
+	t.Helper()
+
+	input := encode(t, map[string]any{"alg": "HS256", "typ": "JWT"}) + "." + encode(t, claims)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(input))
+
+	return input + "." + b64(mac.Sum(nil))
+}
+
+func signRSA(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]any) string {
+	t.Helper()
+
+	input := encode(t, map[string]any{"alg": "RS256", "kid": kid}) + "." + encode(t, claims)
+	digest := sha256.Sum256([]byte(input))
+
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	return input + "." + b64(sig)
+}
+
+func signECDSA(t *testing.T, key *ecdsa.PrivateKey, kid string, claims map[string]any) string {
+	t.Helper()
+
+	input := encode(t, map[string]any{"alg": "ES256", "kid": kid}) + "." + encode(t, claims)
+	digest := sha256.Sum256([]byte(input))
+
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+
+	sig := append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+
+	return input + "." + b64(sig)
+}
+
+func signEd25519(t *testing.T, key ed25519.PrivateKey, claims map[string]any) string {
+	t.Helper()
+
+	input := encode(t, map[string]any{"alg": "EdDSA"}) + "." + encode(t, claims)
+
+	return input + "." + b64(ed25519.Sign(key, []byte(input)))
+}
+
+func jwks(t *testing.T, keys ...map[string]any) string {
+	t.Helper()
+
+	data, err := json.Marshal(map[string]any{"keys": keys})
+	require.NoError(t, err)
+
+	return string(data)
+}
+
+func ecJWK(key *ecdsa.PrivateKey, kid string) map[string]any {
+	return map[string]any{
+		"kty": "EC", "kid": kid, "crv": "P-256",
+		"x": b64(key.X.FillBytes(make([]byte, 32))),
+		"y": b64(key.Y.FillBytes(make([]byte, 32))),
+	}
+}
+
+func encode(t *testing.T, value any) string {
+	t.Helper()
+
+	data, err := json.Marshal(value)
+	require.NoError(t, err)
+
+	return b64(data)
+}
+
+func b64(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
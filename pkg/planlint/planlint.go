@@ -0,0 +1,306 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package planlint reports the inconsistencies of APIPlans, such as a RateLimit allowing more requests than the Quota
+// or a limit that can never be reached, and ranks the plans of a namespace by generosity to check that tiers stay
+// monotonic.
+//
+// Limits are compared once normalized to requests per second with Period.Seconds: a RateLimit allows Limit requests
+// per Period, a Quota allows Limit requests per Period on average. Periods left unset default to plansim.DefaultPeriod,
+// and the calendar months of the "calendar-month" quota Window count as 30 days.
+package planlint
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	"github.com/traefik/hub-crds/pkg/plansim"
+)
+
+// month is the period of a quota with the calendar-month window.
+const month = 30 * 24 * time.Hour
+
+// Severity qualifies the impact of a Finding.
+type Severity string
+
+// Supported severities.
+const (
+	// SeverityError indicates that a limit of the plan can never apply, or that tiers are not ordered.
+	SeverityError Severity = "error"
+	// SeverityWarning indicates that the plan is consistent but likely not to behave as intended.
+	SeverityWarning Severity = "warning"
+)
+
+// Rule identifies the check that reported a Finding.
+type Rule string
+
+// Supported rules.
+const (
+	RuleRateLimitExceedsQuota Rule = "RateLimitExceedsQuota"
+	RuleRateLimitBlocksAll    Rule = "RateLimitBlocksAll"
+	RuleQuotaBlocksAll        Rule = "QuotaBlocksAll"
+	RuleQuotaUnreachable      Rule = "QuotaUnreachable"
+	RuleTierNotFound          Rule = "TierNotFound"
+	RuleTierNotMonotonic      Rule = "TierNotMonotonic"
+)
+
+// Finding is an inconsistency of an APIPlan.
+type Finding struct {
+	Severity  Severity `json:"severity"`
+	Rule      Rule     `json:"rule"`
+	Namespace string   `json:"namespace,omitempty"`
+	Name      string   `json:"name"`
+	Path      string   `json:"path,omitempty"`
+	Message   string   `json:"message"`
+}
+
+// String returns a human-readable representation of the Finding.
+func (f Finding) String() string {
+	location := "APIPlan " + f.Name
+	if f.Namespace != "" {
+		location = "APIPlan " + f.Namespace + "/" + f.Name
+	}
+	if f.Path != "" {
+		location += " " + f.Path
+	}
+
+	return fmt.Sprintf("[%s] %s: %s (%s)", f.Severity, location, f.Message, f.Rule)
+}
+
+// Lint reports the inconsistencies between the RateLimit and the Quota of the given plan:
+// a limit of 0 rejecting every request, a RateLimit allowing more requests than the Quota,
+// or a RateLimit allowing fewer requests per quota period than the Quota, which can then never be reached.
+func Lint(plan *hubv1alpha1.APIPlan) []Finding {
+	rateLimit, quota := plan.Spec.RateLimit, plan.Spec.Quota
+
+	newFinding := func(severity Severity, rule Rule, path, message string) Finding {
+		return Finding{
+			Severity:  severity,
+			Rule:      rule,
+			Namespace: plan.Namespace,
+			Name:      plan.Name,
+			Path:      path,
+			Message:   message,
+		}
+	}
+
+	var findings []Finding
+
+	if rateLimit != nil && rateLimit.Limit == 0 {
+		if quota != nil && quota.Limit > 0 {
+			findings = append(findings, newFinding(SeverityError, RuleRateLimitBlocksAll, "spec.rateLimit.limit",
+				fmt.Sprintf("a rate limit of 0 rejects every request, the quota of %d is unreachable", quota.Limit)))
+		} else {
+			findings = append(findings, newFinding(SeverityWarning, RuleRateLimitBlocksAll, "spec.rateLimit.limit",
+				"a rate limit of 0 rejects every request"))
+		}
+	}
+
+	if quota != nil && quota.Limit == 0 {
+		if rateLimit != nil && rateLimit.Limit > 0 {
+			findings = append(findings, newFinding(SeverityError, RuleQuotaBlocksAll, "spec.quota.limit",
+				fmt.Sprintf("a quota of 0 rejects every request, the rate limit of %d is unreachable", rateLimit.Limit)))
+		} else {
+			findings = append(findings, newFinding(SeverityWarning, RuleQuotaBlocksAll, "spec.quota.limit",
+				"a quota of 0 rejects every request"))
+		}
+	}
+
+	if rateLimit != nil && rateLimit.Limit > 0 && quota != nil && quota.Limit > 0 {
+		window := quotaPeriod(quota)
+
+		allowed := float64(rateLimit.Limit) / seconds(rateLimit.Period) * window.Seconds()
+		switch {
+		case allowed > float64(quota.Limit):
+			findings = append(findings, newFinding(SeverityWarning, RuleRateLimitExceedsQuota, "spec.rateLimit",
+				fmt.Sprintf("the rate limit allows %s requests per quota period of %v, more than the quota of %d",
+					formatFloat(allowed), hubv1alpha1.NewPeriod(window), quota.Limit)))
+		case allowed < float64(quota.Limit):
+			findings = append(findings, newFinding(SeverityError, RuleQuotaUnreachable, "spec.quota.limit",
+				fmt.Sprintf("the rate limit allows at most %s requests per quota period of %v, the quota of %d is unreachable",
+					formatFloat(allowed), hubv1alpha1.NewPeriod(window), quota.Limit)))
+		}
+	}
+
+	return findings
+}
+
+// Ranking holds the limits of a plan normalized to requests per second.
+// Limits the plan doesn't define are infinite.
+type Ranking struct {
+	Namespace string
+	Name      string
+
+	// RateLimit is the number of requests per second regenerated by the RateLimit.
+	RateLimit float64
+	// Quota is the average number of requests per second allowed by the Quota.
+	Quota float64
+}
+
+// Effective returns the number of requests per second the plan sustains, the lowest of its limits.
+func (r Ranking) Effective() float64 {
+	return min(r.RateLimit, r.Quota)
+}
+
+// NewRanking normalizes the limits of the given plan.
+func NewRanking(plan *hubv1alpha1.APIPlan) Ranking {
+	ranking := Ranking{
+		Namespace: plan.Namespace,
+		Name:      plan.Name,
+		RateLimit: math.Inf(1),
+		Quota:     math.Inf(1),
+	}
+
+	if rateLimit := plan.Spec.RateLimit; rateLimit != nil {
+		ranking.RateLimit = float64(rateLimit.Limit) / seconds(rateLimit.Period)
+	}
+	if quota := plan.Spec.Quota; quota != nil {
+		ranking.Quota = float64(quota.Limit) / quotaPeriod(quota).Seconds()
+	}
+
+	return ranking
+}
+
+// Rank ranks the given plans from the least to the most generous: by effective rate, then rate limit, quota,
+// namespace and name. Nil plans are skipped.
+func Rank(plans []*hubv1alpha1.APIPlan) []Ranking {
+	rankings := make([]Ranking, 0, len(plans))
+	for _, plan := range plans {
+		if plan == nil {
+			continue
+		}
+
+		rankings = append(rankings, NewRanking(plan))
+	}
+
+	slices.SortFunc(rankings, func(a, b Ranking) int {
+		return cmp.Or(
+			cmp.Compare(a.Effective(), b.Effective()),
+			cmp.Compare(a.RateLimit, b.RateLimit),
+			cmp.Compare(a.Quota, b.Quota),
+			cmp.Compare(a.Namespace, b.Namespace),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+
+	return rankings
+}
+
+// CheckTiers checks that each of the given tiers, plan names ordered from the least to the most generous,
+// has a RateLimit and a Quota at least as generous as the previous tier.
+// Plans are looked up by name, the given plans being expected to belong to the same namespace. Nil plans are skipped.
+func CheckTiers(plans []*hubv1alpha1.APIPlan, tiers []string) []Finding {
+	byName := make(map[string]*hubv1alpha1.APIPlan, len(plans))
+	for _, plan := range plans {
+		if plan == nil {
+			continue
+		}
+
+		byName[plan.Name] = plan
+	}
+
+	var (
+		findings []Finding
+		previous *Ranking
+	)
+
+	for _, tier := range tiers {
+		plan, ok := byName[tier]
+		if !ok {
+			findings = append(findings, Finding{
+				Severity: SeverityError,
+				Rule:     RuleTierNotFound,
+				Name:     tier,
+				Message:  "plan not found",
+			})
+
+			continue
+		}
+
+		ranking := NewRanking(plan)
+
+		if previous != nil {
+			if ranking.RateLimit < previous.RateLimit {
+				findings = append(findings, notMonotonic(ranking, *previous, "spec.rateLimit", "rate limit", ranking.RateLimit, previous.RateLimit))
+			}
+			if ranking.Quota < previous.Quota {
+				findings = append(findings, notMonotonic(ranking, *previous, "spec.quota", "quota", ranking.Quota, previous.Quota))
+			}
+		}
+
+		previous = &ranking
+	}
+
+	return findings
+}
+
+func notMonotonic(ranking, previous Ranking, path, limit string, value, previousValue float64) Finding {
+	return Finding{
+		Severity:  SeverityError,
+		Rule:      RuleTierNotMonotonic,
+		Namespace: ranking.Namespace,
+		Name:      ranking.Name,
+		Path:      path,
+		Message: fmt.Sprintf("%s of %s is less generous than the lower tier %q (%s)",
+			limit, formatRate(value), previous.Name, formatRate(previousValue)),
+	}
+}
+
+// seconds returns the given period in seconds, defaulting to plansim.DefaultPeriod.
+func seconds(p *hubv1alpha1.Period) float64 {
+	if p.IsZero() {
+		return plansim.DefaultPeriod.Seconds()
+	}
+
+	return p.Seconds()
+}
+
+// quotaPeriod returns the period of the given quota, calendar months being normalized to 30 days.
+func quotaPeriod(quota *hubv1alpha1.Quota) time.Duration {
+	if quota.Window == hubv1alpha1.QuotaWindowCalendarMonth {
+		return month
+	}
+
+	return period(quota.Period)
+}
+
+// period returns the given period, defaulting to plansim.DefaultPeriod.
+func period(p *hubv1alpha1.Period) time.Duration {
+	if p.IsZero() {
+		return plansim.DefaultPeriod
+	}
+
+	return time.Duration(*p)
+}
+
+// formatFloat formats the given value with up to 6 decimals.
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(math.Round(value*1e6)/1e6, 'f', -1, 64)
+}
+
+func formatRate(value float64) string {
+	if math.IsInf(value, 1) {
+		return "unlimited"
+	}
+
+	return formatFloat(value) + " requests/s"
+}
@@ -0,0 +1,299 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package planlint_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	"github.com/traefik/hub-crds/pkg/planlint"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc string
+		spec hubv1alpha1.APIPlanSpec
+		want []planlint.Finding
+	}{
+		{
+			desc: "no limits",
+		},
+		{
+			desc: "consistent limits",
+			spec: hubv1alpha1.APIPlanSpec{
+				RateLimit: &hubv1alpha1.RateLimit{Limit: 1, Period: hubv1alpha1.NewPeriod(time.Minute)},
+				Quota:     &hubv1alpha1.Quota{Limit: 60, Period: hubv1alpha1.NewPeriod(time.Hour)},
+			},
+		},
+		{
+			desc: "quota unreachable with the rate limit",
+			spec: hubv1alpha1.APIPlanSpec{
+				RateLimit: &hubv1alpha1.RateLimit{Limit: 1, Period: hubv1alpha1.NewPeriod(time.Minute)},
+				Quota:     &hubv1alpha1.Quota{Limit: 100, Period: hubv1alpha1.NewPeriod(time.Hour)},
+			},
+			want: []planlint.Finding{
+				{
+					Severity:  planlint.SeverityError,
+					Rule:      planlint.RuleQuotaUnreachable,
+					Namespace: "default",
+					Name:      "my-plan",
+					Path:      "spec.quota.limit",
+					Message:   "the rate limit allows at most 60 requests per quota period of 1h, the quota of 100 is unreachable",
+				},
+			},
+		},
+		{
+			desc: "rate limit exceeding the quota",
+			spec: hubv1alpha1.APIPlanSpec{
+				RateLimit: &hubv1alpha1.RateLimit{Limit: 10},
+				Quota:     &hubv1alpha1.Quota{Limit: 1000, Period: hubv1alpha1.NewPeriod(time.Hour)},
+			},
+			want: []planlint.Finding{
+				{
+					Severity:  planlint.SeverityWarning,
+					Rule:      planlint.RuleRateLimitExceedsQuota,
+					Namespace: "default",
+					Name:      "my-plan",
+					Path:      "spec.rateLimit",
+					Message:   "the rate limit allows 36000 requests per quota period of 1h, more than the quota of 1000",
+				},
+			},
+		},
+		{
+			desc: "rate limit exceeding a calendar month quota",
+			spec: hubv1alpha1.APIPlanSpec{
+				RateLimit: &hubv1alpha1.RateLimit{Limit: 1, Period: hubv1alpha1.NewPeriod(time.Hour)},
+				Quota:     &hubv1alpha1.Quota{Limit: 100, Window: hubv1alpha1.QuotaWindowCalendarMonth},
+			},
+			want: []planlint.Finding{
+				{
+					Severity:  planlint.SeverityWarning,
+					Rule:      planlint.RuleRateLimitExceedsQuota,
+					Namespace: "default",
+					Name:      "my-plan",
+					Path:      "spec.rateLimit",
+					Message:   "the rate limit allows 720 requests per quota period of 720h, more than the quota of 100",
+				},
+			},
+		},
+		{
+			desc: "zero rate limit with a quota",
+			spec: hubv1alpha1.APIPlanSpec{
+				RateLimit: &hubv1alpha1.RateLimit{Limit: 0},
+				Quota:     &hubv1alpha1.Quota{Limit: 1000000},
+			},
+			want: []planlint.Finding{
+				{
+					Severity:  planlint.SeverityError,
+					Rule:      planlint.RuleRateLimitBlocksAll,
+					Namespace: "default",
+					Name:      "my-plan",
+					Path:      "spec.rateLimit.limit",
+					Message:   "a rate limit of 0 rejects every request, the quota of 1000000 is unreachable",
+				},
+			},
+		},
+		{
+			desc: "zero quota with a rate limit",
+			spec: hubv1alpha1.APIPlanSpec{
+				RateLimit: &hubv1alpha1.RateLimit{Limit: 10},
+				Quota:     &hubv1alpha1.Quota{Limit: 0},
+			},
+			want: []planlint.Finding{
+				{
+					Severity:  planlint.SeverityError,
+					Rule:      planlint.RuleQuotaBlocksAll,
+					Namespace: "default",
+					Name:      "my-plan",
+					Path:      "spec.quota.limit",
+					Message:   "a quota of 0 rejects every request, the rate limit of 10 is unreachable",
+				},
+			},
+		},
+		{
+			desc: "zero limits",
+			spec: hubv1alpha1.APIPlanSpec{
+				RateLimit: &hubv1alpha1.RateLimit{Limit: 0},
+				Quota:     &hubv1alpha1.Quota{Limit: 0},
+			},
+			want: []planlint.Finding{
+				{
+					Severity:  planlint.SeverityWarning,
+					Rule:      planlint.RuleRateLimitBlocksAll,
+					Namespace: "default",
+					Name:      "my-plan",
+					Path:      "spec.rateLimit.limit",
+					Message:   "a rate limit of 0 rejects every request",
+				},
+				{
+					Severity:  planlint.SeverityWarning,
+					Rule:      planlint.RuleQuotaBlocksAll,
+					Namespace: "default",
+					Name:      "my-plan",
+					Path:      "spec.quota.limit",
+					Message:   "a quota of 0 rejects every request",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			plan := &hubv1alpha1.APIPlan{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-plan", Namespace: "default"},
+				Spec:       test.spec,
+			}
+
+			assert.Equal(t, test.want, planlint.Lint(plan))
+		})
+	}
+}
+
+func TestRank(t *testing.T) {
+	t.Parallel()
+
+	plans := []*hubv1alpha1.APIPlan{
+		newPlan("enterprise", nil, nil),
+		newPlan("pro", &hubv1alpha1.RateLimit{Limit: 100}, &hubv1alpha1.Quota{Limit: 3600, Period: hubv1alpha1.NewPeriod(time.Hour)}),
+		newPlan("free", &hubv1alpha1.RateLimit{Limit: 60, Period: hubv1alpha1.NewPeriod(time.Minute)}, nil),
+		newPlan("trial", &hubv1alpha1.RateLimit{Limit: 10}, &hubv1alpha1.Quota{Limit: 3600, Period: hubv1alpha1.NewPeriod(time.Hour)}),
+		nil,
+	}
+
+	got := planlint.Rank(plans)
+
+	want := []planlint.Ranking{
+		{Namespace: "default", Name: "free", RateLimit: 1, Quota: math.Inf(1)},
+		{Namespace: "default", Name: "trial", RateLimit: 10, Quota: 1},
+		{Namespace: "default", Name: "pro", RateLimit: 100, Quota: 1},
+		{Namespace: "default", Name: "enterprise", RateLimit: math.Inf(1), Quota: math.Inf(1)},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestCheckTiers(t *testing.T) {
+	t.Parallel()
+
+	plans := []*hubv1alpha1.APIPlan{
+		newPlan("free", &hubv1alpha1.RateLimit{Limit: 10}, &hubv1alpha1.Quota{Limit: 1000, Period: hubv1alpha1.NewPeriod(24 * time.Hour)}),
+		newPlan("pro", &hubv1alpha1.RateLimit{Limit: 5}, &hubv1alpha1.Quota{Limit: 100000, Period: hubv1alpha1.NewPeriod(24 * time.Hour)}),
+		newPlan("enterprise", nil, nil),
+		nil,
+	}
+
+	tests := []struct {
+		desc  string
+		tiers []string
+		want  []planlint.Finding
+	}{
+		{
+			desc:  "monotonic tiers",
+			tiers: []string{"free", "enterprise"},
+		},
+		{
+			desc:  "not monotonic tiers",
+			tiers: []string{"free", "pro", "enterprise"},
+			want: []planlint.Finding{
+				{
+					Severity:  planlint.SeverityError,
+					Rule:      planlint.RuleTierNotMonotonic,
+					Namespace: "default",
+					Name:      "pro",
+					Path:      "spec.rateLimit",
+					Message:   `rate limit of 5 requests/s is less generous than the lower tier "free" (10 requests/s)`,
+				},
+			},
+		},
+		{
+			desc:  "unlimited plan in a lower tier",
+			tiers: []string{"enterprise", "free"},
+			want: []planlint.Finding{
+				{
+					Severity:  planlint.SeverityError,
+					Rule:      planlint.RuleTierNotMonotonic,
+					Namespace: "default",
+					Name:      "free",
+					Path:      "spec.rateLimit",
+					Message:   `rate limit of 10 requests/s is less generous than the lower tier "enterprise" (unlimited)`,
+				},
+				{
+					Severity:  planlint.SeverityError,
+					Rule:      planlint.RuleTierNotMonotonic,
+					Namespace: "default",
+					Name:      "free",
+					Path:      "spec.quota",
+					Message:   `quota of 0.011574 requests/s is less generous than the lower tier "enterprise" (unlimited)`,
+				},
+			},
+		},
+		{
+			desc:  "unknown tier",
+			tiers: []string{"free", "gold"},
+			want: []planlint.Finding{
+				{
+					Severity: planlint.SeverityError,
+					Rule:     planlint.RuleTierNotFound,
+					Name:     "gold",
+					Message:  "plan not found",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.want, planlint.CheckTiers(plans, test.tiers))
+		})
+	}
+}
+
+func TestFinding_String(t *testing.T) {
+	t.Parallel()
+
+	finding := planlint.Finding{
+		Severity:  planlint.SeverityError,
+		Rule:      planlint.RuleQuotaBlocksAll,
+		Namespace: "default",
+		Name:      "my-plan",
+		Path:      "spec.quota.limit",
+		Message:   "a quota of 0 rejects every request",
+	}
+
+	assert.Equal(t, "[error] APIPlan default/my-plan spec.quota.limit: a quota of 0 rejects every request (QuotaBlocksAll)", finding.String())
+}
+
+func newPlan(name string, rateLimit *hubv1alpha1.RateLimit, quota *hubv1alpha1.Quota) *hubv1alpha1.APIPlan {
+	return &hubv1alpha1.APIPlan{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: hubv1alpha1.APIPlanSpec{
+			Title:     name,
+			RateLimit: rateLimit,
+			Quota:     quota,
+		},
+	}
+}
@@ -0,0 +1,167 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package plansim
+
+import (
+	"math"
+	"time"
+
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+)
+
+// maxDefaultDelay caps the default maximum delay of a request.
+const maxDefaultDelay = 500 * time.Millisecond
+
+// tokenBucket is a token bucket holding up to limit tokens, regenerated at a rate of limit tokens per period.
+type tokenBucket struct {
+	// rate is the number of tokens regenerated per second.
+	rate     float64
+	limit    float64
+	maxDelay time.Duration
+
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(limit int, period time.Duration, maxDelay time.Duration) *tokenBucket {
+	return &tokenBucket{
+		rate:     float64(limit) / period.Seconds(),
+		limit:    float64(limit),
+		maxDelay: maxDelay,
+		tokens:   float64(limit),
+	}
+}
+
+// defaultMaxDelay returns half the time needed to regenerate a token, capped to 500ms.
+func defaultMaxDelay(limit int, period time.Duration) time.Duration {
+	if limit <= 0 {
+		return 0
+	}
+
+	return min(period/time.Duration(2*limit), maxDefaultDelay)
+}
+
+// reserve takes a token from the bucket at the given time. It returns the time the request has to wait for the token
+// to be regenerated, and false when this delay exceeds the maximum delay, in which case no token is taken.
+func (b *tokenBucket) reserve(now time.Time) (time.Duration, bool) {
+	if b.rate <= 0 {
+		return 0, false
+	}
+
+	if now.After(b.last) {
+		if !b.last.IsZero() {
+			b.tokens = min(b.limit, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		}
+		b.last = now
+	}
+
+	tokens := b.tokens - 1
+	if tokens >= 0 {
+		b.tokens = tokens
+		return 0, true
+	}
+
+	delay := time.Duration(math.Round(-tokens / b.rate * float64(time.Second)))
+	if delay > b.maxDelay {
+		return 0, false
+	}
+
+	b.tokens = tokens
+
+	return delay, true
+}
+
+// window counts the accepted requests of a Quota.
+type window interface {
+	// allow returns whether a request can be accepted at the given time, without recording it.
+	allow(now time.Time) bool
+	// record records a request accepted at the given time.
+	record(now time.Time)
+}
+
+// slidingWindow counts the requests of the last period, and accepts up to limit of them.
+type slidingWindow struct {
+	limit  int
+	period time.Duration
+
+	// times are the times of the accepted requests of the current window, in chronological order.
+	times []time.Time
+}
+
+func newSlidingWindow(limit int, period time.Duration) *slidingWindow {
+	return &slidingWindow{
+		limit:  limit,
+		period: period,
+	}
+}
+
+func (w *slidingWindow) allow(now time.Time) bool {
+	start := now.Add(-w.period)
+
+	var expired int
+	for expired < len(w.times) && !w.times[expired].After(start) {
+		expired++
+	}
+	w.times = w.times[expired:]
+
+	return len(w.times) < w.limit
+}
+
+func (w *slidingWindow) record(now time.Time) {
+	w.times = append(w.times, now)
+}
+
+// calendarMonthWindow counts the requests of the current calendar month in UTC, and accepts up to limit of them.
+type calendarMonthWindow struct {
+	limit int
+
+	month time.Time
+	count int
+}
+
+func newCalendarMonthWindow(limit int) *calendarMonthWindow {
+	return &calendarMonthWindow{limit: limit}
+}
+
+func (w *calendarMonthWindow) allow(now time.Time) bool {
+	now = now.UTC()
+
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if !month.Equal(w.month) {
+		w.month = month
+		w.count = 0
+	}
+
+	return w.count < w.limit
+}
+
+func (w *calendarMonthWindow) record(_ time.Time) {
+	w.count++
+}
+
+// bucketKey returns the key of the bucket a request is counted in.
+func bucketKey(bucket hubv1alpha1.Bucket, req Request) string {
+	switch bucket {
+	case hubv1alpha1.BucketApplicationAPI:
+		return req.Application + "/" + req.API
+	case hubv1alpha1.BucketApplication:
+		return req.Application
+	default:
+		return req.Subscription
+	}
+}
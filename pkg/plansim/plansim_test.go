@@ -0,0 +1,230 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package plansim_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	"github.com/traefik/hub-crds/pkg/plansim"
+)
+
+func TestSimulator_Replay(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(d time.Duration, req plansim.Request) plansim.Record {
+		return plansim.Record{Time: start.Add(d), Request: req}
+	}
+
+	sub := plansim.Request{Subscription: "sub", Application: "app", API: "api"}
+	otherAPI := plansim.Request{Subscription: "sub", Application: "app", API: "other-api"}
+	otherApp := plansim.Request{Subscription: "sub", Application: "other-app", API: "api"}
+
+	allowed := plansim.Result{Outcome: plansim.OutcomeAllowed}
+	rateLimited := plansim.Result{Outcome: plansim.OutcomeRateLimited}
+	quotaExceeded := plansim.Result{Outcome: plansim.OutcomeQuotaExceeded}
+
+	tests := []struct {
+		desc    string
+		spec    hubv1alpha1.APIPlanSpec
+		opts    plansim.Options
+		records []plansim.Record
+		want    []plansim.Result
+	}{
+		{
+			desc:    "no limits",
+			records: []plansim.Record{at(0, sub), at(0, sub), at(0, sub)},
+			want:    []plansim.Result{allowed, allowed, allowed},
+		},
+		{
+			desc: "rate limit delays then drops",
+			spec: hubv1alpha1.APIPlanSpec{
+				RateLimit: &hubv1alpha1.RateLimit{Limit: 2, Period: hubv1alpha1.NewPeriod(time.Second)},
+			},
+			records: []plansim.Record{
+				at(0, sub),
+				at(0, sub),
+				// The next token is regenerated in 500ms, more than the 250ms default max delay.
+				at(0, sub),
+				// 0.6 token has been regenerated, the remaining 0.4 token takes 200ms.
+				at(300*time.Millisecond, sub),
+				at(2*time.Second, sub),
+			},
+			want: []plansim.Result{
+				allowed,
+				allowed,
+				rateLimited,
+				{Outcome: plansim.OutcomeDelayed, Delay: 200 * time.Millisecond},
+				allowed,
+			},
+		},
+		{
+			desc: "rate limit with custom max delay",
+			spec: hubv1alpha1.APIPlanSpec{
+				RateLimit: &hubv1alpha1.RateLimit{Limit: 1, Period: hubv1alpha1.NewPeriod(time.Second)},
+			},
+			opts:    plansim.Options{MaxDelay: 2 * time.Second},
+			records: []plansim.Record{at(0, sub), at(0, sub), at(0, sub), at(0, sub)},
+			want: []plansim.Result{
+				allowed,
+				{Outcome: plansim.OutcomeDelayed, Delay: time.Second},
+				{Outcome: plansim.OutcomeDelayed, Delay: 2 * time.Second},
+				rateLimited,
+			},
+		},
+		{
+			desc: "rate limit defaults to a one second period",
+			spec: hubv1alpha1.APIPlanSpec{
+				RateLimit: &hubv1alpha1.RateLimit{Limit: 1},
+			},
+			records: []plansim.Record{at(0, sub), at(0, sub), at(time.Second, sub)},
+			want:    []plansim.Result{allowed, rateLimited, allowed},
+		},
+		{
+			desc: "zero rate limit",
+			spec: hubv1alpha1.APIPlanSpec{
+				RateLimit: &hubv1alpha1.RateLimit{Limit: 0},
+			},
+			records: []plansim.Record{at(0, sub), at(time.Hour, sub)},
+			want:    []plansim.Result{rateLimited, rateLimited},
+		},
+		{
+			desc: "quota sliding window",
+			spec: hubv1alpha1.APIPlanSpec{
+				Quota: &hubv1alpha1.Quota{Limit: 2, Period: hubv1alpha1.NewPeriod(10 * time.Second)},
+			},
+			records: []plansim.Record{
+				at(0, sub),
+				at(5*time.Second, sub),
+				at(9*time.Second, sub),
+				// The first request leaves the window.
+				at(10*time.Second, sub),
+				at(14*time.Second, sub),
+				// The second request leaves the window.
+				at(15*time.Second, sub),
+			},
+			want: []plansim.Result{allowed, allowed, quotaExceeded, allowed, quotaExceeded, allowed},
+		},
+		{
+			desc: "calendar month quota",
+			spec: hubv1alpha1.APIPlanSpec{
+				Quota: &hubv1alpha1.Quota{Limit: 2, Window: hubv1alpha1.QuotaWindowCalendarMonth},
+			},
+			records: []plansim.Record{
+				at(0, sub),
+				at(24*time.Hour, sub),
+				at(30*24*time.Hour, sub),
+				// February starts.
+				at(31*24*time.Hour, sub),
+				at(31*24*time.Hour, sub),
+				at(31*24*time.Hour, sub),
+			},
+			want: []plansim.Result{allowed, allowed, quotaExceeded, allowed, allowed, quotaExceeded},
+		},
+		{
+			desc: "rate limited requests are not counted in the quota",
+			spec: hubv1alpha1.APIPlanSpec{
+				RateLimit: &hubv1alpha1.RateLimit{Limit: 1, Period: hubv1alpha1.NewPeriod(time.Second)},
+				Quota:     &hubv1alpha1.Quota{Limit: 2, Period: hubv1alpha1.NewPeriod(time.Minute)},
+			},
+			records: []plansim.Record{at(0, sub), at(0, sub), at(time.Second, sub), at(2*time.Second, sub)},
+			want:    []plansim.Result{allowed, rateLimited, allowed, quotaExceeded},
+		},
+		{
+			desc: "subscription bucket",
+			spec: hubv1alpha1.APIPlanSpec{
+				Quota: &hubv1alpha1.Quota{Limit: 1, Bucket: hubv1alpha1.BucketSubscription},
+			},
+			records: []plansim.Record{at(0, sub), at(0, otherAPI), at(0, otherApp)},
+			want:    []plansim.Result{allowed, quotaExceeded, quotaExceeded},
+		},
+		{
+			desc: "application bucket",
+			spec: hubv1alpha1.APIPlanSpec{
+				Quota: &hubv1alpha1.Quota{Limit: 1, Bucket: hubv1alpha1.BucketApplication},
+			},
+			records: []plansim.Record{at(0, sub), at(0, otherAPI), at(0, otherApp)},
+			want:    []plansim.Result{allowed, quotaExceeded, allowed},
+		},
+		{
+			desc: "application-api bucket",
+			spec: hubv1alpha1.APIPlanSpec{
+				RateLimit: &hubv1alpha1.RateLimit{Limit: 1, Bucket: hubv1alpha1.BucketApplicationAPI},
+			},
+			records: []plansim.Record{at(0, sub), at(0, otherAPI), at(0, otherApp), at(0, sub)},
+			want:    []plansim.Result{allowed, allowed, allowed, rateLimited},
+		},
+		{
+			desc: "records are replayed in chronological order",
+			spec: hubv1alpha1.APIPlanSpec{
+				Quota: &hubv1alpha1.Quota{Limit: 1, Period: hubv1alpha1.NewPeriod(time.Minute)},
+			},
+			records: []plansim.Record{at(time.Second, sub), at(0, sub)},
+			want:    []plansim.Result{quotaExceeded, allowed},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			simulator := plansim.NewSimulator(test.spec, test.opts)
+
+			assert.Equal(t, test.want, simulator.Replay(test.records))
+		})
+	}
+}
+
+func TestSimulator_Do(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	spec := hubv1alpha1.APIPlanSpec{
+		RateLimit: &hubv1alpha1.RateLimit{Limit: 1, Period: hubv1alpha1.NewPeriod(time.Second)},
+	}
+
+	simulator := plansim.NewSimulator(spec, plansim.Options{Now: func() time.Time { return now }})
+	req := plansim.Request{Subscription: "sub"}
+
+	assert.Equal(t, plansim.Result{Outcome: plansim.OutcomeAllowed}, simulator.Do(req))
+	assert.Equal(t, plansim.Result{Outcome: plansim.OutcomeRateLimited}, simulator.Do(req))
+
+	now = now.Add(800 * time.Millisecond)
+	assert.Equal(t, plansim.Result{Outcome: plansim.OutcomeDelayed, Delay: 200 * time.Millisecond}, simulator.Do(req))
+}
+
+func TestSummary(t *testing.T) {
+	t.Parallel()
+
+	got := plansim.Summary([]plansim.Result{
+		{Outcome: plansim.OutcomeAllowed},
+		{Outcome: plansim.OutcomeDelayed, Delay: time.Second},
+		{Outcome: plansim.OutcomeAllowed},
+		{Outcome: plansim.OutcomeRateLimited},
+	})
+
+	want := map[plansim.Outcome]int{
+		plansim.OutcomeAllowed:     2,
+		plansim.OutcomeDelayed:     1,
+		plansim.OutcomeRateLimited: 1,
+	}
+	assert.Equal(t, want, got)
+}
@@ -0,0 +1,205 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package plansim simulates the RateLimit and Quota of an APIPlan, so that recorded traffic can be replayed to see
+// which requests would be delayed or rejected under a plan before publishing it.
+//
+// The RateLimit is a token bucket holding up to Limit tokens, regenerated at a rate of Limit ÷ Period. A request takes
+// a token, and is delayed until a token is regenerated when the bucket is empty. It is dropped when the delay exceeds
+// the maximum delay. The Quota accepts up to Limit requests in any sliding window of Period, or, with the
+// "calendar-month" Window, in each calendar month in UTC. Requests rejected by the RateLimit are not counted in the Quota.
+package plansim
+
+import (
+	"slices"
+	"time"
+
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+)
+
+// DefaultPeriod is the Period of a RateLimit or a Quota without Period.
+const DefaultPeriod = time.Second
+
+// Outcome is the outcome of a simulated request.
+type Outcome string
+
+// Supported outcomes.
+const (
+	// OutcomeAllowed is the outcome of a request forwarded right away.
+	OutcomeAllowed Outcome = "Allowed"
+	// OutcomeDelayed is the outcome of a request forwarded after waiting for the RateLimit.
+	OutcomeDelayed Outcome = "Delayed"
+	// OutcomeRateLimited is the outcome of a request dropped by the RateLimit.
+	OutcomeRateLimited Outcome = "RateLimited"
+	// OutcomeQuotaExceeded is the outcome of a request rejected by the Quota.
+	OutcomeQuotaExceeded Outcome = "QuotaExceeded"
+)
+
+// Request is a request made under a plan.
+type Request struct {
+	// Subscription identifies the subscription of the request, used by the "subscription" Bucket.
+	Subscription string
+	// Application is the ID of the application making the request, used by the "application" and "application-api" Buckets.
+	Application string
+	// API identifies the called API, used by the "application-api" Bucket.
+	API string
+}
+
+// Record is a recorded request.
+type Record struct {
+	Time time.Time
+	Request
+}
+
+// Result is the result of a simulated request.
+type Result struct {
+	Outcome Outcome
+	// Delay is the time a delayed request waits for the RateLimit.
+	Delay time.Duration
+}
+
+// Options configures a Simulator.
+type Options struct {
+	// Now returns the current time, used as the time of the requests given to Do. Defaults to time.Now.
+	Now func() time.Time
+	// MaxDelay is the maximum time a request can be delayed by the RateLimit before being dropped.
+	// Defaults to half the time needed to regenerate a token, capped to 500ms.
+	MaxDelay time.Duration
+}
+
+// Simulator simulates the RateLimit and Quota of an APIPlan.
+// A Simulator is not safe for concurrent use.
+type Simulator struct {
+	rateLimit *hubv1alpha1.RateLimit
+	quota     *hubv1alpha1.Quota
+	now       func() time.Time
+	maxDelay  time.Duration
+
+	buckets map[string]*tokenBucket
+	windows map[string]window
+}
+
+// NewSimulator creates a Simulator for the given plan.
+func NewSimulator(spec hubv1alpha1.APIPlanSpec, opts Options) *Simulator {
+	s := &Simulator{
+		rateLimit: spec.RateLimit,
+		quota:     spec.Quota,
+		now:       opts.Now,
+		maxDelay:  opts.MaxDelay,
+		buckets:   make(map[string]*tokenBucket),
+		windows:   make(map[string]window),
+	}
+	if s.now == nil {
+		s.now = time.Now
+	}
+	if s.rateLimit != nil && s.maxDelay == 0 {
+		s.maxDelay = defaultMaxDelay(s.rateLimit.Limit, period(s.rateLimit.Period))
+	}
+
+	return s
+}
+
+// Do simulates a request made at the current time.
+func (s *Simulator) Do(req Request) Result {
+	return s.do(s.now(), req)
+}
+
+// Replay simulates the given records in chronological order, and returns their results in the order of the records.
+func (s *Simulator) Replay(records []Record) []Result {
+	order := make([]int, len(records))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return records[a].Time.Compare(records[b].Time)
+	})
+
+	results := make([]Result, len(records))
+	for _, i := range order {
+		results[i] = s.do(records[i].Time, records[i].Request)
+	}
+
+	return results
+}
+
+func (s *Simulator) do(now time.Time, req Request) Result {
+	var quotaWindow window
+	if s.quota != nil {
+		key := bucketKey(s.quota.Bucket, req)
+
+		quotaWindow = s.windows[key]
+		if quotaWindow == nil {
+			quotaWindow = s.newWindow()
+			s.windows[key] = quotaWindow
+		}
+
+		if !quotaWindow.allow(now) {
+			return Result{Outcome: OutcomeQuotaExceeded}
+		}
+	}
+
+	result := Result{Outcome: OutcomeAllowed}
+	if s.rateLimit != nil {
+		key := bucketKey(s.rateLimit.Bucket, req)
+
+		bucket := s.buckets[key]
+		if bucket == nil {
+			bucket = newTokenBucket(s.rateLimit.Limit, period(s.rateLimit.Period), s.maxDelay)
+			s.buckets[key] = bucket
+		}
+
+		delay, ok := bucket.reserve(now)
+		if !ok {
+			return Result{Outcome: OutcomeRateLimited}
+		}
+		if delay > 0 {
+			result = Result{Outcome: OutcomeDelayed, Delay: delay}
+		}
+	}
+
+	if quotaWindow != nil {
+		quotaWindow.record(now)
+	}
+
+	return result
+}
+
+func (s *Simulator) newWindow() window {
+	if s.quota.Window == hubv1alpha1.QuotaWindowCalendarMonth {
+		return newCalendarMonthWindow(s.quota.Limit)
+	}
+
+	return newSlidingWindow(s.quota.Limit, period(s.quota.Period))
+}
+
+// Summary counts the results of a simulation by outcome.
+func Summary(results []Result) map[Outcome]int {
+	summary := make(map[Outcome]int)
+	for _, result := range results {
+		summary[result.Outcome]++
+	}
+
+	return summary
+}
+
+func period(p *hubv1alpha1.Period) time.Duration {
+	if p.IsZero() {
+		return DefaultPeriod
+	}
+
+	return time.Duration(*p)
+}
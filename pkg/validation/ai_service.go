@@ -0,0 +1,174 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/traefik/hub-crds/pkg/aiprovider"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// RegisterProvider registers an LLM provider, against which the AIServices are validated along with the built-in ones.
+func (v *Validator) RegisterProvider(provider aiprovider.Provider) error {
+	return v.providers.Register(provider)
+}
+
+// validateAIProviders validates the provider configurations of an AIService, and of its routing backends,
+// against the provider registry: OpenAI-compatible provider names, base URLs and credentials.
+func (v *Validator) validateAIProviders(obj *unstructured.Unstructured) field.ErrorList {
+	configs, errs := providerConfigs(v.providers, obj)
+
+	for _, config := range configs {
+		if !config.provider.SecretRequired || config.provider.SecretField == "" {
+			continue
+		}
+
+		if _, ok := config.content[config.provider.SecretField]; !ok {
+			errs = append(errs, field.Required(config.path.Child(config.provider.SecretField), fmt.Sprintf("required by the %s provider", config.provider.Name)))
+		}
+	}
+
+	return errs
+}
+
+// Warnings returns the issues of the given object which don't prevent it from being applied,
+// such as AIService params which are not known to be supported by their provider.
+// Unknown objects are skipped without returning any warning.
+func (v *Validator) Warnings(obj *unstructured.Unstructured) []string {
+	if !v.Known(obj) {
+		return nil
+	}
+
+	configs, _ := providerConfigs(v.providers, obj)
+
+	var warnings []string
+	for _, config := range configs {
+		warnings = append(warnings, paramWarnings(config.provider, config.content["params"], config.path.Child("params"))...)
+		if config.backendPath != nil {
+			warnings = append(warnings, paramWarnings(config.provider, config.backend["params"], config.backendPath.Child("params"))...)
+		}
+	}
+
+	return warnings
+}
+
+// providerConfig is the configuration of a provider in an AIService.
+type providerConfig struct {
+	// provider is the provider configured, OpenAI-compatible providers being resolved by name.
+	provider aiprovider.Provider
+	content  map[string]any
+	path     *field.Path
+	// backend is the routing backend embedding the configuration, if any.
+	backend     map[string]any
+	backendPath *field.Path
+}
+
+// providerConfigs returns the provider configurations of an AIService, and of its routing backends, along with the
+// errors found while resolving their provider. The configurations of unknown OpenAI-compatible providers are
+// returned as configurations of the generic OpenAI-compatible provider.
+func providerConfigs(providers *aiprovider.Registry, obj *unstructured.Unstructured) ([]providerConfig, field.ErrorList) {
+	if obj.GetKind() != "AIService" {
+		return nil, nil
+	}
+
+	specPath := field.NewPath("spec")
+
+	spec, _, _ := unstructured.NestedMap(obj.Object, "spec")
+	configs, errs := findProviderConfigs(providers, spec, specPath, false)
+
+	backends, _, _ := unstructured.NestedSlice(obj.Object, "spec", "routing", "backends")
+	for i, backend := range backends {
+		backendConfigs, backendErrs := findProviderConfigs(providers, asMap(backend), specPath.Child("routing", "backends").Index(i), true)
+		configs = append(configs, backendConfigs...)
+		errs = append(errs, backendErrs...)
+	}
+
+	return configs, errs
+}
+
+// findProviderConfigs returns the provider configurations of the given object, the spec of an AIService or
+// one of its routing backends.
+func findProviderConfigs(providers *aiprovider.Registry, content map[string]any, path *field.Path, backend bool) ([]providerConfig, field.ErrorList) {
+	var (
+		configs []providerConfig
+		errs    field.ErrorList
+	)
+
+	for _, provider := range providers.Fields() {
+		config, ok := content[provider.Field].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		providerPath := path.Child(provider.Field)
+
+		if provider.Field == aiprovider.FieldOpenAICompatible {
+			var providerErrs field.ErrorList
+			provider, providerErrs = resolveOpenAICompatible(providers, config, providerPath)
+			errs = append(errs, providerErrs...)
+		}
+
+		providerConfig := providerConfig{provider: provider, content: config, path: providerPath}
+		if backend {
+			providerConfig.backend = content
+			providerConfig.backendPath = path
+		}
+
+		configs = append(configs, providerConfig)
+	}
+
+	return configs, errs
+}
+
+// resolveOpenAICompatible returns the provider selected by the given openaiCompatible configuration,
+// and checks that the base URL is set when the provider doesn't have a default one.
+// The generic OpenAI-compatible provider is returned when the selected provider is unknown.
+func resolveOpenAICompatible(providers *aiprovider.Registry, config map[string]any, path *field.Path) (aiprovider.Provider, field.ErrorList) {
+	generic, _ := providers.Get(aiprovider.FieldOpenAICompatible)
+
+	provider := generic
+	if name, _ := config["provider"].(string); name != "" {
+		var ok bool
+		provider, ok = providers.Get(name)
+		if !ok || provider.Field != aiprovider.FieldOpenAICompatible || provider.IsField() {
+			return generic, field.ErrorList{field.NotSupported(path.Child("provider"), name, providers.OpenAICompatible())}
+		}
+	}
+
+	if baseURL, _ := config["baseUrl"].(string); baseURL == "" && provider.DefaultBaseURL == "" {
+		return provider, field.ErrorList{field.Required(path.Child("baseUrl"), fmt.Sprintf("the %s provider has no default base URL", provider.Name))}
+	}
+
+	return provider, nil
+}
+
+// paramWarnings returns a warning for each of the given params which is not known to be supported by the provider.
+func paramWarnings(provider aiprovider.Provider, params any, path *field.Path) []string {
+	var warnings []string
+	for _, name := range slices.Sorted(maps.Keys(asMap(params))) {
+		if !provider.SupportsParam(name) {
+			warnings = append(warnings, fmt.Sprintf("%s: not known to be supported by the %s provider, it may be ignored", path.Child(name), provider.Name))
+		}
+	}
+
+	return warnings
+}
@@ -0,0 +1,157 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package validation_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/hub-crds/pkg/aiprovider"
+	hubcrd "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1/crd"
+	"github.com/traefik/hub-crds/pkg/crd"
+	"github.com/traefik/hub-crds/pkg/validation"
+)
+
+func TestValidator_Warnings(t *testing.T) {
+	t.Parallel()
+
+	validator := newHubValidator(t)
+
+	tests := []struct {
+		desc   string
+		object string
+		want   []string
+	}{
+		{
+			desc: "supported params",
+			object: `
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: ai
+  namespace: default
+spec:
+  openai:
+    params:
+      temperature: 1
+      presencePenalty: 1`,
+		},
+		{
+			desc: "params not known to be supported",
+			object: `
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: ai
+  namespace: default
+spec:
+  anthropic:
+    params:
+      temperature: 1
+      presencePenalty: 1
+      frequencyPenalty: 1`,
+			want: []string{
+				"spec.anthropic.params.frequencyPenalty: not known to be supported by the anthropic provider, it may be ignored",
+				"spec.anthropic.params.presencePenalty: not known to be supported by the anthropic provider, it may be ignored",
+			},
+		},
+		{
+			desc: "routing backend params not known to be supported",
+			object: `
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: ai
+  namespace: default
+spec:
+  routing:
+    backends:
+      - bedrock:
+          model: claude
+        params:
+          presencePenalty: 1`,
+			want: []string{"spec.routing.backends[0].params.presencePenalty: not known to be supported by the bedrock provider, it may be ignored"},
+		},
+		{
+			desc: "params not known to be supported by a registered OpenAI-compatible provider",
+			object: `
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: ai
+  namespace: default
+spec:
+  openaiCompatible:
+    provider: acme
+    params:
+      temperature: 1
+      topP: 1`,
+			want: []string{"spec.openaiCompatible.params.topP: not known to be supported by the acme provider, it may be ignored"},
+		},
+		{
+			desc: "not an AIService",
+			object: `
+apiVersion: hub.traefik.io/v1alpha1
+kind: APIRateLimit
+metadata:
+  name: limit
+  namespace: default
+spec:
+  limit: 1`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			objects, err := crd.GetObjects(fstest.MapFS{"object.yaml": {Data: []byte(test.object)}})
+			require.NoError(t, err)
+			require.Len(t, objects, 1)
+
+			assert.Empty(t, validator.Validate(objects[0]))
+			assert.Equal(t, test.want, validator.Warnings(objects[0]))
+		})
+	}
+}
+
+// newHubValidator creates a Validator of the hub.traefik.io CRDs, with the acme OpenAI-compatible provider registered.
+func newHubValidator(t *testing.T) *validation.Validator {
+	t.Helper()
+
+	crds, err := crd.GetCRDs(hubcrd.CRDs)
+	require.NoError(t, err)
+
+	validator := validation.NewValidator()
+	for _, definition := range crds {
+		require.NoError(t, validator.Register(definition))
+	}
+
+	require.NoError(t, validator.RegisterProvider(aiprovider.Provider{
+		Name:           "acme",
+		Field:          aiprovider.FieldOpenAICompatible,
+		SecretField:    "token",
+		SecretKeys:     []string{"apiKey"},
+		DefaultBaseURL: "https://llm.acme.example/v1",
+		Params:         []string{aiprovider.ParamTemperature},
+	}))
+
+	return validator
+}
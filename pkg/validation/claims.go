@@ -0,0 +1,55 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package validation
+
+import (
+	"github.com/traefik/hub-crds/pkg/claims"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// claimsField is a field holding a claims expression.
+type claimsField struct {
+	kind string
+	path []string
+}
+
+// claimsFields lists the fields holding a claims expression.
+var claimsFields = []claimsField{
+	{kind: "AccessControlPolicy", path: []string{"spec", "jwt", "claims"}},
+	{kind: "AccessControlPolicy", path: []string{"spec", "oidc", "claims"}},
+	{kind: "AccessControlPolicy", path: []string{"spec", "oAuthIntro", "claims"}},
+	{kind: "ManagedSubscription", path: []string{"spec", "claims"}},
+}
+
+// validateClaims validates the syntax of the claims expressions of the object.
+func (v *Validator) validateClaims(obj *unstructured.Unstructured) field.ErrorList {
+	var errs field.ErrorList
+
+	for _, claimsField := range claimsFields {
+		if claimsField.kind != obj.GetKind() {
+			continue
+		}
+
+		walkStrings(obj.Object, claimsField.path, nil, func(fieldPath *field.Path, expr string) {
+			errs = append(errs, claims.Validate(expr, fieldPath)...)
+		})
+	}
+
+	return errs
+}
@@ -0,0 +1,308 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package validation
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	hubinformers "github.com/traefik/hub-crds/pkg/client/informers/externalversions/hub/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ObjectField is a field of an object.
+type ObjectField struct {
+	Kind      string      `json:"kind"`
+	Namespace string      `json:"namespace"`
+	Name      string      `json:"name"`
+	Field     *field.Path `json:"field"`
+}
+
+// String returns a human-readable reference to the field.
+func (o ObjectField) String() string {
+	return fmt.Sprintf("%s %s/%s %s", o.Kind, o.Namespace, o.Name, o.Field)
+}
+
+// Conflict is a constraint spanning several objects, or several items of an object, that is violated.
+// First is the field holding the value first, in the order of namespace, kind and name, and Second the field
+// conflicting with it.
+type Conflict struct {
+	First   ObjectField `json:"first"`
+	Second  ObjectField `json:"second"`
+	Message string      `json:"message"`
+}
+
+// String returns a human-readable description of the conflict.
+func (c Conflict) String() string {
+	return fmt.Sprintf("%s conflicts with %s: %s", c.Second, c.First, c.Message)
+}
+
+// ValidateCollection checks the constraints that a single object validation cannot enforce:
+//   - only one APIAuth per namespace has isDefault set to true,
+//   - the AppID of ManagedApplications is unique within a namespace,
+//   - an API key value is used once by the ManagedApplications of a namespace,
+//   - the OperationSet names of an API are unique,
+//   - the AIServices referenced by the routing backends of AIServices don't form cycles.
+//
+// Objects of any hub.traefik.io version are supported, objects of other groups are ignored.
+func ValidateCollection(objects []*unstructured.Unstructured) []Conflict {
+	objects = slices.Clone(objects)
+	slices.SortStableFunc(objects, func(a, b *unstructured.Unstructured) int {
+		return cmp.Or(
+			strings.Compare(a.GetNamespace(), b.GetNamespace()),
+			strings.Compare(a.GetKind(), b.GetKind()),
+			strings.Compare(a.GetName(), b.GetName()),
+		)
+	})
+
+	defaults := newUniqueValues("only one APIAuth per namespace can be the default")
+	appIDs := newUniqueValues("the AppID %q is already used")
+	apiKeys := newUniqueValues("the API key value is already used")
+	aiServices := newReferenceGraph("the AIService backends form a cycle: %s")
+
+	var conflicts []Conflict
+	for _, object := range objects {
+		gvk := object.GroupVersionKind()
+		if gvk.Group != hubv1alpha1.SchemeGroupVersion.Group {
+			continue
+		}
+
+		fieldOf := func(path *field.Path) ObjectField {
+			return ObjectField{Kind: gvk.Kind, Namespace: object.GetNamespace(), Name: object.GetName(), Field: path}
+		}
+
+		specPath := field.NewPath("spec")
+
+		switch gvk.Kind {
+		case "APIAuth":
+			isDefault, _, _ := unstructured.NestedBool(object.Object, "spec", "isDefault")
+			if isDefault {
+				conflicts = defaults.add(conflicts, object.GetNamespace(), "", fieldOf(specPath.Child("isDefault")), false)
+			}
+
+		case "ManagedApplication":
+			appID, _, _ := unstructured.NestedString(object.Object, "spec", "appId")
+			if appID != "" {
+				conflicts = appIDs.add(conflicts, object.GetNamespace(), appID, fieldOf(specPath.Child("appId")), true)
+			}
+
+			keys, _, _ := unstructured.NestedSlice(object.Object, "spec", "apiKeys")
+			for i, key := range keys {
+				value, _, _ := unstructured.NestedString(asMap(key), "value")
+				if value != "" {
+					// API key values are secrets and must not be written in the conflict message.
+					conflicts = apiKeys.add(conflicts, object.GetNamespace(), value, fieldOf(specPath.Child("apiKeys").Index(i).Child("value")), false)
+				}
+			}
+
+		case "API":
+			names := newUniqueValues("the OperationSet name %q is already used")
+
+			sets, _, _ := unstructured.NestedSlice(object.Object, "spec", "operationSets")
+			for i, set := range sets {
+				name, _, _ := unstructured.NestedString(asMap(set), "name")
+				if name != "" {
+					conflicts = names.add(conflicts, "", name, fieldOf(specPath.Child("operationSets").Index(i).Child("name")), true)
+				}
+			}
+
+		case "AIService":
+			backends, _, _ := unstructured.NestedSlice(object.Object, "spec", "routing", "backends")
+			for i, backend := range backends {
+				name, _, _ := unstructured.NestedString(asMap(backend), "aiService", "name")
+				if name != "" {
+					aiServices.add(object.GetNamespace(), object.GetName(), name, fieldOf(specPath.Child("routing", "backends").Index(i).Child("aiService", "name")))
+				}
+			}
+		}
+	}
+
+	return append(conflicts, aiServices.cycles()...)
+}
+
+// ListInformerObjects lists the objects subject to the constraints of ValidateCollection from the given informers.
+// The informers must have been started and synced.
+func ListInformerObjects(informers hubinformers.Interface) ([]*unstructured.Unstructured, error) {
+	var items []runtime.Object
+
+	auths, err := informers.APIAuths().Lister().List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("listing APIAuth: %w", err)
+	}
+	for _, auth := range auths {
+		items = append(items, auth)
+	}
+
+	apps, err := informers.ManagedApplications().Lister().List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("listing ManagedApplication: %w", err)
+	}
+	for _, app := range apps {
+		items = append(items, app)
+	}
+
+	apis, err := informers.APIs().Lister().List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("listing API: %w", err)
+	}
+	for _, api := range apis {
+		items = append(items, api)
+	}
+
+	aiServices, err := informers.AIServices().Lister().List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("listing AIService: %w", err)
+	}
+	for _, aiService := range aiServices {
+		items = append(items, aiService)
+	}
+
+	objects := make([]*unstructured.Unstructured, 0, len(items))
+	for _, item := range items {
+		object, err := toUnstructured(item)
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, object)
+	}
+
+	return objects, nil
+}
+
+// uniqueValues tracks the first field holding each value of a scope.
+type uniqueValues struct {
+	message string
+	fields  map[[2]string]ObjectField
+}
+
+func newUniqueValues(message string) *uniqueValues {
+	return &uniqueValues{message: message, fields: make(map[[2]string]ObjectField)}
+}
+
+// add records the value of the field in the scope, and appends a conflict when the value is already held by another
+// field. The value is only written in the message when public is true.
+func (u *uniqueValues) add(conflicts []Conflict, scope, value string, f ObjectField, public bool) []Conflict {
+	key := [2]string{scope, value}
+
+	first, ok := u.fields[key]
+	if !ok {
+		u.fields[key] = f
+		return conflicts
+	}
+
+	message := u.message
+	if public {
+		message = fmt.Sprintf(u.message, value)
+	}
+
+	return append(conflicts, Conflict{First: first, Second: f, Message: message})
+}
+
+// reference is a reference by name between two objects of the same kind and namespace.
+type reference struct {
+	from, to [2]string
+	field    ObjectField
+}
+
+// referenceGraph tracks the references between the objects of a kind, to detect the cycles they form.
+type referenceGraph struct {
+	message string
+	nodes   [][2]string
+	refs    map[[2]string][]reference
+}
+
+func newReferenceGraph(message string) *referenceGraph {
+	return &referenceGraph{message: message, refs: make(map[[2]string][]reference)}
+}
+
+// add records the reference held by the field of the object from to the object to, of the same namespace.
+func (g *referenceGraph) add(namespace, from, to string, f ObjectField) {
+	key := [2]string{namespace, from}
+	if _, ok := g.refs[key]; !ok {
+		g.nodes = append(g.nodes, key)
+	}
+
+	g.refs[key] = append(g.refs[key], reference{from: key, to: [2]string{namespace, to}, field: f})
+}
+
+// cycles returns a conflict for each reference closing a cycle, between the field holding the first reference
+// of the cycle and the one closing it. Objects are walked in the order they were added.
+func (g *referenceGraph) cycles() []Conflict {
+	const (
+		visiting = iota + 1
+		visited
+	)
+
+	var conflicts []Conflict
+	var path []reference
+	states := make(map[[2]string]int)
+
+	var visit func(node [2]string)
+	visit = func(node [2]string) {
+		states[node] = visiting
+
+		for _, ref := range g.refs[node] {
+			switch states[ref.to] {
+			case visiting:
+				start := slices.IndexFunc(path, func(r reference) bool { return r.from == ref.to })
+				if start < 0 {
+					start = len(path)
+				}
+				cycle := append(slices.Clone(path[start:]), ref)
+
+				names := []string{cycle[0].from[1]}
+				for _, r := range cycle {
+					names = append(names, r.to[1])
+				}
+
+				conflicts = append(conflicts, Conflict{
+					First:   cycle[0].field,
+					Second:  ref.field,
+					Message: fmt.Sprintf(g.message, strings.Join(names, " -> ")),
+				})
+
+			case 0:
+				path = append(path, ref)
+				visit(ref.to)
+				path = path[:len(path)-1]
+			}
+		}
+
+		states[node] = visited
+	}
+
+	for _, node := range g.nodes {
+		if states[node] == 0 {
+			visit(node)
+		}
+	}
+
+	return conflicts
+}
+
+func asMap(value any) map[string]any {
+	m, _ := value.(map[string]any)
+	return m
+}
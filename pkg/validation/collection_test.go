@@ -0,0 +1,284 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package validation_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	"github.com/traefik/hub-crds/pkg/client/clientset/versioned/fake"
+	"github.com/traefik/hub-crds/pkg/client/informers/externalversions"
+	"github.com/traefik/hub-crds/pkg/crd"
+	"github.com/traefik/hub-crds/pkg/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateCollection(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc    string
+		objects string
+		want    []string
+	}{
+		{
+			desc: "several default APIAuths",
+			objects: `
+apiVersion: hub.traefik.io/v1alpha1
+kind: APIAuth
+metadata:
+  name: b
+  namespace: default
+spec:
+  isDefault: true
+  apiKey: {}
+---
+apiVersion: hub.traefik.io/v1beta1
+kind: APIAuth
+metadata:
+  name: a
+  namespace: default
+spec:
+  isDefault: true
+  apiKey: {}
+---
+apiVersion: hub.traefik.io/v1alpha1
+kind: APIAuth
+metadata:
+  name: c
+  namespace: default
+spec:
+  isDefault: false
+  apiKey: {}
+---
+apiVersion: hub.traefik.io/v1alpha1
+kind: APIAuth
+metadata:
+  name: d
+  namespace: other
+spec:
+  isDefault: true
+  apiKey: {}`,
+			want: []string{
+				"APIAuth default/b spec.isDefault conflicts with APIAuth default/a spec.isDefault: only one APIAuth per namespace can be the default",
+			},
+		},
+		{
+			desc: "duplicate AppIDs and API keys",
+			objects: `
+apiVersion: hub.traefik.io/v1alpha1
+kind: ManagedApplication
+metadata:
+  name: app-a
+  namespace: default
+spec:
+  appId: my-app
+  owner: me
+  apiKeys:
+    - value: secret-1
+    - value: secret-2
+---
+apiVersion: hub.traefik.io/v1alpha1
+kind: ManagedApplication
+metadata:
+  name: app-b
+  namespace: default
+spec:
+  appId: my-app
+  owner: me
+  apiKeys:
+    - secretName: my-secret
+    - value: secret-2
+    - value: secret-2
+---
+apiVersion: hub.traefik.io/v1alpha1
+kind: ManagedApplication
+metadata:
+  name: app-c
+  namespace: other
+spec:
+  appId: my-app
+  owner: me
+  apiKeys:
+    - value: secret-1`,
+			want: []string{
+				`ManagedApplication default/app-b spec.appId conflicts with ManagedApplication default/app-a spec.appId: the AppID "my-app" is already used`,
+				"ManagedApplication default/app-b spec.apiKeys[1].value conflicts with ManagedApplication default/app-a spec.apiKeys[1].value: the API key value is already used",
+				"ManagedApplication default/app-b spec.apiKeys[2].value conflicts with ManagedApplication default/app-a spec.apiKeys[1].value: the API key value is already used",
+			},
+		},
+		{
+			desc: "duplicate OperationSet names",
+			objects: `
+apiVersion: hub.traefik.io/v1alpha1
+kind: API
+metadata:
+  name: users
+  namespace: default
+spec:
+  operationSets:
+    - name: read
+      matchers:
+        - methods: [GET]
+    - name: write
+      matchers:
+        - methods: [POST]
+    - name: read
+      matchers:
+        - methods: [HEAD]
+---
+apiVersion: hub.traefik.io/v1alpha1
+kind: API
+metadata:
+  name: orders
+  namespace: default
+spec:
+  operationSets:
+    - name: read
+      matchers:
+        - methods: [GET]`,
+			want: []string{
+				`API default/users spec.operationSets[2].name conflicts with API default/users spec.operationSets[0].name: the OperationSet name "read" is already used`,
+			},
+		},
+		{
+			desc: "AIService backend cycles",
+			objects: `
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: a
+  namespace: default
+spec:
+  routing:
+    backends:
+      - aiService:
+          name: b
+      - openai:
+          model: gpt-4o
+---
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: c
+  namespace: default
+spec:
+  routing:
+    backends:
+      - aiService:
+          name: a
+      - aiService:
+          name: d
+---
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: b
+  namespace: default
+spec:
+  routing:
+    strategy: weighted
+    backends:
+      - aiService:
+          name: c
+        weight: 3
+      - aiService:
+          name: d
+---
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: d
+  namespace: default
+spec:
+  openai:
+    model: gpt-4o
+---
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: self
+  namespace: other
+spec:
+  routing:
+    backends:
+      - aiService:
+          name: self`,
+			want: []string{
+				"AIService default/c spec.routing.backends[0].aiService.name conflicts with AIService default/a spec.routing.backends[0].aiService.name: the AIService backends form a cycle: a -> b -> c -> a",
+				"AIService other/self spec.routing.backends[0].aiService.name conflicts with AIService other/self spec.routing.backends[0].aiService.name: the AIService backends form a cycle: self -> self",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			objects, err := crd.GetObjects(fstest.MapFS{"objects.yaml": {Data: []byte(test.objects)}})
+			require.NoError(t, err)
+
+			var got []string
+			for _, conflict := range validation.ValidateCollection(objects) {
+				got = append(got, conflict.String())
+			}
+
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestListInformerObjects(t *testing.T) {
+	t.Parallel()
+
+	client := fake.NewSimpleClientset(
+		&hubv1alpha1.ManagedApplication{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "app-a"},
+			Spec:       hubv1alpha1.ManagedApplicationSpec{AppID: "my-app", Owner: "me"},
+		},
+		&hubv1alpha1.ManagedApplication{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "app-b"},
+			Spec:       hubv1alpha1.ManagedApplicationSpec{AppID: "my-app", Owner: "me"},
+		},
+	)
+
+	factory := externalversions.NewSharedInformerFactory(client, 0)
+	informers := factory.Hub().V1alpha1()
+
+	// Request the informers before starting the factory.
+	_, err := validation.ListInformerObjects(informers)
+	require.NoError(t, err)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	factory.Start(stopCh)
+	factory.WaitForCacheSync(stopCh)
+
+	objects, err := validation.ListInformerObjects(informers)
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+
+	conflicts := validation.ValidateCollection(objects)
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, "app-a", conflicts[0].First.Name)
+	assert.Equal(t, "app-b", conflicts[0].Second.Name)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"time"
+
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// periodField is a Period field whose value is restricted to a range.
+type periodField struct {
+	kind string
+	path []string
+	min  time.Duration
+	// max is the maximum value of the field, no maximum applying when zero.
+	max time.Duration
+}
+
+// periodFields lists the Period fields restricted to a range.
+// The CRD schemas check these ranges with CEL rules summing the units of the periods, since CEL durations don't
+// support the "d" and "w" units. They are checked again with ParsePeriod, as a second layer matching the parsing of
+// the consumers of the periods.
+var periodFields = []periodField{
+	{kind: "AIService", path: []string{"spec", "routing", "failover", "timeout"}, min: time.Second, max: time.Hour},
+	{kind: "AIService", path: []string{"spec", "tokenBudgets", "[]", "period"}, min: time.Second, max: 9999 * time.Hour},
+	{kind: "AIService", path: []string{"spec", "contentGuard", "prompt", "timeout"}, min: time.Second, max: time.Hour},
+	{kind: "AIService", path: []string{"spec", "contentGuard", "response", "timeout"}, min: time.Second, max: time.Hour},
+	{kind: "API", path: []string{"spec", "openApiSpec", "refreshInterval"}, min: time.Minute},
+	{kind: "APIPlan", path: []string{"spec", "rateLimit", "period"}, min: time.Second, max: time.Hour},
+	{kind: "APIPlan", path: []string{"spec", "quota", "period"}, min: time.Second, max: 9999 * time.Hour},
+	{kind: "APIPlan", path: []string{"spec", "tokenQuota", "period"}, min: time.Second, max: 9999 * time.Hour},
+	{kind: "APIRateLimit", path: []string{"spec", "period"}, min: time.Second, max: time.Hour},
+	{kind: "APIVersion", path: []string{"spec", "openApiSpec", "refreshInterval"}, min: time.Minute},
+}
+
+// validatePeriods checks that the Period fields of the object can be parsed and are within their range.
+// The fields for which an error is already reported, by the CRD rules for instance, are skipped.
+func (v *Validator) validatePeriods(obj *unstructured.Unstructured, reported field.ErrorList) field.ErrorList {
+	reportedFields := make(map[string]struct{}, len(reported))
+	for _, err := range reported {
+		reportedFields[err.Field] = struct{}{}
+	}
+
+	var errs field.ErrorList
+
+	for _, periodField := range periodFields {
+		if periodField.kind != obj.GetKind() {
+			continue
+		}
+
+		walkStrings(obj.Object, periodField.path, nil, func(fieldPath *field.Path, value string) {
+			if _, ok := reportedFields[fieldPath.String()]; ok {
+				return
+			}
+
+			period, err := hubv1alpha1.ParsePeriod(value)
+			if err != nil {
+				errs = append(errs, field.Invalid(fieldPath, value, err.Error()))
+				return
+			}
+
+			if !periodField.contains(time.Duration(period)) {
+				errs = append(errs, field.Invalid(fieldPath, value, periodField.detail()))
+			}
+		})
+	}
+
+	return errs
+}
+
+func (f periodField) contains(period time.Duration) bool {
+	return period >= f.min && (f.max == 0 || period <= f.max)
+}
+
+func (f periodField) detail() string {
+	if f.max == 0 {
+		return "must be at least " + hubv1alpha1.NewPeriod(f.min).String()
+	}
+
+	return fmt.Sprintf("must be between %s and %s", hubv1alpha1.NewPeriod(f.min), hubv1alpha1.NewPeriod(f.max))
+}
@@ -0,0 +1,207 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	"github.com/traefik/hub-crds/pkg/client/clientset/versioned"
+	"github.com/traefik/hub-crds/pkg/client/clientset/versioned/scheme"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeschema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Preflight re-validates existing objects against a candidate set of CRDs.
+// It detects the objects that would no longer validate once the candidate CRDs are applied.
+type Preflight struct {
+	validator *Validator
+}
+
+// NewPreflight creates a new Preflight for the given candidate CRDs.
+func NewPreflight(crds []*apiextensions.CustomResourceDefinition) (*Preflight, error) {
+	validator := NewValidator()
+
+	for _, crd := range crds {
+		if err := validator.Register(crd); err != nil {
+			return nil, fmt.Errorf("registering CRD %q: %w", crd.Name, err)
+		}
+	}
+
+	return &Preflight{validator: validator}, nil
+}
+
+// PreflightResult holds the validation errors of an object against the candidate CRDs.
+type PreflightResult struct {
+	GroupVersionKind runtimeschema.GroupVersionKind
+	Namespace        string
+	Name             string
+
+	// SchemaErrs holds the metadata and OpenAPI schema validation errors.
+	SchemaErrs field.ErrorList
+	// CELErrs holds the CEL validation rules errors.
+	CELErrs field.ErrorList
+}
+
+// String returns a human-readable reference to the object.
+func (r PreflightResult) String() string {
+	name := r.Name
+	if r.Namespace != "" {
+		name = r.Namespace + "/" + name
+	}
+
+	return fmt.Sprintf("%s %s", r.GroupVersionKind.Kind, name)
+}
+
+// Check validates the given objects against the candidate CRDs and returns a result for each object
+// that would be invalid. Objects of other groups are skipped. Objects of the hub.traefik.io group
+// whose version or kind is not served by the candidate CRDs are reported as invalid.
+func (p *Preflight) Check(objects []*unstructured.Unstructured) []PreflightResult {
+	var results []PreflightResult
+
+	for _, object := range objects {
+		gvk := object.GroupVersionKind()
+		if gvk.Group != hubv1alpha1.SchemeGroupVersion.Group {
+			continue
+		}
+
+		result := PreflightResult{
+			GroupVersionKind: gvk,
+			Namespace:        object.GetNamespace(),
+			Name:             object.GetName(),
+		}
+
+		if !p.validator.Known(object) {
+			result.SchemaErrs = field.ErrorList{
+				field.NotSupported[string](field.NewPath("apiVersion"), object.GetAPIVersion(), nil),
+			}
+			results = append(results, result)
+
+			continue
+		}
+
+		result.SchemaErrs = append(p.validator.validateMetadata(object), p.validator.validateSchema(object)...)
+		result.CELErrs = p.validator.validateCEL(object)
+
+		if len(result.SchemaErrs) > 0 || len(result.CELErrs) > 0 {
+			results = append(results, result)
+		}
+	}
+
+	return results
+}
+
+// ListObjects lists all the Hub objects of the given namespace using the given clientset.
+// An empty namespace lists objects across all namespaces. AccessControlPolicies, being
+// cluster scoped, are always listed.
+func ListObjects(ctx context.Context, clientSet versioned.Interface, namespace string) ([]*unstructured.Unstructured, error) {
+	client := clientSet.HubV1alpha1()
+	opts := metav1.ListOptions{}
+
+	listers := []struct {
+		kind string
+		list func() (runtime.Object, error)
+	}{
+		{kind: "AccessControlPolicy", list: func() (runtime.Object, error) { return client.AccessControlPolicies().List(ctx, opts) }},
+		{kind: "AIService", list: func() (runtime.Object, error) { return client.AIServices(namespace).List(ctx, opts) }},
+		{kind: "API", list: func() (runtime.Object, error) { return client.APIs(namespace).List(ctx, opts) }},
+		{kind: "APIAuth", list: func() (runtime.Object, error) { return client.APIAuths(namespace).List(ctx, opts) }},
+		{kind: "APIBundle", list: func() (runtime.Object, error) { return client.APIBundles(namespace).List(ctx, opts) }},
+		{kind: "APICatalogItem", list: func() (runtime.Object, error) { return client.APICatalogItems(namespace).List(ctx, opts) }},
+		{kind: "APIPlan", list: func() (runtime.Object, error) { return client.APIPlans(namespace).List(ctx, opts) }},
+		{kind: "APIPortal", list: func() (runtime.Object, error) { return client.APIPortals(namespace).List(ctx, opts) }},
+		{kind: "APIPortalAuth", list: func() (runtime.Object, error) { return client.APIPortalAuths(namespace).List(ctx, opts) }},
+		{kind: "APIRateLimit", list: func() (runtime.Object, error) { return client.APIRateLimits(namespace).List(ctx, opts) }},
+		{kind: "APIVersion", list: func() (runtime.Object, error) { return client.APIVersions(namespace).List(ctx, opts) }},
+		{kind: "ContentItem", list: func() (runtime.Object, error) { return client.ContentItems(namespace).List(ctx, opts) }},
+		{kind: "ManagedApplication", list: func() (runtime.Object, error) { return client.ManagedApplications(namespace).List(ctx, opts) }},
+		{kind: "ManagedSubscription", list: func() (runtime.Object, error) { return client.ManagedSubscriptions(namespace).List(ctx, opts) }},
+		{kind: "Uplink", list: func() (runtime.Object, error) { return client.Uplinks(namespace).List(ctx, opts) }},
+	}
+
+	var objects []*unstructured.Unstructured
+
+	for _, lister := range listers {
+		list, err := lister.list()
+		if err != nil {
+			return nil, fmt.Errorf("listing %s: %w", lister.kind, err)
+		}
+
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, fmt.Errorf("extracting %s items: %w", lister.kind, err)
+		}
+
+		for _, item := range items {
+			object, err := toUnstructured(item)
+			if err != nil {
+				return nil, err
+			}
+
+			objects = append(objects, object)
+		}
+	}
+
+	return objects, nil
+}
+
+// toUnstructured converts a typed hub.traefik.io/v1alpha1 object to an unstructured one.
+func toUnstructured(item runtime.Object) (*unstructured.Unstructured, error) {
+	// Typed clients and informers don't populate the TypeMeta of objects.
+	gvks, _, err := scheme.Scheme.ObjectKinds(item)
+	if err != nil {
+		return nil, fmt.Errorf("getting object kind: %w", err)
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(item)
+	if err != nil {
+		return nil, fmt.Errorf("converting %s: %w", gvks[0].Kind, err)
+	}
+
+	// The API server drops the null values of non-nullable fields, typed objects however
+	// serialize unset structs such as metadata.creationTimestamp as null.
+	removeNullValues(content)
+
+	object := &unstructured.Unstructured{Object: content}
+	object.SetGroupVersionKind(hubv1alpha1.SchemeGroupVersion.WithKind(gvks[0].Kind))
+
+	return object, nil
+}
+
+func removeNullValues(content map[string]any) {
+	for key, value := range content {
+		switch typed := value.(type) {
+		case nil:
+			delete(content, key)
+		case map[string]any:
+			removeNullValues(typed)
+		case []any:
+			for _, item := range typed {
+				if m, ok := item.(map[string]any); ok {
+					removeNullValues(m)
+				}
+			}
+		}
+	}
+}
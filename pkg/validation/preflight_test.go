@@ -0,0 +1,175 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package validation_test
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	hubcrd "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1/crd"
+	"github.com/traefik/hub-crds/pkg/client/clientset/versioned/fake"
+	"github.com/traefik/hub-crds/pkg/crd"
+	"github.com/traefik/hub-crds/pkg/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestPreflight_Check_files(t *testing.T) {
+	t.Parallel()
+
+	files := fstest.MapFS{
+		"objects.yaml": {Data: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: APIPlan
+metadata:
+  name: my-plan
+  namespace: default
+spec:
+  title: My plan
+---
+apiVersion: hub.traefik.io/v1alpha1
+kind: APIAuth
+metadata:
+  name: my-auth
+  namespace: default
+spec:
+  isDefault: true
+  jwt:
+    appIdClaim: client_id
+    trustedIssuers:
+      - jwksUrl: https://first.example.com/jwks.json
+      - jwksUrl: https://second.example.com/jwks.json
+---
+apiVersion: hub.traefik.io/v1
+kind: API
+metadata:
+  name: my-api
+  namespace: default
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: my-secret
+  namespace: default`)},
+		"list.yaml": {Data: []byte(`
+apiVersion: v1
+kind: List
+items:
+  - apiVersion: hub.traefik.io/v1alpha1
+    kind: APIBundle
+    metadata:
+      name: my-bundle
+      namespace: default
+    spec:
+      apis:
+        - name: my-api
+        - name: my-api`)},
+	}
+
+	objects, err := crd.GetObjects(files)
+	require.NoError(t, err)
+	require.Len(t, objects, 5)
+
+	preflight := newPreflight(t)
+
+	got := preflight.Check(objects)
+
+	want := []validation.PreflightResult{
+		{
+			GroupVersionKind: schema.GroupVersionKind{Group: "hub.traefik.io", Version: "v1alpha1", Kind: "APIBundle"},
+			Namespace:        "default",
+			Name:             "my-bundle",
+			CELErrs:          field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.apis", BadValue: "array", Detail: "duplicated apis"}},
+		},
+		{
+			GroupVersionKind: schema.GroupVersionKind{Group: "hub.traefik.io", Version: "v1alpha1", Kind: "APIAuth"},
+			Namespace:        "default",
+			Name:             "my-auth",
+			CELErrs:          field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.jwt", BadValue: "object", Detail: "only one entry in trustedIssuers may omit the issuer field"}},
+		},
+		{
+			GroupVersionKind: schema.GroupVersionKind{Group: "hub.traefik.io", Version: "v1", Kind: "API"},
+			Namespace:        "default",
+			Name:             "my-api",
+			SchemaErrs:       field.ErrorList{{Type: field.ErrorTypeNotSupported, Field: "apiVersion", BadValue: "hub.traefik.io/v1"}},
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestPreflight_Check_cluster(t *testing.T) {
+	t.Parallel()
+
+	clientSet := fake.NewSimpleClientset(
+		&hubv1alpha1.APIPlan{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-plan", Namespace: "default"},
+			Spec:       hubv1alpha1.APIPlanSpec{Title: "My plan"},
+		},
+		&hubv1alpha1.ManagedApplication{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "default"},
+			Spec: hubv1alpha1.ManagedApplicationSpec{
+				AppID: "my-app",
+				Owner: "me",
+				APIKeys: []hubv1alpha1.APIKey{
+					{SecretName: "my-secret", Value: "my-value"},
+				},
+			},
+		},
+		&hubv1alpha1.AccessControlPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-policy"},
+			Spec: hubv1alpha1.AccessControlPolicySpec{
+				JWT: &hubv1alpha1.AccessControlPolicyJWT{SigningSecret: "secret"},
+			},
+		},
+	)
+
+	objects, err := validation.ListObjects(context.Background(), clientSet, "")
+	require.NoError(t, err)
+	require.Len(t, objects, 3)
+
+	preflight := newPreflight(t)
+
+	got := preflight.Check(objects)
+
+	want := []validation.PreflightResult{
+		{
+			GroupVersionKind: schema.GroupVersionKind{Group: "hub.traefik.io", Version: "v1alpha1", Kind: "ManagedApplication"},
+			Namespace:        "default",
+			Name:             "my-app",
+			CELErrs:          field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.apiKeys[0]", BadValue: "object", Detail: "secretName and value are mutually exclusive"}},
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+func newPreflight(t *testing.T) *validation.Preflight {
+	t.Helper()
+
+	crds, err := crd.GetCRDs(hubcrd.CRDs)
+	require.NoError(t, err)
+
+	preflight, err := validation.NewPreflight(crds)
+	require.NoError(t, err)
+
+	return preflight
+}
@@ -0,0 +1,195 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/traefik/hub-crds/pkg/aiprovider"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	kerror "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+)
+
+// secretReference is a field of a kind naming a Secret of the object namespace.
+type secretReference struct {
+	kind string
+	// path is the path of the field holding the Secret name, "[]" iterates over the items of a list.
+	path []string
+	// keys are the keys the Secret must contain.
+	keys []string
+	// secretType is the type the Secret must have, if any.
+	secretType corev1.SecretType
+}
+
+// secretReferences are the Secret references of the hub.traefik.io kinds, with the keys and type they document.
+// The references of the AIService providers come from the provider registry of the SecretValidator.
+var secretReferences = []secretReference{
+	{kind: "APIAuth", path: []string{"spec", "jwt", "signingSecretName"}, keys: []string{"value"}, secretType: corev1.SecretTypeOpaque},
+	{kind: "APIAuth", path: []string{"spec", "ldap", "bindPasswordSecretName"}, keys: []string{"password"}},
+	{kind: "APIPortalAuth", path: []string{"spec", "oidc", "secretName"}, keys: []string{"clientId", "clientSecret"}},
+	{kind: "APIPortalAuth", path: []string{"spec", "ldap", "bindPasswordSecretName"}, keys: []string{"password"}},
+	{kind: "ManagedApplication", path: []string{"spec", "apiKeys", "[]", "secretName"}},
+}
+
+// SecretGetter returns the Secret of the given namespace and name, or nil if it doesn't exist.
+type SecretGetter func(namespace, name string) (*corev1.Secret, error)
+
+// SecretsFromList returns a SecretGetter looking up the given Secrets.
+func SecretsFromList(secrets []corev1.Secret) SecretGetter {
+	return func(namespace, name string) (*corev1.Secret, error) {
+		for i := range secrets {
+			if secrets[i].Namespace == namespace && secrets[i].Name == name {
+				return &secrets[i], nil
+			}
+		}
+
+		return nil, nil
+	}
+}
+
+// SecretsFromLister returns a SecretGetter backed by the given Secret lister.
+func SecretsFromLister(lister corev1listers.SecretLister) SecretGetter {
+	return func(namespace, name string) (*corev1.Secret, error) {
+		secret, err := lister.Secrets(namespace).Get(name)
+		if kerror.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return secret, err
+	}
+}
+
+// SecretValidator checks that the Secrets referenced by Hub objects exist in the namespace of the objects,
+// with the keys and the type documented by the referencing fields.
+type SecretValidator struct {
+	getSecret SecretGetter
+	providers *aiprovider.Registry
+}
+
+// NewSecretValidator creates a new SecretValidator looking up Secrets with the given getter.
+// The AIService providers are checked against the built-in providers.
+func NewSecretValidator(getSecret SecretGetter) *SecretValidator {
+	return &SecretValidator{getSecret: getSecret, providers: aiprovider.Default()}
+}
+
+// NewSecretValidator creates a new SecretValidator looking up Secrets with the given getter.
+// The AIService providers are checked against the providers registered in the Validator.
+func (v *Validator) NewSecretValidator(getSecret SecretGetter) *SecretValidator {
+	return &SecretValidator{getSecret: getSecret, providers: v.providers}
+}
+
+// Validate validates the Secret references of the given object, of any hub.traefik.io version.
+// Objects of other groups are skipped without returning any error.
+func (v *SecretValidator) Validate(obj *unstructured.Unstructured) field.ErrorList {
+	gvk := obj.GroupVersionKind()
+	if gvk.Group != hubv1alpha1.SchemeGroupVersion.Group {
+		return nil
+	}
+
+	var errs field.ErrorList
+	for _, ref := range secretReferences {
+		if ref.kind != gvk.Kind {
+			continue
+		}
+
+		walkStrings(obj.Object, ref.path, nil, func(path *field.Path, name string) {
+			errs = append(errs, v.validateSecret(obj.GetNamespace(), path, name, ref)...)
+		})
+	}
+
+	// The errors resolving the providers are reported by the Validator.
+	configs, _ := providerConfigs(v.providers, obj)
+	for _, config := range configs {
+		if config.provider.SecretField == "" {
+			continue
+		}
+
+		ref := secretReference{kind: gvk.Kind, keys: config.provider.SecretKeys}
+		walkStrings(config.content, []string{config.provider.SecretField, "secretName"}, config.path, func(path *field.Path, name string) {
+			errs = append(errs, v.validateSecret(obj.GetNamespace(), path, name, ref)...)
+		})
+	}
+
+	return errs
+}
+
+func (v *SecretValidator) validateSecret(namespace string, path *field.Path, name string, ref secretReference) field.ErrorList {
+	secret, err := v.getSecret(namespace, name)
+	if err != nil {
+		return field.ErrorList{field.InternalError(path, fmt.Errorf("getting secret %s/%s: %w", namespace, name, err))}
+	}
+	if secret == nil {
+		return field.ErrorList{field.NotFound(path, name)}
+	}
+
+	var errs field.ErrorList
+	if ref.secretType != "" && secret.Type != ref.secretType && !(ref.secretType == corev1.SecretTypeOpaque && secret.Type == "") {
+		errs = append(errs, field.Invalid(path, name, fmt.Sprintf("secret must be of type %s, not %s", ref.secretType, secret.Type)))
+	}
+
+	for _, key := range ref.keys {
+		if _, ok := secret.Data[key]; ok {
+			continue
+		}
+		if _, ok := secret.StringData[key]; ok {
+			continue
+		}
+
+		errs = append(errs, field.Invalid(path, name, fmt.Sprintf("secret must contain the key %q", key)))
+	}
+
+	return errs
+}
+
+// walkStrings calls fn for each non-empty string found at the given path of the content.
+func walkStrings(content any, path []string, fieldPath *field.Path, fn func(*field.Path, string)) {
+	if len(path) == 0 {
+		if value, ok := content.(string); ok && value != "" {
+			fn(fieldPath, value)
+		}
+
+		return
+	}
+
+	if path[0] == "[]" {
+		items, _ := content.([]any)
+		for i, item := range items {
+			walkStrings(item, path[1:], fieldPath.Index(i), fn)
+		}
+
+		return
+	}
+
+	m, ok := content.(map[string]any)
+	if !ok {
+		return
+	}
+
+	var child *field.Path
+	if fieldPath == nil {
+		child = field.NewPath(path[0])
+	} else {
+		child = fieldPath.Child(path[0])
+	}
+
+	walkStrings(m[path[0]], path[1:], child, fn)
+}
@@ -0,0 +1,352 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package validation_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/hub-crds/pkg/crd"
+	"github.com/traefik/hub-crds/pkg/validation"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestSecretValidator_Validate(t *testing.T) {
+	t.Parallel()
+
+	secrets := []corev1.Secret{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "signing"},
+			Type:       corev1.SecretTypeOpaque,
+			Data:       map[string][]byte{"value": []byte("secret")},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "tls"},
+			Type:       corev1.SecretTypeTLS,
+			Data:       map[string][]byte{"value": []byte("secret")},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "oidc"},
+			StringData: map[string]string{"clientId": "id"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "api-key"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gemini"},
+			Data:       map[string][]byte{"apiKey": []byte("secret")},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "anthropic"},
+			Data:       map[string][]byte{"apiKey": []byte("secret")},
+		},
+	}
+
+	tests := []struct {
+		desc   string
+		object string
+		want   []string
+	}{
+		{
+			desc: "valid signing secret",
+			object: `
+apiVersion: hub.traefik.io/v1alpha1
+kind: APIAuth
+metadata:
+  name: auth
+  namespace: default
+spec:
+  isDefault: true
+  jwt:
+    appIdClaim: sub
+    signingSecretName: signing`,
+		},
+		{
+			desc: "signing secret of the wrong type",
+			object: `
+apiVersion: hub.traefik.io/v1beta1
+kind: APIAuth
+metadata:
+  name: auth
+  namespace: default
+spec:
+  isDefault: true
+  jwt:
+    appIdClaim: sub
+    signingSecretName: tls`,
+			want: []string{`spec.jwt.signingSecretName: Invalid value: "tls": secret must be of type Opaque, not kubernetes.io/tls`},
+		},
+		{
+			desc: "missing key",
+			object: `
+apiVersion: hub.traefik.io/v1alpha1
+kind: APIPortalAuth
+metadata:
+  name: auth
+  namespace: default
+spec:
+  oidc:
+    issuerUrl: https://example.com
+    secretName: oidc`,
+			want: []string{`spec.oidc.secretName: Invalid value: "oidc": secret must contain the key "clientSecret"`},
+		},
+		{
+			desc: "secret of another namespace",
+			object: `
+apiVersion: hub.traefik.io/v1alpha1
+kind: ManagedApplication
+metadata:
+  name: app
+  namespace: default
+spec:
+  appId: app
+  owner: me
+  apiKeys:
+    - value: key
+    - secretName: api-key`,
+			want: []string{`spec.apiKeys[1].secretName: Not found: "api-key"`},
+		},
+		{
+			desc: "AI service token",
+			object: `
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: ai
+  namespace: default
+spec:
+  openai:
+    token:
+      secretName: openai`,
+			want: []string{`spec.openai.token.secretName: Not found: "openai"`},
+		},
+		{
+			desc: "AI service routing backend token",
+			object: `
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: ai
+  namespace: default
+spec:
+  routing:
+    backends:
+      - aiService:
+          name: other
+      - mistral:
+          apiKey:
+            secretName: mistral`,
+			want: []string{`spec.routing.backends[1].mistral.apiKey.secretName: Not found: "mistral"`},
+		},
+		{
+			desc: "AI service OpenAI-compatible token",
+			object: `
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: ai
+  namespace: default
+spec:
+  openaiCompatible:
+    provider: groq
+    token:
+      secretName: groq`,
+			want: []string{`spec.openaiCompatible.token.secretName: Not found: "groq"`},
+		},
+		{
+			desc: "valid AI service API key",
+			object: `
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: ai
+  namespace: default
+spec:
+  gemini:
+    apiKey:
+      secretName: gemini`,
+		},
+		{
+			desc: "AI service token without its key",
+			object: `
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: ai
+  namespace: default
+spec:
+  anthropic:
+    token:
+      secretName: anthropic`,
+			want: []string{`spec.anthropic.token.secretName: Invalid value: "anthropic": secret must contain the key "token"`},
+		},
+	}
+
+	validator := validation.NewSecretValidator(validation.SecretsFromList(secrets))
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			objects, err := crd.GetObjects(fstest.MapFS{"object.yaml": {Data: []byte(test.object)}})
+			require.NoError(t, err)
+			require.Len(t, objects, 1)
+
+			var got []string
+			for _, fieldErr := range validator.Validate(objects[0]) {
+				got = append(got, fieldErr.Error())
+			}
+
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestValidator_NewSecretValidator(t *testing.T) {
+	t.Parallel()
+
+	secrets := []corev1.Secret{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "acme"},
+			Data:       map[string][]byte{"apiKey": []byte("secret")},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "token"},
+			Data:       map[string][]byte{"token": []byte("secret")},
+		},
+	}
+
+	tests := []struct {
+		desc   string
+		object string
+		want   []string
+	}{
+		{
+			desc: "registered provider token",
+			object: `
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: ai
+  namespace: default
+spec:
+  openaiCompatible:
+    provider: acme
+    token:
+      secretName: acme`,
+		},
+		{
+			desc: "registered provider token without its keys",
+			object: `
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: ai
+  namespace: default
+spec:
+  openaiCompatible:
+    provider: acme
+    token:
+      secretName: token`,
+			want: []string{`spec.openaiCompatible.token.secretName: Invalid value: "token": secret must contain the key "apiKey"`},
+		},
+		{
+			desc: "registered provider token of a routing backend",
+			object: `
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: ai
+  namespace: default
+spec:
+  routing:
+    backends:
+      - openaiCompatible:
+          provider: acme
+          token:
+            secretName: missing`,
+			want: []string{`spec.routing.backends[0].openaiCompatible.token.secretName: Not found: "missing"`},
+		},
+		{
+			desc: "generic OpenAI-compatible token",
+			object: `
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: ai
+  namespace: default
+spec:
+  openaiCompatible:
+    baseUrl: http://vllm.default.svc
+    token:
+      secretName: token`,
+		},
+	}
+
+	validator := newHubValidator(t).NewSecretValidator(validation.SecretsFromList(secrets))
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			objects, err := crd.GetObjects(fstest.MapFS{"object.yaml": {Data: []byte(test.object)}})
+			require.NoError(t, err)
+			require.Len(t, objects, 1)
+
+			var got []string
+			for _, fieldErr := range validator.Validate(objects[0]) {
+				got = append(got, fieldErr.Error())
+			}
+
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestSecretsFromLister(t *testing.T) {
+	t.Parallel()
+
+	client := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ldap"},
+		Data:       map[string][]byte{"password": []byte("secret")},
+	})
+
+	factory := informers.NewSharedInformerFactory(client, 0)
+	lister := factory.Core().V1().Secrets().Lister()
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	factory.Start(stopCh)
+	factory.WaitForCacheSync(stopCh)
+
+	getSecret := validation.SecretsFromLister(lister)
+
+	secret, err := getSecret("default", "ldap")
+	require.NoError(t, err)
+	require.NotNil(t, secret)
+
+	secret, err = getSecret("default", "missing")
+	require.NoError(t, err)
+	assert.Nil(t, secret)
+}
@@ -52,6 +52,745 @@ spec:
   openai:
     token: 
       secretName: xxxx`),
+		},
+		{
+			desc: "valid: full azure openai",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  azureOpenai:
+    apiKeySecret:
+      secretName: my-secret
+    model: gpt-4o
+    deploymentName: my-deployment
+    baseUrl: https://my-resource.openai.azure.com
+    params:
+      temperature: 0.7
+      topP: 0.9
+      maxTokens: 2048
+      frequencyPenalty: -1.5
+      presencePenalty: 2`),
+		},
+		{
+			desc: "valid: ollama",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  ollama:
+    model: llama3
+    baseUrl: http://ollama.default.svc:11434`),
+		},
+		{
+			desc: "missing provider",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  {}`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec", BadValue: "object", Detail: "exactly one provider or routing must be specified"}},
+		},
+		{
+			desc: "multiple providers",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  openai:
+    model: gpt-4o
+  anthropic:
+    model: claude`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec", BadValue: "object", Detail: "exactly one provider or routing must be specified"}},
+		},
+		{
+			desc: "azure openai: missing deployment name and base URL",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  azureOpenai:
+    model: gpt-4o`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeRequired, Field: "spec.azureOpenai.baseUrl", BadValue: ""}, {Type: field.ErrorTypeRequired, Field: "spec.azureOpenai.deploymentName", BadValue: ""}},
+		},
+		{
+			desc: "azure openai: empty deployment name",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  azureOpenai:
+    deploymentName: ""
+    baseUrl: https://my-resource.openai.azure.com`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.azureOpenai.deploymentName", BadValue: "", Detail: "spec.azureOpenai.deploymentName in body should be at least 1 chars long"}},
+		},
+		{
+			desc: "azure openai: invalid base URL",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  azureOpenai:
+    deploymentName: my-deployment
+    baseUrl: not a url`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.azureOpenai.baseUrl", BadValue: "string", Detail: "must be a valid HTTP or HTTPS URL"}},
+		},
+		{
+			desc: "ollama: missing base URL",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  ollama:
+    model: llama3`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeRequired, Field: "spec.ollama.baseUrl", BadValue: ""}},
+		},
+		{
+			desc: "openai: base URL with unsupported scheme",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  openai:
+    baseUrl: ftp://example.com`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.openai.baseUrl", BadValue: "string", Detail: "must be a valid HTTP or HTTPS URL"}},
+		},
+		{
+			desc: "deepseek: invalid base URL",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  deepSeek:
+    baseUrl: example.com`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.deepSeek.baseUrl", BadValue: "string", Detail: "must be a valid HTTP or HTTPS URL"}},
+		},
+		{
+			desc: "qwen: invalid base URL",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  qWen:
+    baseUrl: "://example.com"`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.qWen.baseUrl", BadValue: "string", Detail: "must be a valid HTTP or HTTPS URL"}},
+		},
+		{
+			desc: "temperature too high",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  mistral:
+    params:
+      temperature: 2.5`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.mistral.params.temperature", BadValue: "number", Detail: "must be between 0 and 2"}},
+		},
+		{
+			desc: "topP too high",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  mistral:
+    params:
+      topP: 1.1`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.mistral.params.topP", BadValue: "number", Detail: "must be between 0 and 1"}},
+		},
+		{
+			desc: "negative max tokens",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  mistral:
+    params:
+      maxTokens: -1`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.mistral.params.maxTokens", BadValue: "integer", Detail: "must be a positive number"}},
+		},
+		{
+			desc: "frequency penalty too low",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  mistral:
+    params:
+      frequencyPenalty: -2.1`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.mistral.params.frequencyPenalty", BadValue: "number", Detail: "must be between -2 and 2"}},
+		},
+		{
+			desc: "presence penalty too high",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  mistral:
+    params:
+      presencePenalty: 3`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.mistral.params.presencePenalty", BadValue: "number", Detail: "must be between -2 and 2"}},
+		},
+		{
+			desc: "negative temperature",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  gemini:
+    params:
+      temperature: -0.1`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.gemini.params.temperature", BadValue: "number", Detail: "must be between 0 and 2"}},
+		},
+		{
+			desc: "valid: failover routing",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  routing:
+    backends:
+      - aiService:
+          name: primary
+      - openai:
+          token:
+            secretName: openai
+          model: gpt-4o
+        params:
+          temperature: 0.2
+    failover:
+      statusCodes: ["429", "500-599"]
+      timeout: 30s`),
+		},
+		{
+			desc: "valid: weighted routing",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  routing:
+    strategy: weighted
+    backends:
+      - aiService:
+          name: a
+        weight: 3
+      - aiService:
+          name: b
+        weight: 1`),
+		},
+		{
+			desc: "provider and routing",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  openai:
+    model: gpt-4o
+  routing:
+    backends:
+      - aiService:
+          name: other`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec", BadValue: "object", Detail: "exactly one provider or routing must be specified"}},
+		},
+		{
+			desc: "routing: no backends",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  routing:
+    backends: []`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.routing.backends", BadValue: int64(0), Detail: "spec.routing.backends in body should have at least 1 items"}},
+		},
+		{
+			desc: "routing: backend with reference and provider",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  routing:
+    backends:
+      - aiService:
+          name: other
+        openai:
+          model: gpt-4o`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.routing.backends[0]", BadValue: "object", Detail: "exactly one of aiService or a provider must be specified"}},
+		},
+		{
+			desc: "routing: empty backend",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  routing:
+    backends:
+      - weight: 1
+    strategy: weighted`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.routing.backends[0]", BadValue: "object", Detail: "exactly one of aiService or a provider must be specified"}},
+		},
+		{
+			desc: "routing: weight with failover strategy",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  routing:
+    backends:
+      - aiService:
+          name: other
+        weight: 2`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.routing", BadValue: "object", Detail: "weight can only be set with the weighted strategy"}},
+		},
+		{
+			desc: "valid: default weight with failover strategy",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  routing:
+    strategy: failover
+    backends:
+      - aiService:
+          name: other
+        weight: 1`),
+		},
+		{
+			desc: "routing: weighted strategy without non-zero weight",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  routing:
+    strategy: weighted
+    backends:
+      - aiService:
+          name: first
+        weight: 0
+      - aiService:
+          name: second
+        weight: 0`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.routing", BadValue: "object", Detail: "at least one backend must have a non-zero weight"}},
+		},
+		{
+			desc: "routing: duplicated reference",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  routing:
+    backends:
+      - aiService:
+          name: other
+      - aiService:
+          name: other`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.routing", BadValue: "object", Detail: "duplicated AIService reference"}},
+		},
+		{
+			desc: "routing: self reference",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  routing:
+    backends:
+      - aiService:
+          name: my-ai-service`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "<nil>", BadValue: "object", Detail: "an AIService cannot reference itself"}},
+		},
+		{
+			desc: "routing: invalid failover status code",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  routing:
+    backends:
+      - aiService:
+          name: other
+    failover:
+      statusCodes: ["500", "5xx"]`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.routing.failover.statusCodes", BadValue: "array", Detail: "must be status codes or ranges of status codes such as 500-599"}},
+		},
+		{
+			desc: "routing: failover timeout too long",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  routing:
+    backends:
+      - aiService:
+          name: other
+    failover:
+      timeout: 2h`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.routing.failover.timeout", BadValue: "string", Detail: "must be between 1s and 1h"}},
+		},
+		{
+			desc: "routing: invalid strategy",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  routing:
+    strategy: random
+    backends:
+      - aiService:
+          name: other`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeNotSupported, Field: "spec.routing.strategy", BadValue: "random", Detail: "supported values: \"failover\", \"weighted\""}},
+		},
+		{
+			desc: "routing: negative weight",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  routing:
+    strategy: weighted
+    backends:
+      - aiService:
+          name: other
+        weight: -1
+      - aiService:
+          name: fallback
+        weight: 1`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.routing.backends[0].weight", BadValue: "integer", Detail: "must be a positive number"}},
+		},
+		{
+			desc: "valid: token budgets, models and content guard",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  openai:
+    model: gpt-4o
+  tokenBudgets:
+    - limit: 10000
+      period: 1m
+      bucket: application
+    - limit: 1000000
+      window: calendar-month
+      tokens: input
+  models:
+    allow: [gpt-4o, gpt-4o-mini]
+    deny: [gpt-4o-mini]
+  contentGuard:
+    prompt:
+      url: http://guard.default.svc:8080/check
+      timeout: 2s
+    response:
+      url: https://guard.example.com/check
+      failOpen: true`),
+		},
+		{
+			desc: "negative token budget",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  openai:
+    model: gpt-4o
+  tokenBudgets:
+    - limit: -5`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.tokenBudgets[0].limit", BadValue: "integer", Detail: "must be a positive number"}},
+		},
+		{
+			desc: "token budget period out of range",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  openai:
+    model: gpt-4o
+  tokenBudgets:
+    - limit: 10000
+      period: 1m
+    - limit: 1000000
+      period: 417d`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.tokenBudgets[1].period", BadValue: "string", Detail: "must be between 1s and 9999h"}},
+		},
+		{
+			desc: "duplicated denied models",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  openai:
+    model: gpt-4o
+  models:
+    deny: [gpt-4o, gpt-4o]`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.models.deny", BadValue: "array", Detail: "duplicated models"}},
+		},
+		{
+			desc: "empty content guard",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  openai:
+    model: gpt-4o
+  contentGuard: {}`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.contentGuard", BadValue: "object", Detail: "at least one of prompt or response must be specified"}},
+		},
+		{
+			desc: "content guard with invalid URL",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  openai:
+    model: gpt-4o
+  contentGuard:
+    prompt:
+      url: guard.default.svc`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.contentGuard.prompt.url", BadValue: "string", Detail: "must be a valid HTTP or HTTPS URL"}},
+		},
+		{
+			desc: "content guard timeout too long",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  openai:
+    model: gpt-4o
+  contentGuard:
+    response:
+      url: http://guard.default.svc
+      timeout: 2h`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.contentGuard.response.timeout", BadValue: "string", Detail: "must be between 1s and 1h"}},
+		},
+		{
+			desc: "valid: OpenAI-compatible provider",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  openaiCompatible:
+    provider: groq
+    token:
+      secretName: groq
+    model: llama-3.3-70b-versatile
+    params:
+      temperature: 0.5`),
+		},
+		{
+			desc: "valid: self-hosted OpenAI-compatible provider",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  openaiCompatible:
+    baseUrl: http://vllm.default.svc:8000/v1
+    authHeader: X-Api-Key
+    headers:
+      X-Tenant: my-tenant
+    model: mistral-7b`),
+		},
+		{
+			desc: "OpenAI-compatible: unknown provider",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  openaiCompatible:
+    provider: acme`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeNotSupported, Field: "spec.openaiCompatible.provider", BadValue: "acme", Detail: `supported values: "groq", "tgi", "together", "vllm", "xai"`}},
+		},
+		{
+			desc: "OpenAI-compatible: missing base URL",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  openaiCompatible:
+    provider: vllm`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeRequired, Field: "spec.openaiCompatible.baseUrl", BadValue: "", Detail: "the vllm provider has no default base URL"}},
+		},
+		{
+			desc: "OpenAI-compatible: missing token",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  openaiCompatible:
+    provider: xai`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeRequired, Field: "spec.openaiCompatible.token", BadValue: "", Detail: "required by the xai provider"}},
+		},
+		{
+			desc: "OpenAI-compatible: auth scheme with custom header",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  openaiCompatible:
+    baseUrl: http://tgi.default.svc
+    authHeader: X-Api-Key
+    authScheme: Token`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.openaiCompatible", BadValue: "object", Detail: "authScheme can only be used when authHeader is 'Authorization'"}},
+		},
+		{
+			desc: "provider params not known to be supported",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  anthropic:
+    params:
+      temperature: 1
+      presencePenalty: 1
+      frequencyPenalty: 1`),
+		},
+		{
+			desc: "routing backend params not known to be supported",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: AIService
+metadata:
+  name: my-ai-service
+  namespace: default
+spec:
+  routing:
+    backends:
+      - bedrock:
+          model: claude
+        params:
+          presencePenalty: 1`),
 		},
 		{
 			desc: "invalid resource name",
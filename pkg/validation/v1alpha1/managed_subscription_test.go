@@ -18,6 +18,7 @@ along with this program. If not, see <https://www.gnu.org/licenses/>.
 package v1alpha1_test
 
 import (
+	"strings"
 	"testing"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -26,6 +27,8 @@ import (
 func TestManagedSubscription_Validation(t *testing.T) {
 	t.Parallel()
 
+	tooLongClaims := "OneOf(`group`, `" + strings.Repeat("x", 4096) + "`)"
+
 	tests := []validationTestCase{
 		{
 			desc: "missing resource namespace",
@@ -68,7 +71,7 @@ spec:
     name: my-plan
   apis:
     - name: my-api
-  claims: expression
+  claims: 'Equals("group", "dev") && Contains("scope", "read")'
   apiSelector:
     matchLabels:
       key: value
@@ -79,6 +82,54 @@ spec:
     include:
       - my-filter`),
 		},
+		{
+			desc: "invalid claims expression",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: ManagedSubscription
+metadata:
+  name: my-managed-subscription
+  namespace: default
+spec:
+  apiPlan:
+    name: my-plan
+  apis:
+    - name: my-api
+  claims: 'Equals("group", "dev") &&'`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.claims", BadValue: `Equals("group", "dev") &&`, Detail: "unexpected end of expression at offset 25"}},
+		},
+		{
+			desc: "claims expression too long",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: ManagedSubscription
+metadata:
+  name: my-managed-subscription
+  namespace: default
+spec:
+  apiPlan:
+    name: my-plan
+  apis:
+    - name: my-api
+  claims: '` + tooLongClaims + `'`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeTooLong, Field: "spec.claims", BadValue: "<value omitted>", Detail: "may not be more than 4096 bytes"}},
+		},
+		{
+			desc: "claims expression nested too deeply",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: ManagedSubscription
+metadata:
+  name: my-managed-subscription
+  namespace: default
+spec:
+  apiPlan:
+    name: my-plan
+  apis:
+    - name: my-api
+  claims: '` + strings.Repeat("!", 33) + `Equals("group", "dev")'`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.claims", BadValue: strings.Repeat("!", 33) + `Equals("group", "dev")`, Detail: "expression nested more than 32 levels deep at offset 32"}},
+		},
 		{
 			desc: "invalid managed applications selector",
 			manifest: []byte(`
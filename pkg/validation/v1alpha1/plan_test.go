@@ -78,6 +78,55 @@ spec:
     limit: 100
     period: 1h
     bucket: application`),
+		},
+		{
+			desc: "valid: periods with days, weeks and ISO-8601",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: APIPlan
+metadata:
+  name: my-plan
+  namespace: default
+spec:
+  title: my-plan
+  rateLimit:
+    limit: 10
+    period: PT30M
+  quota:
+    limit: 100000
+    period: 4w2d`),
+		},
+		{
+			desc: "valid: calendar month quota",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: APIPlan
+metadata:
+  name: my-plan
+  namespace: default
+spec:
+  title: my-plan
+  quota:
+    limit: 100000
+    window: calendar-month`),
+		},
+		{
+			desc: "valid: token quota",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: APIPlan
+metadata:
+  name: my-plan
+  namespace: default
+spec:
+  title: my-plan
+  quota:
+    limit: 1000
+  tokenQuota:
+    limit: 1000000
+    period: 30d
+    bucket: application
+    tokens: output`),
 		},
 		{
 			desc: "missing resource namespace",
@@ -193,6 +242,127 @@ spec:
     period: 10000h`),
 			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.quota.period", BadValue: "string", Detail: "must be between 1s and 9999h"}},
 		},
+		{
+			desc: "quota period in days must be less than 9999 hour",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: APIPlan
+metadata:
+  name: my-plan
+  namespace: default
+spec:
+  title: my-plan
+  quota:
+    limit: 1
+    period: 417d`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.quota.period", BadValue: "string", Detail: "must be between 1s and 9999h"}},
+		},
+		{
+			desc: "rate limit ISO-8601 period must be less than 1 hour",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: APIPlan
+metadata:
+  name: my-plan
+  namespace: default
+spec:
+  title: my-plan
+  rateLimit:
+    limit: 1
+    period: PT1H1S`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.rateLimit.period", BadValue: "string", Detail: "must be between 1s and 1h"}},
+		},
+		{
+			desc: "rate limit period must be more than 1 second",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: APIPlan
+metadata:
+  name: my-plan
+  namespace: default
+spec:
+  title: my-plan
+  rateLimit:
+    limit: 1
+    period: "0"`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.rateLimit.period", BadValue: "string", Detail: "must be between 1s and 1h"}},
+		},
+		{
+			desc: "calendar month quota cannot have a period",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: APIPlan
+metadata:
+  name: my-plan
+  namespace: default
+spec:
+  title: my-plan
+  quota:
+    limit: 1
+    period: 30d
+    window: calendar-month`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.quota", BadValue: "object", Detail: "period cannot be set with a calendar-month window"}},
+		},
+		{
+			desc: "token quota limit must be a positive integer",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: APIPlan
+metadata:
+  name: my-plan
+  namespace: default
+spec:
+  title: my-plan
+  tokenQuota:
+    limit: -1`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.tokenQuota.limit", BadValue: "integer", Detail: "must be a positive number"}},
+		},
+		{
+			desc: "calendar month token quota cannot have a period",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: APIPlan
+metadata:
+  name: my-plan
+  namespace: default
+spec:
+  title: my-plan
+  tokenQuota:
+    limit: 1
+    period: 2w
+    window: calendar-month`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.tokenQuota", BadValue: "object", Detail: "period cannot be set with a calendar-month window"}},
+		},
+		{
+			desc: "invalid token type",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: APIPlan
+metadata:
+  name: my-plan
+  namespace: default
+spec:
+  title: my-plan
+  tokenQuota:
+    limit: 1
+    tokens: cached`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeNotSupported, Field: "spec.tokenQuota.tokens", BadValue: "cached", Detail: "supported values: \"total\", \"input\", \"output\""}},
+		},
+		{
+			desc: "token quota period must be less than 9999 hours",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: APIPlan
+metadata:
+  name: my-plan
+  namespace: default
+spec:
+  title: my-plan
+  tokenQuota:
+    limit: 1
+    period: 60w`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.tokenQuota.period", BadValue: "string", Detail: "must be between 1s and 9999h"}},
+		},
 		{
 			desc: "rate limit period must be less than 1 hour",
 			manifest: []byte(`
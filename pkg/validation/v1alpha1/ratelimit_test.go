@@ -153,6 +153,20 @@ metadata:
 spec:
   limit: 1
   period: 0s
+`),
+			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.period", BadValue: "string", Detail: "must be between 1s and 1h"}},
+		},
+		{
+			desc: "period in weeks must be less than 1 hour",
+			manifest: []byte(`
+apiVersion: hub.traefik.io/v1alpha1
+kind: APIRateLimit
+metadata:
+  name: my-ratelimit
+  namespace: default
+spec:
+  limit: 1
+  period: 10w
 `),
 			wantErrs: field.ErrorList{{Type: field.ErrorTypeInvalid, Field: "spec.period", BadValue: "string", Detail: "must be between 1s and 1h"}},
 		},
@@ -15,12 +15,19 @@ You should have received a copy of the GNU Affero General Public License
 along with this program. If not, see <https://www.gnu.org/licenses/>.
 */
 
+// Package validation validates Hub objects offline, the way the API server would, and beyond.
+//
+// The Validator checks objects against the schema and the CEL rules of their CRD, checks the syntax of claims
+// expressions and the range of periods, and checks AIServices against a registry of LLM providers. The constraints
+// spanning several objects are checked by ValidateCollection, the Secrets referenced by objects by a
+// SecretValidator, and the objects of a cluster can be re-validated against candidate CRDs with a Preflight.
 package validation
 
 import (
 	"context"
 	"fmt"
 
+	"github.com/traefik/hub-crds/pkg/aiprovider"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
 	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
 	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel"
@@ -34,12 +41,14 @@ import (
 )
 
 // Validator validates the Kubernetes resources against their OpenAPI specification.
-// It runs spec, metadata and CEL validations.
+// It runs spec, metadata and CEL validations, checks the syntax of claims expressions and the range of periods,
+// and checks the AIService providers against a registry of LLM providers.
 type Validator struct {
 	structuralSchemas map[string]*schema.Structural
 	namespaced        map[string]bool
 	schemaValidators  map[string]apiservervalidation.SchemaValidator
 	celValidators     map[string]*cel.Validator
+	providers         *aiprovider.Registry
 }
 
 // NewValidator creates a new Validator.
@@ -49,6 +58,7 @@ func NewValidator() *Validator {
 		namespaced:        make(map[string]bool),
 		schemaValidators:  make(map[string]apiservervalidation.SchemaValidator),
 		celValidators:     make(map[string]*cel.Validator),
+		providers:         aiprovider.Default(),
 	}
 }
 
@@ -90,39 +100,61 @@ func (v *Validator) Register(crd *apiextensions.CustomResourceDefinition) error
 // Validate validates the given object and report potential issues.
 // Unknown objects are skipped without returning any error.
 func (v *Validator) Validate(obj *unstructured.Unstructured) field.ErrorList {
-	key := obj.GetObjectKind().GroupVersionKind().String()
-
-	structuralSchema, ok := v.structuralSchemas[key]
-	if !ok {
+	if !v.Known(obj) {
 		// Skip unknown resource.
 		return nil
 	}
 
-	unstructuredContent := obj.UnstructuredContent()
+	fieldErrs := v.validateMetadata(obj)
+	fieldErrs = append(fieldErrs, v.validateSchema(obj)...)
+	fieldErrs = append(fieldErrs, v.validateCEL(obj)...)
+	fieldErrs = append(fieldErrs, v.validateClaims(obj)...)
+	fieldErrs = append(fieldErrs, v.validatePeriods(obj, fieldErrs)...)
+	fieldErrs = append(fieldErrs, v.validateAIProviders(obj)...)
+
+	return fieldErrs
+}
 
-	namespaced := v.namespaced[key]
+// Known returns true if the group, version and kind of the given object have been registered.
+func (v *Validator) Known(obj *unstructured.Unstructured) bool {
+	_, ok := v.structuralSchemas[obj.GetObjectKind().GroupVersionKind().String()]
 
-	var fieldErrs field.ErrorList
+	return ok
+}
 
-	// Validate object metadata.
+// validateMetadata validates the object metadata.
+func (v *Validator) validateMetadata(obj *unstructured.Unstructured) field.ErrorList {
 	accessor, err := meta.Accessor(obj)
 	if err != nil {
-		fieldErrs = append(fieldErrs, field.Invalid(field.NewPath("metadata"), nil, err.Error()))
-	} else {
-		// TODO: replace NameIsDNSLabel by NameIsDNSSubdomain once the backend will have relaxed this constrain.
-		fieldErrs = append(fieldErrs, apivalidation.ValidateObjectMetaAccessor(accessor, namespaced, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+		return field.ErrorList{field.Invalid(field.NewPath("metadata"), nil, err.Error())}
 	}
 
-	// Validate object schema.
-	if validator, ok := v.schemaValidators[key]; ok {
-		fieldErrs = append(fieldErrs, apiservervalidation.ValidateCustomResource(nil, unstructuredContent, validator)...)
+	namespaced := v.namespaced[obj.GetObjectKind().GroupVersionKind().String()]
+
+	// TODO: replace NameIsDNSLabel by NameIsDNSSubdomain once the backend will have relaxed this constrain.
+	return apivalidation.ValidateObjectMetaAccessor(accessor, namespaced, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))
+}
+
+// validateSchema validates the object against its OpenAPI schema.
+func (v *Validator) validateSchema(obj *unstructured.Unstructured) field.ErrorList {
+	validator, ok := v.schemaValidators[obj.GetObjectKind().GroupVersionKind().String()]
+	if !ok {
+		return nil
 	}
 
-	// Validate CEL rules.
-	if validator, ok := v.celValidators[key]; ok {
-		celErrs, _ := validator.Validate(context.Background(), nil, structuralSchema, unstructuredContent, nil, apiservercel.RuntimeCELCostBudget)
-		fieldErrs = append(fieldErrs, celErrs...)
+	return apiservervalidation.ValidateCustomResource(nil, obj.UnstructuredContent(), validator)
+}
+
+// validateCEL validates the object against the CEL rules of its schema.
+func (v *Validator) validateCEL(obj *unstructured.Unstructured) field.ErrorList {
+	key := obj.GetObjectKind().GroupVersionKind().String()
+
+	validator, ok := v.celValidators[key]
+	if !ok {
+		return nil
 	}
 
-	return fieldErrs
+	celErrs, _ := validator.Validate(context.Background(), nil, v.structuralSchemas[key], obj.UnstructuredContent(), nil, apiservercel.RuntimeCELCostBudget)
+
+	return celErrs
 }
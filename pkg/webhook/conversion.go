@@ -0,0 +1,214 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package webhook provides the HTTP handlers the Kubernetes API server calls for Hub objects: the ConversionHandler
+// of the conversion webhook, needed by the CRDs defining several versions, and the DeletionHandler of a validating
+// webhook registered for the DELETE operation. PatchCRDs sets the conversion webhook of the CRD manifests.
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/traefik/hub-crds/pkg/apis/hub"
+	"github.com/traefik/hub-crds/pkg/conversion"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// maxBodySize is the maximum size of a request body, which matches the API server request size limit.
+const maxBodySize = 3 * 1024 * 1024
+
+// NewScheme creates a new scheme holding all the hub.traefik.io versions and their conversion functions.
+func NewScheme() (*runtime.Scheme, error) {
+	scheme := runtime.NewScheme()
+	if err := hub.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("adding hub.traefik.io resources: %w", err)
+	}
+
+	return scheme, nil
+}
+
+// ConversionHandler serves the apiextensions.k8s.io/v1 ConversionReview requests of the hub.traefik.io CRDs.
+// Objects are converted using the conversion functions registered in the scheme. Conversions between two
+// versions which are not the hub go through the hub version of the kind.
+type ConversionHandler struct {
+	scheme *runtime.Scheme
+}
+
+// NewConversionHandler creates a new ConversionHandler using the given scheme.
+func NewConversionHandler(scheme *runtime.Scheme) *ConversionHandler {
+	return &ConversionHandler{scheme: scheme}
+}
+
+// ServeHTTP serves a ConversionReview request.
+func (h *ConversionHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(rw, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(rw, req.Body, maxBodySize))
+	if err != nil {
+		http.Error(rw, fmt.Sprintf("reading body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	var review apiextensionsv1.ConversionReview
+	if err = json.Unmarshal(body, &review); err != nil {
+		http.Error(rw, fmt.Sprintf("decoding conversion review: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	if review.Request == nil {
+		http.Error(rw, "missing conversion request", http.StatusBadRequest)
+		return
+	}
+
+	review.Response = h.review(review.Request)
+	review.Request = nil
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err = json.NewEncoder(rw).Encode(review); err != nil {
+		http.Error(rw, fmt.Sprintf("encoding conversion review: %v", err), http.StatusInternalServerError)
+	}
+}
+
+func (h *ConversionHandler) review(req *apiextensionsv1.ConversionRequest) *apiextensionsv1.ConversionResponse {
+	resp := &apiextensionsv1.ConversionResponse{UID: req.UID}
+
+	desiredVersion, err := schema.ParseGroupVersion(req.DesiredAPIVersion)
+	if err != nil {
+		resp.Result = failure(fmt.Errorf("parsing desired API version: %w", err))
+		return resp
+	}
+
+	for i, object := range req.Objects {
+		converted, err := h.convert(object.Raw, desiredVersion)
+		if err != nil {
+			resp.Result = failure(fmt.Errorf("converting object %d: %w", i, err))
+			return resp
+		}
+
+		resp.ConvertedObjects = append(resp.ConvertedObjects, converted)
+	}
+
+	resp.Result = metav1.Status{Status: metav1.StatusSuccess}
+
+	return resp
+}
+
+func (h *ConversionHandler) convert(raw []byte, desiredVersion schema.GroupVersion) (runtime.RawExtension, error) {
+	var typeMeta metav1.TypeMeta
+	if err := json.Unmarshal(raw, &typeMeta); err != nil {
+		return runtime.RawExtension{}, fmt.Errorf("decoding type: %w", err)
+	}
+
+	srcGVK := typeMeta.GroupVersionKind()
+	if srcGVK.Group != desiredVersion.Group {
+		return runtime.RawExtension{}, fmt.Errorf("cannot convert %s to %s", srcGVK.GroupVersion(), desiredVersion)
+	}
+
+	dstGVK := desiredVersion.WithKind(srcGVK.Kind)
+	if srcGVK == dstGVK {
+		// The API server doesn't send objects already in the desired version, return them untouched anyway.
+		return runtime.RawExtension{Raw: raw}, nil
+	}
+
+	src, err := h.scheme.New(srcGVK)
+	if err != nil {
+		return runtime.RawExtension{}, err
+	}
+
+	if err = json.Unmarshal(raw, src); err != nil {
+		return runtime.RawExtension{}, fmt.Errorf("decoding %s: %w", srcGVK.Kind, err)
+	}
+
+	dst, err := h.scheme.New(dstGVK)
+	if err != nil {
+		return runtime.RawExtension{}, err
+	}
+
+	if err = h.convertThroughHub(src, dst); err != nil {
+		return runtime.RawExtension{}, fmt.Errorf("%s %s: %w", srcGVK.Kind, objectName(src), err)
+	}
+
+	dst.GetObjectKind().SetGroupVersionKind(dstGVK)
+
+	return runtime.RawExtension{Object: dst}, nil
+}
+
+func (h *ConversionHandler) convertThroughHub(src, dst runtime.Object) error {
+	_, srcIsHub := src.(conversion.Hub)
+	_, dstIsHub := dst.(conversion.Hub)
+	if srcIsHub || dstIsHub {
+		return h.scheme.Convert(src, dst, nil)
+	}
+
+	gk := src.GetObjectKind().GroupVersionKind().GroupKind()
+
+	hub, err := h.hub(gk)
+	if err != nil {
+		return err
+	}
+
+	if err = h.scheme.Convert(src, hub, nil); err != nil {
+		return err
+	}
+
+	return h.scheme.Convert(hub, dst, nil)
+}
+
+func (h *ConversionHandler) hub(gk schema.GroupKind) (runtime.Object, error) {
+	for _, version := range h.scheme.VersionsForGroupKind(gk) {
+		object, err := h.scheme.New(version.WithKind(gk.Kind))
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := object.(conversion.Hub); ok {
+			return object, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no hub version for %s", gk)
+}
+
+func objectName(object runtime.Object) string {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return ""
+	}
+
+	if accessor.GetNamespace() == "" {
+		return accessor.GetName()
+	}
+
+	return accessor.GetNamespace() + "/" + accessor.GetName()
+}
+
+func failure(err error) metav1.Status {
+	return metav1.Status{
+		Status:  metav1.StatusFailure,
+		Message: err.Error(),
+	}
+}
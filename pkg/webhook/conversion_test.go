@@ -0,0 +1,219 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package webhook_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/hub-crds/pkg/webhook"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestConversionHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc           string
+		desiredVersion string
+		objects        []string
+		wantStatus     string
+		wantMessage    string
+		wantObjects    []string
+	}{
+		{
+			desc:           "v1alpha1 to v1beta1",
+			desiredVersion: "hub.traefik.io/v1beta1",
+			objects: []string{
+				`{"apiVersion":"hub.traefik.io/v1alpha1","kind":"APIAuth","metadata":{"name":"my-auth","namespace":"default"},"spec":{"isDefault":true,"jwt":{"appIdClaim":"client_id","jwksUrl":"https://example.com/jwks.json"}}}`,
+				`{"apiVersion":"hub.traefik.io/v1alpha1","kind":"ManagedSubscription","metadata":{"name":"my-subscription","namespace":"default"},"spec":{"apiPlan":{"name":"my-plan"},"applications":[{"appId":"my-app"}]}}`,
+				`{"apiVersion":"hub.traefik.io/v1alpha1","kind":"ManagedApplication","metadata":{"name":"my-app","namespace":"default"},"spec":{"appId":"my-app","owner":"me"},"status":{"apiKeyVersions":{"b":"2","a":"1"}}}`,
+			},
+			wantStatus: metav1.StatusSuccess,
+			wantObjects: []string{
+				`{"apiVersion":"hub.traefik.io/v1beta1","kind":"APIAuth","metadata":{"creationTimestamp":null,"name":"my-auth","namespace":"default","annotations":{"hub.traefik.io/v1alpha1-spec":"{\"isDefault\":true,\"jwt\":{\"appIdClaim\":\"client_id\",\"jwksUrl\":\"https://example.com/jwks.json\"}}"}},"spec":{"isDefault":true,"jwt":{"appIdClaim":"client_id","trustedIssuers":[{"jwksUrl":"https://example.com/jwks.json"}]}},"status":{}}`,
+				`{"apiVersion":"hub.traefik.io/v1beta1","kind":"ManagedSubscription","metadata":{"creationTimestamp":null,"name":"my-subscription","namespace":"default","annotations":{"hub.traefik.io/v1alpha1-applications":"[{\"appId\":\"my-app\"}]"}},"spec":{"apiPlan":{"name":"my-plan"}},"status":{}}`,
+				`{"apiVersion":"hub.traefik.io/v1beta1","kind":"ManagedApplication","metadata":{"creationTimestamp":null,"name":"my-app","namespace":"default"},"spec":{"appId":"my-app","owner":"me"},"status":{"apiKeyVersions":[{"key":"a","version":"1"},{"key":"b","version":"2"}]}}`,
+			},
+		},
+		{
+			desc:           "v1beta1 to v1alpha1",
+			desiredVersion: "hub.traefik.io/v1alpha1",
+			objects: []string{
+				`{"apiVersion":"hub.traefik.io/v1beta1","kind":"ManagedSubscription","metadata":{"name":"my-subscription","namespace":"default","annotations":{"hub.traefik.io/v1alpha1-applications":"[{\"appId\":\"my-app\"}]"}},"spec":{"apiPlan":{"name":"my-plan"}}}`,
+				`{"apiVersion":"hub.traefik.io/v1beta1","kind":"Uplink","metadata":{"name":"my-uplink","namespace":"default"},"spec":{"healthCheck":{"interval":"1m","timeout":"5s"}}}`,
+			},
+			wantStatus: metav1.StatusSuccess,
+			wantObjects: []string{
+				`{"apiVersion":"hub.traefik.io/v1alpha1","kind":"ManagedSubscription","metadata":{"creationTimestamp":null,"name":"my-subscription","namespace":"default"},"spec":{"apiPlan":{"name":"my-plan"},"applications":[{"appId":"my-app"}]},"status":{}}`,
+				`{"apiVersion":"hub.traefik.io/v1alpha1","kind":"Uplink","metadata":{"creationTimestamp":null,"name":"my-uplink","namespace":"default"},"spec":{"healthCheck":{"interval":"1m","timeout":"5s"}},"status":{}}`,
+			},
+		},
+		{
+			desc:           "same version",
+			desiredVersion: "hub.traefik.io/v1alpha1",
+			objects: []string{
+				`{"apiVersion":"hub.traefik.io/v1alpha1","kind":"APIPlan","metadata":{"name":"my-plan","namespace":"default"},"spec":{"title":"My plan"}}`,
+			},
+			wantStatus: metav1.StatusSuccess,
+			wantObjects: []string{
+				`{"apiVersion":"hub.traefik.io/v1alpha1","kind":"APIPlan","metadata":{"name":"my-plan","namespace":"default"},"spec":{"title":"My plan"}}`,
+			},
+		},
+		{
+			desc:           "invalid duration",
+			desiredVersion: "hub.traefik.io/v1beta1",
+			objects: []string{
+				`{"apiVersion":"hub.traefik.io/v1alpha1","kind":"Uplink","metadata":{"name":"my-uplink","namespace":"default"},"spec":{"healthCheck":{"interval":"one minute"}}}`,
+			},
+			wantStatus:  metav1.StatusFailure,
+			wantMessage: `converting object 0: Uplink default/my-uplink: parse: invalid period "one minute"`,
+		},
+		{
+			desc:           "kind not served in the desired version",
+			desiredVersion: "hub.traefik.io/v1beta1",
+			objects: []string{
+				`{"apiVersion":"hub.traefik.io/v1alpha1","kind":"APIRateLimit","metadata":{"name":"my-rate-limit","namespace":"default"},"spec":{"limit":1}}`,
+			},
+			wantStatus:  metav1.StatusFailure,
+			wantMessage: `converting object 0: no kind "APIRateLimit" is registered for version "hub.traefik.io/v1beta1" in scheme "pkg/runtime/scheme.go:100"`,
+		},
+		{
+			desc:           "other group",
+			desiredVersion: "hub.traefik.io/v1beta1",
+			objects: []string{
+				`{"apiVersion":"v1","kind":"Secret","metadata":{"name":"my-secret","namespace":"default"}}`,
+			},
+			wantStatus:  metav1.StatusFailure,
+			wantMessage: "converting object 0: cannot convert v1 to hub.traefik.io/v1beta1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			review := apiextensionsv1.ConversionReview{
+				TypeMeta: metav1.TypeMeta{APIVersion: "apiextensions.k8s.io/v1", Kind: "ConversionReview"},
+				Request: &apiextensionsv1.ConversionRequest{
+					UID:               types.UID("uid"),
+					DesiredAPIVersion: test.desiredVersion,
+				},
+			}
+			for _, object := range test.objects {
+				review.Request.Objects = append(review.Request.Objects, runtime.RawExtension{Raw: []byte(object)})
+			}
+
+			got := doConversionReview(t, review)
+
+			assert.Equal(t, "apiextensions.k8s.io/v1", got.APIVersion)
+			assert.Equal(t, "ConversionReview", got.Kind)
+			assert.Nil(t, got.Request)
+			require.NotNil(t, got.Response)
+			assert.Equal(t, types.UID("uid"), got.Response.UID)
+			assert.Equal(t, test.wantStatus, got.Response.Result.Status)
+			assert.Equal(t, test.wantMessage, got.Response.Result.Message)
+
+			require.Len(t, got.Response.ConvertedObjects, len(test.wantObjects))
+			for i, object := range got.Response.ConvertedObjects {
+				assert.JSONEq(t, test.wantObjects[i], string(object.Raw))
+			}
+		})
+	}
+}
+
+func TestConversionHandler_badRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc       string
+		method     string
+		body       string
+		wantStatus int
+	}{
+		{
+			desc:       "method not allowed",
+			method:     http.MethodGet,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			desc:       "invalid body",
+			method:     http.MethodPost,
+			body:       "{",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			desc:       "missing request",
+			method:     http.MethodPost,
+			body:       `{"apiVersion":"apiextensions.k8s.io/v1","kind":"ConversionReview"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(test.method, "/convert", bytes.NewBufferString(test.body))
+			rw := httptest.NewRecorder()
+
+			newConversionHandler(t).ServeHTTP(rw, req)
+
+			assert.Equal(t, test.wantStatus, rw.Code)
+		})
+	}
+}
+
+func doConversionReview(t *testing.T, review apiextensionsv1.ConversionReview) apiextensionsv1.ConversionReview {
+	t.Helper()
+
+	srv := httptest.NewServer(newConversionHandler(t))
+	t.Cleanup(srv.Close)
+
+	body, err := json.Marshal(review)
+	require.NoError(t, err)
+
+	resp, err := srv.Client().Post(srv.URL, "application/json", bytes.NewReader(body))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+	var got apiextensionsv1.ConversionReview
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+
+	return got
+}
+
+func newConversionHandler(t *testing.T) *webhook.ConversionHandler {
+	t.Helper()
+
+	scheme, err := webhook.NewScheme()
+	require.NoError(t, err)
+
+	return webhook.NewConversionHandler(scheme)
+}
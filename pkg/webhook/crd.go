@@ -0,0 +1,130 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package webhook
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+// PatchOptions configures how CRD manifests are patched to use the conversion webhook.
+type PatchOptions struct {
+	// ClientConfig tells the API server how to reach the conversion webhook.
+	ClientConfig apiextensionsv1.WebhookClientConfig
+	// ServeAllVersions marks every version of the patched CRDs as served.
+	// Versions that have not been served so far can be enabled once the webhook is deployed.
+	ServeAllVersions bool
+}
+
+// PatchCRDs patches the CRD manifests of the given filesystem with the conversion webhook stanza.
+// Only the CRDs defining more than one version are patched. The returned map holds the patched
+// manifests indexed by their path in the filesystem.
+func PatchCRDs(filesystem fs.FS, opts PatchOptions) (map[string][]byte, error) {
+	patched := make(map[string][]byte)
+
+	err := fs.WalkDir(filesystem, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || filepath.Ext(path) != ".yaml" {
+			return nil
+		}
+
+		document, err := fs.ReadFile(filesystem, path)
+		if err != nil {
+			return fmt.Errorf("reading %q: %w", path, err)
+		}
+
+		manifest, ok, err := PatchCRD(document, opts)
+		if err != nil {
+			return fmt.Errorf("patching %q: %w", path, err)
+		}
+
+		if ok {
+			patched[path] = manifest
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return patched, nil
+}
+
+// PatchCRD sets the spec.conversion of the given CRD manifest to the conversion webhook.
+// It reports false and leaves the manifest untouched when the CRD defines a single version,
+// since there is nothing to convert.
+func PatchCRD(document []byte, opts PatchOptions) ([]byte, bool, error) {
+	var crd map[string]any
+	if err := yaml.Unmarshal(document, &crd); err != nil {
+		return nil, false, fmt.Errorf("decoding CRD: %w", err)
+	}
+
+	versions, _, err := unstructured.NestedSlice(crd, "spec", "versions")
+	if err != nil {
+		return nil, false, fmt.Errorf("reading versions: %w", err)
+	}
+
+	if len(versions) < 2 {
+		return document, false, nil
+	}
+
+	clientConfig := opts.ClientConfig
+	conversion, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&apiextensionsv1.CustomResourceConversion{
+		Strategy: apiextensionsv1.WebhookConverter,
+		Webhook: &apiextensionsv1.WebhookConversion{
+			ClientConfig:             &clientConfig,
+			ConversionReviewVersions: []string{apiextensionsv1.SchemeGroupVersion.Version},
+		},
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("converting conversion stanza: %w", err)
+	}
+
+	if err = unstructured.SetNestedMap(crd, conversion, "spec", "conversion"); err != nil {
+		return nil, false, fmt.Errorf("setting conversion: %w", err)
+	}
+
+	if opts.ServeAllVersions {
+		for _, version := range versions {
+			if v, ok := version.(map[string]any); ok {
+				v["served"] = true
+			}
+		}
+
+		if err = unstructured.SetNestedSlice(crd, versions, "spec", "versions"); err != nil {
+			return nil, false, fmt.Errorf("setting versions: %w", err)
+		}
+	}
+
+	manifest, err := yaml.Marshal(crd)
+	if err != nil {
+		return nil, false, fmt.Errorf("encoding CRD: %w", err)
+	}
+
+	return append([]byte("---\n"), manifest...), true, nil
+}
@@ -0,0 +1,98 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package webhook_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hubcrd "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1/crd"
+	"github.com/traefik/hub-crds/pkg/crd"
+	"github.com/traefik/hub-crds/pkg/webhook"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+func TestPatchCRDs(t *testing.T) {
+	t.Parallel()
+
+	opts := webhook.PatchOptions{
+		ClientConfig: apiextensionsv1.WebhookClientConfig{
+			Service: &apiextensionsv1.ServiceReference{
+				Namespace: "traefik",
+				Name:      "hub-webhook",
+				Path:      ptr("/convert"),
+				Port:      ptr(int32(443)),
+			},
+			CABundle: []byte("ca"),
+		},
+		ServeAllVersions: true,
+	}
+
+	patched, err := webhook.PatchCRDs(hubcrd.CRDs, opts)
+	require.NoError(t, err)
+
+	assert.Contains(t, patched, "hub.traefik.io_apis.yaml")
+	assert.NotContains(t, patched, "hub.traefik.io_accesscontrolpolicies.yaml")
+	assert.NotContains(t, patched, "hub.traefik.io_aiservices.yaml")
+
+	decoder, err := crd.NewDecoder()
+	require.NoError(t, err)
+
+	for path, manifest := range patched {
+		got, err := decoder.Decode(manifest)
+		require.NoError(t, err, path)
+
+		want := &apiextensions.CustomResourceConversion{
+			Strategy: apiextensions.WebhookConverter,
+			WebhookClientConfig: &apiextensions.WebhookClientConfig{
+				Service: &apiextensions.ServiceReference{
+					Namespace: "traefik",
+					Name:      "hub-webhook",
+					Path:      ptr("/convert"),
+					Port:      443,
+				},
+				CABundle: []byte("ca"),
+			},
+			ConversionReviewVersions: []string{"v1"},
+		}
+		assert.Equal(t, want, got.Spec.Conversion, path)
+
+		for _, version := range got.Spec.Versions {
+			assert.True(t, version.Served, path)
+		}
+	}
+}
+
+func TestPatchCRD_singleVersion(t *testing.T) {
+	t.Parallel()
+
+	document, err := hubcrd.CRDs.ReadFile("hub.traefik.io_accesscontrolpolicies.yaml")
+	require.NoError(t, err)
+
+	got, ok, err := webhook.PatchCRD(document, webhook.PatchOptions{})
+	require.NoError(t, err)
+
+	assert.False(t, ok)
+	assert.Equal(t, document, got)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
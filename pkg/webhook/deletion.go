@@ -0,0 +1,115 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	"github.com/traefik/hub-crds/pkg/deletion"
+	"github.com/traefik/hub-crds/pkg/graph"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GraphFunc returns the current reference graph of the Hub objects, for instance using graph.FromInformers.
+type GraphFunc func(ctx context.Context) (*graph.Graph, error)
+
+// DeletionHandler serves the admission.k8s.io/v1 AdmissionReview requests of the DELETE operations on
+// hub.traefik.io objects. It refuses, or warns about, the deletion of objects still referenced by other objects.
+type DeletionHandler struct {
+	graph GraphFunc
+	mode  deletion.Mode
+}
+
+// NewDeletionHandler creates a new DeletionHandler checking deletions against the graph returned by graphFunc.
+func NewDeletionHandler(graphFunc GraphFunc, mode deletion.Mode) *DeletionHandler {
+	return &DeletionHandler{graph: graphFunc, mode: mode}
+}
+
+// ServeHTTP serves an AdmissionReview request.
+func (h *DeletionHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(rw, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(rw, req.Body, maxBodySize))
+	if err != nil {
+		http.Error(rw, fmt.Sprintf("reading body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	var review admissionv1.AdmissionReview
+	if err = json.Unmarshal(body, &review); err != nil {
+		http.Error(rw, fmt.Sprintf("decoding admission review: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	if review.Request == nil {
+		http.Error(rw, "missing admission request", http.StatusBadRequest)
+		return
+	}
+
+	review.Response = h.review(req.Context(), review.Request)
+	review.Request = nil
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err = json.NewEncoder(rw).Encode(review); err != nil {
+		http.Error(rw, fmt.Sprintf("encoding admission review: %v", err), http.StatusInternalServerError)
+	}
+}
+
+func (h *DeletionHandler) review(ctx context.Context, req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	resp := &admissionv1.AdmissionResponse{UID: req.UID, Allowed: true}
+
+	if req.Operation != admissionv1.Delete || req.Kind.Group != hubv1alpha1.SchemeGroupVersion.Group {
+		return resp
+	}
+
+	g, err := h.graph(ctx)
+	if err != nil {
+		resp.Allowed = false
+		resp.Result = &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusInternalServerError,
+			Message: fmt.Sprintf("building reference graph: %v", err),
+		}
+
+		return resp
+	}
+
+	result := deletion.Check(g, graph.Node{Kind: req.Kind.Kind, Namespace: req.Namespace, Name: req.Name}, h.mode)
+	resp.Warnings = result.Warnings
+
+	if !result.Allowed {
+		resp.Allowed = false
+		resp.Result = &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusForbidden,
+			Reason:  metav1.StatusReasonForbidden,
+			Message: fmt.Sprintf("%s %s/%s cannot be deleted: %s", req.Kind.Kind, req.Namespace, req.Name, result.Message()),
+		}
+	}
+
+	return resp
+}
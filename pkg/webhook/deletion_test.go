@@ -0,0 +1,180 @@
+/*
+Copyright (C) 2022-2026 Traefik Labs
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package webhook_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hubv1alpha1 "github.com/traefik/hub-crds/pkg/apis/hub/v1alpha1"
+	"github.com/traefik/hub-crds/pkg/deletion"
+	"github.com/traefik/hub-crds/pkg/graph"
+	"github.com/traefik/hub-crds/pkg/webhook"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestDeletionHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc         string
+		mode         deletion.Mode
+		graphErr     error
+		operation    admissionv1.Operation
+		kind         metav1.GroupVersionKind
+		name         string
+		wantAllowed  bool
+		wantCode     int32
+		wantMessage  string
+		wantWarnings []string
+	}{
+		{
+			desc:        "referenced plan",
+			mode:        deletion.ModeDeny,
+			operation:   admissionv1.Delete,
+			kind:        metav1.GroupVersionKind{Group: "hub.traefik.io", Version: "v1alpha1", Kind: "APIPlan"},
+			name:        "gold",
+			wantCode:    http.StatusForbidden,
+			wantMessage: "APIPlan default/gold cannot be deleted: referenced by ManagedSubscription sub (spec.apiPlan.name)",
+		},
+		{
+			desc:         "referenced plan in warn mode",
+			mode:         deletion.ModeWarn,
+			operation:    admissionv1.Delete,
+			kind:         metav1.GroupVersionKind{Group: "hub.traefik.io", Version: "v1alpha1", Kind: "APIPlan"},
+			name:         "gold",
+			wantAllowed:  true,
+			wantWarnings: []string{"APIPlan default/gold is referenced by ManagedSubscription sub (spec.apiPlan.name)"},
+		},
+		{
+			desc:        "unreferenced plan",
+			mode:        deletion.ModeDeny,
+			operation:   admissionv1.Delete,
+			kind:        metav1.GroupVersionKind{Group: "hub.traefik.io", Version: "v1beta1", Kind: "APIPlan"},
+			name:        "silver",
+			wantAllowed: true,
+		},
+		{
+			desc:        "update",
+			mode:        deletion.ModeDeny,
+			operation:   admissionv1.Update,
+			kind:        metav1.GroupVersionKind{Group: "hub.traefik.io", Version: "v1alpha1", Kind: "APIPlan"},
+			name:        "gold",
+			wantAllowed: true,
+		},
+		{
+			desc:        "other group",
+			mode:        deletion.ModeDeny,
+			graphErr:    errors.New("boom"),
+			operation:   admissionv1.Delete,
+			kind:        metav1.GroupVersionKind{Version: "v1", Kind: "ConfigMap"},
+			name:        "gold",
+			wantAllowed: true,
+		},
+		{
+			desc:        "graph error",
+			mode:        deletion.ModeDeny,
+			graphErr:    errors.New("boom"),
+			operation:   admissionv1.Delete,
+			kind:        metav1.GroupVersionKind{Group: "hub.traefik.io", Version: "v1alpha1", Kind: "APIPlan"},
+			name:        "gold",
+			wantCode:    http.StatusInternalServerError,
+			wantMessage: "building reference graph: boom",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			graphFunc := func(context.Context) (*graph.Graph, error) {
+				if test.graphErr != nil {
+					return nil, test.graphErr
+				}
+
+				return graph.Build(
+					&hubv1alpha1.APIPlan{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gold"}},
+					&hubv1alpha1.APIPlan{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "silver"}},
+					&hubv1alpha1.ManagedSubscription{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "sub"},
+						Spec:       hubv1alpha1.ManagedSubscriptionSpec{APIPlan: hubv1alpha1.APIPlanReference{Name: "gold"}},
+					},
+				)
+			}
+
+			review := admissionv1.AdmissionReview{
+				TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1", Kind: "AdmissionReview"},
+				Request: &admissionv1.AdmissionRequest{
+					UID:       types.UID("uid"),
+					Kind:      test.kind,
+					Operation: test.operation,
+					Namespace: "default",
+					Name:      test.name,
+				},
+			}
+			body, err := json.Marshal(review)
+			require.NoError(t, err)
+
+			rw := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/validate-deletion", bytes.NewReader(body))
+			webhook.NewDeletionHandler(graphFunc, test.mode).ServeHTTP(rw, req)
+
+			require.Equal(t, http.StatusOK, rw.Code)
+
+			var got admissionv1.AdmissionReview
+			require.NoError(t, json.Unmarshal(rw.Body.Bytes(), &got))
+			require.NotNil(t, got.Response)
+			assert.Nil(t, got.Request)
+			assert.Equal(t, types.UID("uid"), got.Response.UID)
+			assert.Equal(t, test.wantAllowed, got.Response.Allowed)
+			assert.Equal(t, test.wantWarnings, got.Response.Warnings)
+
+			if test.wantAllowed {
+				assert.Nil(t, got.Response.Result)
+				return
+			}
+
+			require.NotNil(t, got.Response.Result)
+			assert.Equal(t, test.wantCode, got.Response.Result.Code)
+			assert.Equal(t, test.wantMessage, got.Response.Result.Message)
+		})
+	}
+}
+
+func TestDeletionHandler_badRequest(t *testing.T) {
+	t.Parallel()
+
+	handler := webhook.NewDeletionHandler(nil, deletion.ModeDeny)
+
+	rw := httptest.NewRecorder()
+	handler.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/validate-deletion", http.NoBody))
+	assert.Equal(t, http.StatusMethodNotAllowed, rw.Code)
+
+	rw = httptest.NewRecorder()
+	handler.ServeHTTP(rw, httptest.NewRequest(http.MethodPost, "/validate-deletion", bytes.NewBufferString(`{}`)))
+	assert.Equal(t, http.StatusBadRequest, rw.Code)
+}
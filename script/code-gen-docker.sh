@@ -30,5 +30,5 @@ docker run --rm \
            -w "/go/src/${PROJECT_MODULE}" \
            "${IMAGE_NAME}" \
            controller-gen crd:crdVersions=v1,allowDangerousTypes=true  \
-           paths=./pkg/apis/hub/v1alpha1/... \
+           paths=./pkg/apis/hub/... \
            output:dir=./pkg/apis/hub/v1alpha1/crd \
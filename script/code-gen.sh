@@ -14,6 +14,7 @@ kube::codegen::gen_helpers \
 
 kube::codegen::gen_client \
     --with-watch \
+    --with-applyconfig \
     --input-pkg-root "${PROJECT_MODULE}/pkg/apis" \
     --output-pkg-root "${PROJECT_MODULE}/pkg/client" \
     --output-base "$(dirname "${BASH_SOURCE[0]}")/../../../.." \